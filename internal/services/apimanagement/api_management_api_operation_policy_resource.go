@@ -4,6 +4,7 @@
 package apimanagement
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"html"
@@ -14,10 +15,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperationpolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/namedvalue"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -61,6 +64,7 @@ func resourceApiManagementApiOperationPolicy() *pluginsdk.Resource {
 				Computed:         true,
 				ConflictsWith:    []string{"xml_link"},
 				DiffSuppressFunc: XmlWithDotNetInterpolationsDiffSuppress,
+				ValidateFunc:     validate.ApiManagementPolicyXmlWarnings(false),
 			},
 
 			"xml_link": {
@@ -69,6 +73,10 @@ func resourceApiManagementApiOperationPolicy() *pluginsdk.Resource {
 				ConflictsWith: []string{"xml_content"},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			return validateApiManagementPolicyXmlDiff(d, "xml_content", false)
+		}),
 	}
 }
 
@@ -116,6 +124,13 @@ func resourceApiManagementAPIOperationPolicyCreateUpdate(d *pluginsdk.ResourceDa
 		return errors.New("either `xml_content` or `xml_link` must be set")
 	}
 
+	if xmlLink == "" && d.HasChange("xml_content") {
+		namedValueServiceId := namedvalue.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
+		if err := validateApiManagementPolicyNamedValues(ctx, meta.(*clients.Client).ApiManagement.NamedValueClient, namedValueServiceId, xmlContent); err != nil {
+			return fmt.Errorf("creating or updating %s: %+v", id, err)
+		}
+	}
+
 	if _, err := client.CreateOrUpdate(ctx, id, parameters, apioperationpolicy.CreateOrUpdateOperationOptions{}); err != nil {
 		return fmt.Errorf("creating or updating %s: %+v", id, err)
 	}
@@ -6,6 +6,7 @@ package apimanagement_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -99,6 +100,19 @@ func TestAccApiManagementAPIPolicy_customPolicy(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementAPIPolicy_invalidPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_policy", "test")
+	r := ApiManagementApiPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidPolicy(data),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("the attribute `renewal-period` is required for `rate-limit`"),
+		},
+	})
+}
+
 func (ApiManagementApiPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := apipolicy.ParseApiID(state.ID)
 	if err != nil {
@@ -212,3 +226,50 @@ XML
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
+
+func (ApiManagementApiPolicyResource) invalidPolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+  sku_name            = "Consumption_0"
+}
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "api1"
+  path                = "api1"
+  protocols           = ["https"]
+  revision            = "1"
+}
+
+resource "azurerm_api_management_api_policy" "test" {
+  api_name            = azurerm_api_management_api.test.name
+  api_management_name = azurerm_api_management.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  xml_content = <<XML
+<policies>
+  <inbound>
+    <base />
+    <rate-limit calls="10" />
+  </inbound>
+</policies>
+XML
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
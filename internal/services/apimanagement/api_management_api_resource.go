@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/api"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperation"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -172,6 +173,11 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 							}, false),
 						},
 
+						"operation_drift_detection_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+						},
+
 						"wsdl_selector": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
@@ -338,6 +344,14 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 				Computed: true,
 				Optional: true,
 			},
+
+			"operations": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 
 		CustomizeDiff: pluginsdk.CustomDiffWithAll(
@@ -355,6 +369,42 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 				if d.Get("api_type").(string) == string(api.ApiTypeWebsocket) && d.Get("service_url").(string) == "" {
 					return errors.New("`service_url` is required when `api_type` is `websocket`")
 				}
+
+				importVs := d.Get("import").([]interface{})
+				if len(importVs) == 0 || importVs[0] == nil || !d.NewValueKnown("import.0.content_value") {
+					return nil
+				}
+				importV := importVs[0].(map[string]interface{})
+				if !isApiManagementApiInlineSpecification(importV["content_format"].(string)) {
+					return nil
+				}
+
+				// the specification is only validated when it changes, so that an existing API whose specification doesn't pass the
+				// offline validation can still be planned
+				content := importV["content_value"].(string)
+				if d.HasChange("import") {
+					if errs := validate.ApiSpecification(content); len(errs) > 0 {
+						return fmt.Errorf("validating the specification in `import.0.content_value`: %+v", errors.Join(errs...))
+					}
+				}
+
+				// operations added or removed outside of Terraform, or by a change to the specification which isn't reflected in
+				// `content_value`, are only detected by comparing the operations of the API with those in the specification
+				if d.Id() == "" || !importV["operation_drift_detection_enabled"].(bool) {
+					return nil
+				}
+
+				spec, err := validate.ParseApiSpecification(content)
+				if err != nil {
+					return err
+				}
+				expected := validate.ApiSpecificationOperations(spec)
+				if !apiManagementApiOperationsEqual(expected, d.Get("operations").(*pluginsdk.Set).List()) {
+					if err := d.SetNew("operations", expected); err != nil {
+						return fmt.Errorf("setting `operations`: %+v", err)
+					}
+				}
+
 				return nil
 			}),
 		),
@@ -523,7 +573,10 @@ func resourceApiManagementApiUpdate(d *pluginsdk.ResourceData, meta interface{})
 
 	// If import is used, we need to send properties to Azure API in two operations.
 	// First we execute import and then updated the other props.
-	if d.HasChange("import") {
+	// When `operation_drift_detection_enabled` is set, a change to `operations` means the operations of the API have drifted
+	// from the specification, so it's imported again.
+	driftDetected := d.Get("import.0.operation_drift_detection_enabled").(bool) && d.HasChange("operations")
+	if d.HasChange("import") || driftDetected {
 		if vs, hasImport := d.GetOk("import"); hasImport {
 			d.Partial(true)
 			if apiParams := expandApiManagementApiImport(vs.([]interface{}), apiType, soapApiType,
@@ -768,6 +821,21 @@ func resourceApiManagementApiRead(d *pluginsdk.ResourceData, meta interface{}) e
 			}
 		}
 	}
+
+	operations := make([]string, 0)
+	if d.Get("import.0.operation_drift_detection_enabled").(bool) {
+		operationsClient := meta.(*clients.Client).ApiManagement.ApiOperationsClient
+		apiId := apioperation.NewApiID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId)
+		resp, err := operationsClient.ListByApiComplete(ctx, apiId, apioperation.DefaultListByApiOperationOptions())
+		if err != nil {
+			return fmt.Errorf("listing operations of %s: %+v", id, err)
+		}
+		operations = flattenApiManagementApiOperations(resp.Items)
+	}
+	if err := d.Set("operations", operations); err != nil {
+		return fmt.Errorf("setting `operations`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -849,6 +917,46 @@ func expandApiManagementApiImport(importVs []interface{}, apiType api.ApiType, s
 	return &apiParams
 }
 
+// isApiManagementApiInlineSpecification returns whether the content of an import is an OpenAPI or Swagger specification,
+// rather than a link to one or a WSDL/WADL document.
+func isApiManagementApiInlineSpecification(contentFormat string) bool {
+	switch api.ContentFormat(contentFormat) {
+	case api.ContentFormatOpenapi, api.ContentFormatOpenapiPositivejson, api.ContentFormatSwaggerNegativejson:
+		return true
+	}
+	return false
+}
+
+func flattenApiManagementApiOperations(input []apioperation.OperationContract) []string {
+	operations := make([]string, 0)
+	for _, v := range input {
+		if v.Properties == nil {
+			continue
+		}
+		// required query parameters are part of the URL template, but not of the path in the specification
+		urlTemplate, _, _ := strings.Cut(v.Properties.UrlTemplate, "?")
+		operations = append(operations, fmt.Sprintf("%s %s", strings.ToUpper(v.Properties.Method), urlTemplate))
+	}
+	return operations
+}
+
+func apiManagementApiOperationsEqual(expected []string, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+
+	operations := make(map[string]bool)
+	for _, v := range actual {
+		operations[strings.ToLower(v.(string))] = true
+	}
+	for _, v := range expected {
+		if !operations[strings.ToLower(v)] {
+			return false
+		}
+	}
+	return true
+}
+
 func expandApiManagementApiProtocols(input []interface{}) *[]api.Protocol {
 	if len(input) == 0 {
 		return nil
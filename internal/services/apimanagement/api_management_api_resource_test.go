@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/api"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -285,6 +286,78 @@ func TestAccApiManagementApi_updateImportOpenapiInvalid(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementApi_importOpenapiLintError(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
+	r := ApiManagementApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.importOpenapiLintError(data),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("validating the specification in `import.0.content_value`"),
+		},
+	})
+}
+
+func TestAccApiManagementApi_importOpenapiOperationDriftDetection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
+	r := ApiManagementApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.importOpenapiOperationDriftDetection(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("operations.#").HasValue("1"),
+				check.That(data.ResourceName).Key("operations.0").HasValue("POST /default"),
+			),
+		},
+		data.ImportStep("import"),
+		{
+			Config: r.importOpenapiOperationDriftDetection(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClient(r.deleteOperations),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.importOpenapiOperationDriftDetection(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("operations.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("import"),
+	})
+}
+
+func TestAccApiManagementApi_importOpenapiOperationDriftDetectionDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
+	r := ApiManagementApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.importOpenapiOperationDriftDetection(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("operations.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("import"),
+		{
+			// the operations are removed outside of Terraform, which mustn't cause the specification to be imported again
+			Config: r.importOpenapiOperationDriftDetection(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClient(r.deleteOperations),
+			),
+		},
+		{
+			Config:   r.importOpenapiOperationDriftDetection(data, false),
+			PlanOnly: true,
+		},
+	})
+}
+
 func TestAccApiManagementApi_importSwaggerWithServiceUrl(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
 	r := ApiManagementApiResource{}
@@ -485,6 +558,32 @@ func (ApiManagementApiResource) Exists(ctx context.Context, clients *clients.Cli
 	return pointer.To(resp.Model != nil && resp.Model.Id != nil), nil
 }
 
+func (ApiManagementApiResource) deleteOperations(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	id, err := api.ParseApiID(state.ID)
+	if err != nil {
+		return err
+	}
+
+	client := clients.ApiManagement.ApiOperationsClient
+	apiId := apioperation.NewApiID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId)
+	resp, err := client.ListByApiComplete(ctx, apiId, apioperation.DefaultListByApiOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing operations of %s: %+v", *id, err)
+	}
+
+	for _, v := range resp.Items {
+		if v.Name == nil {
+			continue
+		}
+		operationId := apioperation.NewOperationID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId, *v.Name)
+		if _, err := client.Delete(ctx, operationId, apioperation.DeleteOperationOptions{IfMatch: pointer.To("*")}); err != nil {
+			return fmt.Errorf("deleting %s: %+v", operationId, err)
+		}
+	}
+
+	return nil
+}
+
 func (r ApiManagementApiResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -694,6 +793,64 @@ resource "azurerm_api_management_api" "test" {
 `, r.template(data, SkuNameConsumption), data.RandomInteger)
 }
 
+func (r ApiManagementApiResource) importOpenapiLintError(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "api1"
+  path                = "api1"
+  protocols           = ["https"]
+  revision            = "current"
+
+  import {
+    content_format = "openapi"
+    content_value  = <<YAML
+openapi: 3.0.0
+info:
+  title: api1
+paths:
+  /pets/{petId}:
+    get:
+      operationId: getPet
+      responses:
+        "200":
+          $ref: "#/components/responses/Missing"
+YAML
+  }
+}
+`, r.template(data, SkuNameConsumption), data.RandomInteger)
+}
+
+func (r ApiManagementApiResource) importOpenapiOperationDriftDetection(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "api1"
+  path                = "api1"
+  protocols           = ["https"]
+  revision            = "current"
+
+  import {
+    content_value                     = file("testdata/api_management_api_openapi.yaml")
+    content_format                    = "openapi"
+    operation_drift_detection_enabled = %t
+  }
+
+  lifecycle {
+    ignore_changes = [description]
+  }
+}
+`, r.template(data, SkuNameConsumption), data.RandomInteger, enabled)
+}
+
 func (r ApiManagementApiResource) importSwaggerWithServiceUrl(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -4,7 +4,9 @@
 package apimanagement
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -81,6 +84,20 @@ func resourceApiManagementApiSchema() *pluginsdk.Resource {
 				ExactlyOneOf:     []string{"value", "definitions", "components"},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			if d.Get("content_type").(string) != "application/vnd.ms-azure-apim.graphql.schema" || !d.NewValueKnown("value") {
+				return nil
+			}
+
+			if value := d.Get("value").(string); value != "" {
+				if errs := validate.GraphQLSchemaDefinition(value); len(errs) > 0 {
+					return fmt.Errorf("validating the GraphQL schema in `value`: %+v", errors.Join(errs...))
+				}
+			}
+
+			return nil
+		}),
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -52,6 +53,19 @@ func TestAccApiManagementApiSchema_basicSwagger(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementApiSchema_graphqlInvalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_schema", "test")
+	r := ApiManagementApiSchemaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.graphqlInvalid(data),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("validating the GraphQL schema in `value`"),
+		},
+	})
+}
+
 func TestAccApiManagementApiSchema_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management_api_schema", "test")
 	r := ApiManagementApiSchemaResource{}
@@ -142,6 +156,25 @@ resource "azurerm_api_management_api_schema" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r ApiManagementApiSchemaResource) graphqlInvalid(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_schema" "test" {
+  api_name            = azurerm_api_management_api.test.name
+  api_management_name = azurerm_api_management_api.test.api_management_name
+  resource_group_name = azurerm_api_management_api.test.resource_group_name
+  schema_id           = "acctestSchema%d"
+  content_type        = "application/vnd.ms-azure-apim.graphql.schema"
+  value               = <<GRAPHQL
+type Pet {
+  id: ID!
+}
+GRAPHQL
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r ApiManagementApiSchemaResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
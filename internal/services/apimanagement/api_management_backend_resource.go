@@ -4,6 +4,7 @@
 package apimanagement
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -205,9 +206,43 @@ func resourceApiManagementBackend() *pluginsdk.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 2000),
 			},
 
+			"pool": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"service": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 30,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: backend.ValidateBackendID,
+									},
+									"priority": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"weight": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"protocol": {
 				Type:     pluginsdk.TypeString,
-				Required: true,
+				Optional: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(backend.BackendProtocolHTTP),
 					string(backend.BackendProtocolSoap),
@@ -335,12 +370,45 @@ func resourceApiManagementBackend() *pluginsdk.Resource {
 				},
 			},
 
+			"type": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(backend.PossibleValuesForBackendType(), false),
+			},
+
 			"url": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			if d.Get("type").(string) == string(backend.BackendTypePool) {
+				if len(d.Get("pool").([]interface{})) == 0 {
+					return errors.New("`pool` is required when `type` is `Pool`")
+				}
+				for _, field := range []string{"protocol", "url", "credentials", "proxy", "service_fabric_cluster", "tls"} {
+					if _, ok := d.GetOk(field); ok {
+						return fmt.Errorf("`%s` cannot be specified when `type` is `Pool`", field)
+					}
+				}
+				return nil
+			}
+
+			if len(d.Get("pool").([]interface{})) > 0 {
+				return errors.New("`pool` can only be specified when `type` is `Pool`")
+			}
+			for _, field := range []string{"protocol", "url"} {
+				if d.NewValueKnown(field) && d.Get(field).(string) == "" {
+					return fmt.Errorf("`%s` is required when `type` is not `Pool`", field)
+				}
+			}
+
+			return nil
+		}),
 	}
 }
 
@@ -367,22 +435,28 @@ func resourceApiManagementBackendCreateUpdate(d *pluginsdk.ResourceData, meta in
 
 	credentialsRaw := d.Get("credentials").([]interface{})
 	credentials := expandApiManagementBackendCredentials(credentialsRaw)
-	protocol := d.Get("protocol").(string)
 	proxyRaw := d.Get("proxy").([]interface{})
 	proxy := expandApiManagementBackendProxy(proxyRaw)
 	tlsRaw := d.Get("tls").([]interface{})
 	tls := expandApiManagementBackendTls(tlsRaw)
-	url := d.Get("url").(string)
 
 	backendContract := backend.BackendContract{
 		Properties: &backend.BackendContractProperties{
 			Credentials: credentials,
-			Protocol:    pointer.To(backend.BackendProtocol(protocol)),
+			Pool:        expandApiManagementBackendPool(d.Get("pool").([]interface{})),
 			Proxy:       proxy,
 			Tls:         tls,
-			Url:         pointer.To(url),
 		},
 	}
+	if protocol, ok := d.GetOk("protocol"); ok {
+		backendContract.Properties.Protocol = pointer.To(backend.BackendProtocol(protocol.(string)))
+	}
+	if backendType, ok := d.GetOk("type"); ok {
+		backendContract.Properties.Type = pointer.To(backend.BackendType(backendType.(string)))
+	}
+	if url, ok := d.GetOk("url"); ok {
+		backendContract.Properties.Url = pointer.To(url.(string))
+	}
 	if v, ok := d.GetOk("circuit_breaker_rule"); ok {
 		backendContract.Properties.CircuitBreaker = expandApiManagementBackendCircuitBreaker(v.([]interface{}))
 	}
@@ -444,13 +518,17 @@ func resourceApiManagementBackendRead(d *pluginsdk.ResourceData, meta interface{
 			d.Set("protocol", pointer.FromEnum(props.Protocol))
 			d.Set("resource_id", pointer.From(props.ResourceId))
 			d.Set("title", pointer.From(props.Title))
-			d.Set("url", props.Url)
+			d.Set("type", pointer.FromEnum(props.Type))
+			d.Set("url", pointer.From(props.Url))
 			if err := d.Set("circuit_breaker_rule", flattenApiManagementBackendCircuitBreaker(props.CircuitBreaker)); err != nil {
 				return fmt.Errorf("setting `circuit_breaker_rule`: %s", err)
 			}
 			if err := d.Set("credentials", flattenApiManagementBackendCredentials(props.Credentials)); err != nil {
 				return fmt.Errorf("setting `credentials`: %s", err)
 			}
+			if err := d.Set("pool", flattenApiManagementBackendPool(props.Pool)); err != nil {
+				return fmt.Errorf("setting `pool`: %s", err)
+			}
 			if err := d.Set("proxy", flattenApiManagementBackendProxy(props.Proxy)); err != nil {
 				return fmt.Errorf("setting `proxy`: %s", err)
 			}
@@ -792,6 +870,56 @@ func flattenApiManagementBackendCredentialsAuthorization(input *backend.BackendA
 	return append(results, result)
 }
 
+func expandApiManagementBackendPool(input []interface{}) *backend.BackendBaseParametersPool {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	services := make([]backend.BackendPoolItem, 0)
+	for _, raw := range v["service"].([]interface{}) {
+		service := raw.(map[string]interface{})
+		item := backend.BackendPoolItem{
+			Id: service["id"].(string),
+		}
+		if priority := service["priority"].(int); priority != 0 {
+			item.Priority = pointer.To(int64(priority))
+		}
+		if weight := service["weight"].(int); weight != 0 {
+			item.Weight = pointer.To(int64(weight))
+		}
+		services = append(services, item)
+	}
+
+	return &backend.BackendBaseParametersPool{
+		Services: &services,
+	}
+}
+
+func flattenApiManagementBackendPool(input *backend.BackendBaseParametersPool) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Services == nil {
+		return results
+	}
+
+	services := make([]interface{}, 0)
+	for _, item := range *input.Services {
+		serviceId := item.Id
+		if id, err := backend.ParseBackendIDInsensitively(item.Id); err == nil {
+			serviceId = id.ID()
+		}
+		services = append(services, map[string]interface{}{
+			"id":       serviceId,
+			"priority": int(pointer.From(item.Priority)),
+			"weight":   int(pointer.From(item.Weight)),
+		})
+	}
+
+	return append(results, map[string]interface{}{
+		"service": services,
+	})
+}
+
 func flattenApiManagementBackendProxy(input *backend.BackendProxyContract) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
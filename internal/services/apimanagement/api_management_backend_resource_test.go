@@ -79,6 +79,30 @@ func TestAccApiManagementBackend_circuitBreakerRule(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementBackend_pool(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_backend", "test")
+	r := ApiManagementAuthorizationBackendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.pool(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("type").HasValue("Pool"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.poolUpdate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pool.0.service.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccApiManagementBackend_allProperties(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management_backend", "test")
 	r := ApiManagementAuthorizationBackendResource{}
@@ -383,6 +407,110 @@ resource "azurerm_api_management_backend" "test" {
 `, r.template(data, "circuitbreaker"), data.RandomInteger)
 }
 
+func (r ApiManagementAuthorizationBackendResource) pool(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backend" "test" {
+  name                = "acctestapi-pool-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  description         = "Test backend pool"
+  type                = "Pool"
+
+  pool {
+    service {
+      id       = azurerm_api_management_backend.primary.id
+      priority = 1
+    }
+
+    service {
+      id       = azurerm_api_management_backend.secondary.id
+      priority = 2
+    }
+  }
+}
+`, r.poolTemplate(data), data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationBackendResource) poolUpdate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backend" "tertiary" {
+  name                = "acctestapi-tertiary-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  protocol            = "http"
+  url                 = "https://tertiary.acctest"
+}
+
+resource "azurerm_api_management_backend" "test" {
+  name                = "acctestapi-pool-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  description         = "Test backend pool"
+  type                = "Pool"
+
+  pool {
+    service {
+      id       = azurerm_api_management_backend.primary.id
+      priority = 1
+      weight   = 3
+    }
+
+    service {
+      id       = azurerm_api_management_backend.secondary.id
+      priority = 1
+      weight   = 1
+    }
+
+    service {
+      id       = azurerm_api_management_backend.tertiary.id
+      priority = 2
+    }
+  }
+}
+`, r.poolTemplate(data), data.RandomInteger)
+}
+
+func (r ApiManagementAuthorizationBackendResource) poolTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backend" "primary" {
+  name                = "acctestapi-primary-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  protocol            = "http"
+  url                 = "https://primary.acctest"
+
+  circuit_breaker_rule {
+    name          = "primary-circuit-breaker"
+    trip_duration = "PT1M"
+
+    failure_condition {
+      count             = 3
+      interval_duration = "PT1M"
+
+      status_code_range {
+        min = 429
+        max = 429
+      }
+    }
+  }
+}
+
+resource "azurerm_api_management_backend" "secondary" {
+  name                = "acctestapi-secondary-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  protocol            = "http"
+  url                 = "https://secondary.acctest"
+}
+`, r.template(data, "pool"), data.RandomInteger)
+}
+
 func (r ApiManagementAuthorizationBackendResource) update(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
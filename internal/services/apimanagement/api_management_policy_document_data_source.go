@@ -0,0 +1,460 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementPolicyDocumentDataSource struct{}
+
+var _ sdk.DataSource = ApiManagementPolicyDocumentDataSource{}
+
+type ApiManagementPolicyDocumentDataSourceModel struct {
+	AzureOpenAISemanticCacheLookup []ApiManagementPolicyDocumentSemanticCacheLookupModel `tfschema:"azure_openai_semantic_cache_lookup"`
+	AzureOpenAISemanticCacheStore  []ApiManagementPolicyDocumentSemanticCacheStoreModel  `tfschema:"azure_openai_semantic_cache_store"`
+	LlmEmitTokenMetric             []ApiManagementPolicyDocumentLlmEmitTokenMetricModel  `tfschema:"llm_emit_token_metric"`
+	LlmTokenLimit                  []ApiManagementPolicyDocumentLlmTokenLimitModel       `tfschema:"llm_token_limit"`
+	SetBackendService              []ApiManagementPolicyDocumentSetBackendServiceModel   `tfschema:"set_backend_service"`
+	Xml                            string                                                `tfschema:"xml"`
+}
+
+type ApiManagementPolicyDocumentSemanticCacheLookupModel struct {
+	EmbeddingsBackendId         string   `tfschema:"embeddings_backend_id"`
+	ScoreThreshold              float64  `tfschema:"score_threshold"`
+	EmbeddingsBackendAuth       string   `tfschema:"embeddings_backend_auth"`
+	IgnoreSystemMessagesEnabled bool     `tfschema:"ignore_system_messages_enabled"`
+	MaxMessageCount             int64    `tfschema:"max_message_count"`
+	VaryBy                      []string `tfschema:"vary_by"`
+}
+
+type ApiManagementPolicyDocumentSemanticCacheStoreModel struct {
+	DurationInSeconds int64 `tfschema:"duration_in_seconds"`
+}
+
+type ApiManagementPolicyDocumentLlmEmitTokenMetricModel struct {
+	Dimension []ApiManagementPolicyDocumentDimensionModel `tfschema:"dimension"`
+	Namespace string                                      `tfschema:"namespace"`
+}
+
+type ApiManagementPolicyDocumentDimensionModel struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type ApiManagementPolicyDocumentLlmTokenLimitModel struct {
+	CounterKey                  string `tfschema:"counter_key"`
+	EstimatePromptTokensEnabled bool   `tfschema:"estimate_prompt_tokens_enabled"`
+	RemainingTokensHeaderName   string `tfschema:"remaining_tokens_header_name"`
+	RetryAfterHeaderName        string `tfschema:"retry_after_header_name"`
+	TokenQuota                  int64  `tfschema:"token_quota"`
+	TokenQuotaPeriod            string `tfschema:"token_quota_period"`
+	TokensConsumedHeaderName    string `tfschema:"tokens_consumed_header_name"`
+	TokensPerMinute             int64  `tfschema:"tokens_per_minute"`
+}
+
+type ApiManagementPolicyDocumentSetBackendServiceModel struct {
+	BackendId string `tfschema:"backend_id"`
+	BaseUrl   string `tfschema:"base_url"`
+}
+
+func (d ApiManagementPolicyDocumentDataSource) ResourceType() string {
+	return "azurerm_api_management_policy_document"
+}
+
+func (d ApiManagementPolicyDocumentDataSource) ModelObject() interface{} {
+	return &ApiManagementPolicyDocumentDataSourceModel{}
+}
+
+func (d ApiManagementPolicyDocumentDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"azure_openai_semantic_cache_lookup": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			RequiredWith: []string{"azure_openai_semantic_cache_store"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"embeddings_backend_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"score_threshold": {
+						Type:         pluginsdk.TypeFloat,
+						Required:     true,
+						ValidateFunc: validation.FloatBetween(0, 1),
+					},
+
+					"embeddings_backend_auth": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"system-assigned"}, false),
+					},
+
+					"ignore_system_messages_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"max_message_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"vary_by": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"azure_openai_semantic_cache_store": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			RequiredWith: []string{"azure_openai_semantic_cache_lookup"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"duration_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"llm_emit_token_metric": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"dimension": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 5,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"value": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"namespace": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"llm_token_limit": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"counter_key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"estimate_prompt_tokens_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"remaining_tokens_header_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"retry_after_header_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"token_quota": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+						AtLeastOneOf: []string{"llm_token_limit.0.token_quota", "llm_token_limit.0.tokens_per_minute"},
+						RequiredWith: []string{"llm_token_limit.0.token_quota_period"},
+					},
+
+					"token_quota_period": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"Hourly", "Daily", "Weekly", "Monthly", "Yearly"}, false),
+						RequiredWith: []string{"llm_token_limit.0.token_quota"},
+					},
+
+					"tokens_consumed_header_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"tokens_per_minute": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+						AtLeastOneOf: []string{"llm_token_limit.0.token_quota", "llm_token_limit.0.tokens_per_minute"},
+					},
+				},
+			},
+		},
+
+		"set_backend_service": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"backend_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"set_backend_service.0.backend_id", "set_backend_service.0.base_url"},
+					},
+
+					"base_url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						ExactlyOneOf: []string{"set_backend_service.0.backend_id", "set_backend_service.0.base_url"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d ApiManagementPolicyDocumentDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"xml": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (d ApiManagementPolicyDocumentDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ApiManagementPolicyDocumentDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			inbound := policyElement{name: "inbound", children: []policyElement{{name: "base"}}}
+			inbound.children = append(inbound.children, expandApiManagementPolicyDocumentLlmTokenLimit(model.LlmTokenLimit)...)
+			inbound.children = append(inbound.children, expandApiManagementPolicyDocumentLlmEmitTokenMetric(model.LlmEmitTokenMetric)...)
+			inbound.children = append(inbound.children, expandApiManagementPolicyDocumentSemanticCacheLookup(model.AzureOpenAISemanticCacheLookup)...)
+			inbound.children = append(inbound.children, expandApiManagementPolicyDocumentSetBackendService(model.SetBackendService)...)
+
+			outbound := policyElement{name: "outbound", children: []policyElement{{name: "base"}}}
+			outbound.children = append(outbound.children, expandApiManagementPolicyDocumentSemanticCacheStore(model.AzureOpenAISemanticCacheStore)...)
+
+			document := policyElement{
+				name: "policies",
+				children: []policyElement{
+					inbound,
+					{name: "backend", children: []policyElement{{name: "base"}}},
+					outbound,
+					{name: "on-error", children: []policyElement{{name: "base"}}},
+				},
+			}
+
+			var sb strings.Builder
+			document.write(&sb, 0)
+			model.Xml = sb.String()
+
+			// the generated document is validated in the same way as the policies of the resources, which catches both a
+			// malformed document and a policy which is rendered with missing attributes or in the wrong section - since
+			// only known policies are generated, unknown policies are also treated as an error rather than a warning
+			warnings, errs := validate.ApiManagementPolicyXml(model.Xml, false)
+			for _, warning := range warnings {
+				errs = append(errs, errors.New(warning))
+			}
+			if len(errs) > 0 {
+				return fmt.Errorf("validating the generated policy document: %+v", errors.Join(errs...))
+			}
+
+			hash := sha256.Sum256([]byte(model.Xml))
+			metadata.ResourceData.SetId(hex.EncodeToString(hash[:]))
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func expandApiManagementPolicyDocumentLlmTokenLimit(input []ApiManagementPolicyDocumentLlmTokenLimitModel) []policyElement {
+	if len(input) == 0 {
+		return nil
+	}
+	v := input[0]
+
+	element := policyElement{name: "llm-token-limit"}
+	element.addAttribute("counter-key", v.CounterKey)
+	if v.TokensPerMinute > 0 {
+		element.addAttribute("tokens-per-minute", strconv.FormatInt(v.TokensPerMinute, 10))
+	}
+	if v.TokenQuota > 0 {
+		element.addAttribute("token-quota", strconv.FormatInt(v.TokenQuota, 10))
+		element.addAttribute("token-quota-period", v.TokenQuotaPeriod)
+	}
+	element.addAttribute("estimate-prompt-tokens", strconv.FormatBool(v.EstimatePromptTokensEnabled))
+	element.addAttribute("retry-after-header-name", v.RetryAfterHeaderName)
+	element.addAttribute("remaining-tokens-header-name", v.RemainingTokensHeaderName)
+	element.addAttribute("tokens-consumed-header-name", v.TokensConsumedHeaderName)
+
+	return []policyElement{element}
+}
+
+func expandApiManagementPolicyDocumentLlmEmitTokenMetric(input []ApiManagementPolicyDocumentLlmEmitTokenMetricModel) []policyElement {
+	if len(input) == 0 {
+		return nil
+	}
+	v := input[0]
+
+	element := policyElement{name: "llm-emit-token-metric"}
+	element.addAttribute("namespace", v.Namespace)
+	for _, dimension := range v.Dimension {
+		child := policyElement{name: "dimension"}
+		child.addAttribute("name", dimension.Name)
+		child.addAttribute("value", dimension.Value)
+		element.children = append(element.children, child)
+	}
+
+	return []policyElement{element}
+}
+
+func expandApiManagementPolicyDocumentSemanticCacheLookup(input []ApiManagementPolicyDocumentSemanticCacheLookupModel) []policyElement {
+	if len(input) == 0 {
+		return nil
+	}
+	v := input[0]
+
+	element := policyElement{name: "azure-openai-semantic-cache-lookup"}
+	element.addAttribute("score-threshold", strconv.FormatFloat(v.ScoreThreshold, 'f', -1, 64))
+	element.addAttribute("embeddings-backend-id", v.EmbeddingsBackendId)
+	element.addAttribute("embeddings-backend-auth", v.EmbeddingsBackendAuth)
+	element.addAttribute("ignore-system-messages", strconv.FormatBool(v.IgnoreSystemMessagesEnabled))
+	if v.MaxMessageCount > 0 {
+		element.addAttribute("max-message-count", strconv.FormatInt(v.MaxMessageCount, 10))
+	}
+	for _, varyBy := range v.VaryBy {
+		element.children = append(element.children, policyElement{name: "vary-by", text: varyBy})
+	}
+
+	return []policyElement{element}
+}
+
+func expandApiManagementPolicyDocumentSemanticCacheStore(input []ApiManagementPolicyDocumentSemanticCacheStoreModel) []policyElement {
+	if len(input) == 0 {
+		return nil
+	}
+
+	element := policyElement{name: "azure-openai-semantic-cache-store"}
+	element.addAttribute("duration", strconv.FormatInt(input[0].DurationInSeconds, 10))
+
+	return []policyElement{element}
+}
+
+func expandApiManagementPolicyDocumentSetBackendService(input []ApiManagementPolicyDocumentSetBackendServiceModel) []policyElement {
+	if len(input) == 0 {
+		return nil
+	}
+
+	element := policyElement{name: "set-backend-service"}
+	element.addAttribute("backend-id", input[0].BackendId)
+	element.addAttribute("base-url", input[0].BaseUrl)
+
+	return []policyElement{element}
+}
+
+// policyElement is an element of a policy document, which is rendered with the indentation and self-closing
+// elements used in the documents returned by API Management
+type policyElement struct {
+	name       string
+	attributes [][2]string
+	children   []policyElement
+	text       string
+}
+
+// addAttribute adds an attribute to the element, omitting it when the value is empty
+func (e *policyElement) addAttribute(name, value string) {
+	if value != "" {
+		e.attributes = append(e.attributes, [2]string{name, value})
+	}
+}
+
+func (e policyElement) write(sb *strings.Builder, depth int) {
+	indent := strings.Repeat("\t", depth)
+
+	sb.WriteString(indent + "<" + e.name)
+	for _, attribute := range e.attributes {
+		sb.WriteString(" " + attribute[0] + "=\"" + escapePolicyXml(attribute[1]) + "\"")
+	}
+
+	switch {
+	case e.text != "":
+		sb.WriteString(">" + escapePolicyXml(e.text) + "</" + e.name + ">\n")
+	case len(e.children) > 0:
+		sb.WriteString(">\n")
+		for _, child := range e.children {
+			child.write(sb, depth+1)
+		}
+		sb.WriteString(indent + "</" + e.name + ">\n")
+	default:
+		sb.WriteString(" />\n")
+	}
+}
+
+func escapePolicyXml(input string) string {
+	var sb strings.Builder
+	// writing to a strings.Builder can't fail, so neither can escaping
+	_ = xml.EscapeText(&sb, []byte(input))
+	return sb.String()
+}
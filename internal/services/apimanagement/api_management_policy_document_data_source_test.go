@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ApiManagementPolicyDocumentDataSource struct{}
+
+func TestAccDataSourceApiManagementPolicyDocument_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_api_management_policy_document", "test")
+	r := ApiManagementPolicyDocumentDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("xml").HasValue(`<policies>
+	<inbound>
+		<base />
+		<set-backend-service backend-id="openai-pool" />
+	</inbound>
+	<backend>
+		<base />
+	</backend>
+	<outbound>
+		<base />
+	</outbound>
+	<on-error>
+		<base />
+	</on-error>
+</policies>
+`),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceApiManagementPolicyDocument_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_api_management_policy_document", "test")
+	r := ApiManagementPolicyDocumentDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.complete(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("xml").MatchesRegex(regexp.MustCompile(`<llm-token-limit counter-key="@\(context.Subscription.Id\)" tokens-per-minute="5000" token-quota="100000" token-quota-period="Monthly" estimate-prompt-tokens="true" remaining-tokens-header-name="x-remaining-tokens" />`)),
+				check.That(data.ResourceName).Key("xml").MatchesRegex(regexp.MustCompile(`<dimension name="API ID" />`)),
+				check.That(data.ResourceName).Key("xml").MatchesRegex(regexp.MustCompile(`<dimension name="Client IP" value="@\(context.Request.IpAddress\)" />`)),
+				check.That(data.ResourceName).Key("xml").MatchesRegex(regexp.MustCompile(`<azure-openai-semantic-cache-lookup score-threshold="0.05" embeddings-backend-id="embeddings" embeddings-backend-auth="system-assigned" ignore-system-messages="true" max-message-count="10">`)),
+				check.That(data.ResourceName).Key("xml").MatchesRegex(regexp.MustCompile(`<vary-by>@\(context.Subscription.Id\)</vary-by>`)),
+				check.That(data.ResourceName).Key("xml").MatchesRegex(regexp.MustCompile(`<azure-openai-semantic-cache-store duration="60" />`)),
+				check.That(data.ResourceName).Key("xml").MatchesRegex(regexp.MustCompile(`<set-backend-service base-url="https://example.openai.azure.com/openai" />`)),
+			),
+		},
+	})
+}
+
+func (ApiManagementPolicyDocumentDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_api_management_policy_document" "test" {
+  set_backend_service {
+    backend_id = "openai-pool"
+  }
+}
+`
+}
+
+func (ApiManagementPolicyDocumentDataSource) complete() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_api_management_policy_document" "test" {
+  llm_token_limit {
+    counter_key                    = "@(context.Subscription.Id)"
+    tokens_per_minute              = 5000
+    token_quota                    = 100000
+    token_quota_period             = "Monthly"
+    estimate_prompt_tokens_enabled = true
+    remaining_tokens_header_name   = "x-remaining-tokens"
+  }
+
+  llm_emit_token_metric {
+    namespace = "openai"
+
+    dimension {
+      name = "API ID"
+    }
+
+    dimension {
+      name  = "Client IP"
+      value = "@(context.Request.IpAddress)"
+    }
+  }
+
+  azure_openai_semantic_cache_lookup {
+    score_threshold                = 0.05
+    embeddings_backend_id          = "embeddings"
+    embeddings_backend_auth        = "system-assigned"
+    ignore_system_messages_enabled = true
+    max_message_count              = 10
+    vary_by                        = ["@(context.Subscription.Id)"]
+  }
+
+  azure_openai_semantic_cache_store {
+    duration_in_seconds = 60
+  }
+
+  set_backend_service {
+    base_url = "https://example.openai.azure.com/openai"
+  }
+}
+`
+}
@@ -12,11 +12,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/namedvalue"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/policyfragment"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apimanagementservice"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -81,6 +83,7 @@ func resourceApiManagementPolicyFragment() *pluginsdk.Resource {
 				Type:             pluginsdk.TypeString,
 				Required:         true,
 				DiffSuppressFunc: XmlWhitespaceDiffSuppress,
+				ValidateFunc:     validate.ApiManagementPolicyXmlWarnings(true),
 			},
 
 			"description": {
@@ -88,6 +91,10 @@ func resourceApiManagementPolicyFragment() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			return validateApiManagementPolicyXmlDiff(d, "value", true)
+		}),
 	}
 }
 
@@ -127,6 +134,11 @@ func resourceApiManagementPolicyFragmentCreate(d *pluginsdk.ResourceData, meta i
 		},
 	}
 
+	namedValueServiceId := namedvalue.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
+	if err := validateApiManagementPolicyNamedValues(ctx, meta.(*clients.Client).ApiManagement.NamedValueClient, namedValueServiceId, value); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
 	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters, policyfragment.CreateOrUpdateOperationOptions{}); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -169,6 +181,11 @@ func resourceApiManagementPolicyFragmentUpdate(d *pluginsdk.ResourceData, meta i
 	}
 
 	if d.HasChange("value") {
+		namedValueServiceId := namedvalue.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
+		if err := validateApiManagementPolicyNamedValues(ctx, meta.(*clients.Client).ApiManagement.NamedValueClient, namedValueServiceId, d.Get("value").(string)); err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+
 		payload.Properties.Value = d.Get("value").(string)
 	}
 
@@ -4,6 +4,7 @@
 package apimanagement
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"html"
@@ -12,10 +13,12 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/namedvalue"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/policy"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apimanagementservice"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -61,6 +64,7 @@ func resourceApiManagementPolicy() *pluginsdk.Resource {
 				ConflictsWith:    []string{"xml_link"},
 				ExactlyOneOf:     []string{"xml_link", "xml_content"},
 				DiffSuppressFunc: XmlWithDotNetInterpolationsDiffSuppress,
+				ValidateFunc:     validate.ApiManagementPolicyXmlWarnings(false),
 			},
 
 			"xml_link": {
@@ -70,6 +74,10 @@ func resourceApiManagementPolicy() *pluginsdk.Resource {
 				ExactlyOneOf:  []string{"xml_link", "xml_content"},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			return validateApiManagementPolicyXmlDiff(d, "xml_content", false)
+		}),
 	}
 }
 
@@ -121,6 +129,14 @@ func resourceApiManagementPolicyCreateUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	policyServiceId := policy.NewServiceID(apiMgmtId.SubscriptionId, resourceGroup, serviceName)
+
+	if xmlLink == "" && d.HasChange("xml_content") {
+		namedValueServiceId := namedvalue.NewServiceID(apiMgmtId.SubscriptionId, resourceGroup, serviceName)
+		if err := validateApiManagementPolicyNamedValues(ctx, meta.(*clients.Client).ApiManagement.NamedValueClient, namedValueServiceId, xmlContent); err != nil {
+			return fmt.Errorf("creating %s: %+v", policyServiceId, err)
+		}
+	}
+
 	if _, err = client.CreateOrUpdate(ctx, policyServiceId, parameters, policy.CreateOrUpdateOperationOptions{}); err != nil {
 		return fmt.Errorf("creating %s: %+v", policyServiceId, err)
 	}
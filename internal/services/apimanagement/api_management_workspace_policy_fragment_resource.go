@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/policyfragment"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -28,7 +29,10 @@ type ApiManagementWorkspacePolicyFragmentModel struct {
 
 type ApiManagementWorkspacePolicyFragmentResource struct{}
 
-var _ sdk.ResourceWithUpdate = ApiManagementWorkspacePolicyFragmentResource{}
+var (
+	_ sdk.ResourceWithUpdate        = ApiManagementWorkspacePolicyFragmentResource{}
+	_ sdk.ResourceWithCustomizeDiff = ApiManagementWorkspacePolicyFragmentResource{}
+)
 
 func (r ApiManagementWorkspacePolicyFragmentResource) ResourceType() string {
 	return "azurerm_api_management_workspace_policy_fragment"
@@ -52,6 +56,7 @@ func (r ApiManagementWorkspacePolicyFragmentResource) Arguments() map[string]*pl
 			Type:             pluginsdk.TypeString,
 			Required:         true,
 			DiffSuppressFunc: XmlWhitespaceDiffSuppress,
+			ValidateFunc:     validate.ApiManagementPolicyXmlWarnings(true),
 		},
 
 		"description": {
@@ -244,3 +249,12 @@ func (r ApiManagementWorkspacePolicyFragmentResource) Delete() sdk.ResourceFunc
 		},
 	}
 }
+
+func (r ApiManagementWorkspacePolicyFragmentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return validateApiManagementPolicyXmlDiff(metadata.ResourceDiff, "xml_content", true)
+		},
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspace"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspacepolicy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -27,7 +28,10 @@ type ApiManagementWorkspacePolicyModel struct {
 
 type ApiManagementWorkspacePolicyResource struct{}
 
-var _ sdk.ResourceWithUpdate = ApiManagementWorkspacePolicyResource{}
+var (
+	_ sdk.ResourceWithUpdate        = ApiManagementWorkspacePolicyResource{}
+	_ sdk.ResourceWithCustomizeDiff = ApiManagementWorkspacePolicyResource{}
+)
 
 func (r ApiManagementWorkspacePolicyResource) ResourceType() string {
 	return "azurerm_api_management_workspace_policy"
@@ -52,6 +56,7 @@ func (r ApiManagementWorkspacePolicyResource) Arguments() map[string]*pluginsdk.
 			Computed:         true,
 			ExactlyOneOf:     []string{"xml_link", "xml_content"},
 			DiffSuppressFunc: XmlWithDotNetInterpolationsDiffSuppress,
+			ValidateFunc:     validate.ApiManagementPolicyXmlWarnings(false),
 		},
 
 		"xml_link": {
@@ -226,3 +231,12 @@ func (r ApiManagementWorkspacePolicyResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+func (r ApiManagementWorkspacePolicyResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return validateApiManagementPolicyXmlDiff(metadata.ResourceDiff, "xml_content", false)
+		},
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/namedvalue"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// validateApiManagementPolicyXmlDiff validates the policy document in `key` during plan, so that invalid policies are
+// reported before they're sent to the API. Only known values which have changed are validated, meaning policies which
+// are already deployed or which were downloaded from an `xml_link` aren't validated.
+//
+// Unknown policies are reported as warnings by the `ValidateFunc` of `key`, since warnings can't be returned here.
+func validateApiManagementPolicyXmlDiff(d *pluginsdk.ResourceDiff, key string, isFragment bool) error {
+	if !d.HasChange(key) || !d.NewValueKnown(key) {
+		return nil
+	}
+
+	value := d.Get(key).(string)
+	if value == "" {
+		return nil
+	}
+
+	if _, errs := validate.ApiManagementPolicyXml(value, isFragment); len(errs) > 0 {
+		return fmt.Errorf("validating the policy in `%s`: %+v", key, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// validateApiManagementPolicyNamedValues checks that the Named Values referenced in the policy document exist within
+// the API Management Service. This is checked when the policy is applied rather than during plan, since Named Values
+// managed in the same configuration as the policy are only created once the policy's dependencies have been applied.
+func validateApiManagementPolicyNamedValues(ctx context.Context, client *namedvalue.NamedValueClient, id namedvalue.ServiceId, content string) error {
+	references := validate.ApiManagementPolicyNamedValueReferences(content)
+	if len(references) == 0 {
+		return nil
+	}
+
+	resp, err := client.ListByServiceComplete(ctx, id, namedvalue.DefaultListByServiceOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing the Named Values within %s: %+v", id, err)
+	}
+
+	// policies reference Named Values by their display name, however the name is also accepted to avoid false positives
+	namedValues := make(map[string]bool)
+	for _, item := range resp.Items {
+		if item.Name != nil {
+			namedValues[strings.ToLower(*item.Name)] = true
+		}
+		if props := item.Properties; props != nil {
+			namedValues[strings.ToLower(props.DisplayName)] = true
+		}
+	}
+
+	missing := make([]string, 0)
+	for _, reference := range references {
+		if !namedValues[strings.ToLower(reference)] {
+			missing = append(missing, reference)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the Named Values `%s` referenced in the policy don't exist within %s, Named Values which are managed in the same configuration must be created before the policy, either by referencing them or through `depends_on`", strings.Join(missing, "`, `"), id)
+	}
+
+	return nil
+}
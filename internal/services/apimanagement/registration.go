@@ -102,6 +102,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 // DataSources returns a list of Data Sources supported by this Service
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		ApiManagementPolicyDocumentDataSource{},
 		ApiManagementSubscriptionDataSource{},
 		ApiManagementWorkspaceDataSource{},
 	}
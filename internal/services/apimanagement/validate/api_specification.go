@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	apiSpecificationHttpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+	apiSpecificationPathParam   = regexp.MustCompile(`{([^{}]+)}`)
+)
+
+// ParseApiSpecification parses an OpenAPI 2.0 (Swagger) or OpenAPI 3.x specification in either JSON or YAML format.
+func ParseApiSpecification(content string) (map[string]interface{}, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal([]byte(content), &raw); err != nil {
+		return nil, fmt.Errorf("parsing specification: %+v", err)
+	}
+
+	spec, ok := normalizeApiSpecificationValue(raw).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("parsing specification: expected an object at the root of the specification")
+	}
+
+	return spec, nil
+}
+
+// ApiSpecificationOperations returns the operations of a parsed OpenAPI specification in the format `METHOD /path`.
+func ApiSpecificationOperations(spec map[string]interface{}) []string {
+	operations := make([]string, 0)
+
+	paths, _ := spec["paths"].(map[string]interface{})
+	for path, v := range paths {
+		item, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, method := range apiSpecificationHttpMethods {
+			if _, ok := item[method]; ok {
+				operations = append(operations, fmt.Sprintf("%s %s", strings.ToUpper(method), path))
+			}
+		}
+	}
+	sort.Strings(operations)
+
+	return operations
+}
+
+// ApiSpecification performs an offline validation of an OpenAPI 2.0 (Swagger) or OpenAPI 3.x specification, returning
+// all the problems found rather than only the first.
+func ApiSpecification(content string) []error {
+	spec, err := ParseApiSpecification(content)
+	if err != nil {
+		return []error{err}
+	}
+
+	errors := make([]error, 0)
+
+	var isOpenApi31 bool
+	switch {
+	case spec["swagger"] != nil:
+		if version := apiSpecificationVersion(spec["swagger"]); version != "2.0" {
+			errors = append(errors, fmt.Errorf("`swagger` must be `2.0`, got %q", version))
+		}
+	case spec["openapi"] != nil:
+		version := apiSpecificationVersion(spec["openapi"])
+		if !strings.HasPrefix(version, "3.") {
+			errors = append(errors, fmt.Errorf("`openapi` must be a 3.x version, got %q", version))
+		}
+		isOpenApi31 = strings.HasPrefix(version, "3.1")
+	default:
+		return append(errors, fmt.Errorf("the specification must contain either a `swagger` or an `openapi` version field"))
+	}
+
+	info, ok := spec["info"].(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("the specification must contain an `info` object"))
+	} else {
+		for _, field := range []string{"title", "version"} {
+			if v, ok := info[field]; !ok || fmt.Sprint(v) == "" {
+				errors = append(errors, fmt.Errorf("`info.%s` must be specified", field))
+			}
+		}
+	}
+
+	paths, ok := spec["paths"].(map[string]interface{})
+	if !ok {
+		if spec["paths"] != nil || !isOpenApi31 {
+			errors = append(errors, fmt.Errorf("the specification must contain a `paths` object"))
+		}
+	}
+
+	pathNames := make([]string, 0, len(paths))
+	for path := range paths {
+		pathNames = append(pathNames, path)
+	}
+	sort.Strings(pathNames)
+
+	operationIds := make(map[string]string)
+	for _, path := range pathNames {
+		if !strings.HasPrefix(path, "/") {
+			errors = append(errors, fmt.Errorf("path %q must begin with `/`", path))
+		}
+
+		item, ok := paths[path].(map[string]interface{})
+		if !ok {
+			errors = append(errors, fmt.Errorf("path %q must be an object", path))
+			continue
+		}
+
+		pathParameters, pathParametersKnown := apiSpecificationPathParameters(item["parameters"])
+
+		for _, method := range apiSpecificationHttpMethods {
+			v, ok := item[method]
+			if !ok {
+				continue
+			}
+			operationName := fmt.Sprintf("%s %s", strings.ToUpper(method), path)
+
+			operation, ok := v.(map[string]interface{})
+			if !ok {
+				errors = append(errors, fmt.Errorf("operation %q must be an object", operationName))
+				continue
+			}
+
+			if v, ok := operation["operationId"]; ok {
+				operationId := fmt.Sprint(v)
+				if existing, ok := operationIds[operationId]; ok {
+					errors = append(errors, fmt.Errorf("operation %q has the `operationId` %q which is already used by operation %q", operationName, operationId, existing))
+				} else {
+					operationIds[operationId] = operationName
+				}
+			}
+
+			if _, ok := operation["responses"]; !ok && !isOpenApi31 {
+				errors = append(errors, fmt.Errorf("operation %q must contain a `responses` object", operationName))
+			}
+
+			operationParameters, operationParametersKnown := apiSpecificationPathParameters(operation["parameters"])
+			if !pathParametersKnown || !operationParametersKnown {
+				// parameters which are references can't be checked without resolving them, so skip the check
+				continue
+			}
+			for _, match := range apiSpecificationPathParam.FindAllStringSubmatch(path, -1) {
+				if !pathParameters[match[1]] && !operationParameters[match[1]] {
+					errors = append(errors, fmt.Errorf("operation %q does not define the path parameter %q", operationName, match[1]))
+				}
+			}
+		}
+	}
+
+	errors = append(errors, validateApiSpecificationReferences(spec, spec, "#")...)
+
+	return errors
+}
+
+// apiSpecificationVersion returns the value of a version field as a string. An unquoted version such as `swagger: 2.0`
+// is decoded from YAML as a number, which is formatted with at least one decimal place so that it matches the quoted form.
+func apiSpecificationVersion(input interface{}) string {
+	if v, ok := input.(float64); ok {
+		version := strconv.FormatFloat(v, 'f', -1, 64)
+		if !strings.Contains(version, ".") {
+			version += ".0"
+		}
+		return version
+	}
+
+	return fmt.Sprint(input)
+}
+
+// apiSpecificationPathParameters returns the names of the inline path parameters, and whether all parameters were inline
+func apiSpecificationPathParameters(input interface{}) (map[string]bool, bool) {
+	names := make(map[string]bool)
+
+	parameters, ok := input.([]interface{})
+	if !ok {
+		return names, true
+	}
+
+	for _, v := range parameters {
+		parameter, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := parameter["$ref"]; ok {
+			return names, false
+		}
+		if fmt.Sprint(parameter["in"]) == "path" {
+			names[fmt.Sprint(parameter["name"])] = true
+		}
+	}
+
+	return names, true
+}
+
+// validateApiSpecificationReferences checks that all local `$ref`s can be resolved within the specification
+func validateApiSpecificationReferences(root map[string]interface{}, input interface{}, location string) []error {
+	errors := make([]error, 0)
+
+	switch v := input.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			if ref, ok := v[k].(string); ok && k == "$ref" {
+				if strings.HasPrefix(ref, "#/") && !apiSpecificationReferenceExists(root, ref) {
+					errors = append(errors, fmt.Errorf("the reference %q at %q could not be resolved", ref, location))
+				}
+				continue
+			}
+			errors = append(errors, validateApiSpecificationReferences(root, v[k], fmt.Sprintf("%s/%s", location, k))...)
+		}
+	case []interface{}:
+		for i, item := range v {
+			errors = append(errors, validateApiSpecificationReferences(root, item, fmt.Sprintf("%s/%d", location, i))...)
+		}
+	}
+
+	return errors
+}
+
+func apiSpecificationReferenceExists(root map[string]interface{}, ref string) bool {
+	var current interface{} = root
+	for _, segment := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if current, ok = object[segment]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeApiSpecificationValue converts the maps decoded from YAML, which may have non-string keys such as
+// response codes, into maps with string keys
+func normalizeApiSpecificationValue(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			v[k] = normalizeApiSpecificationValue(item)
+		}
+		return v
+	case map[interface{}]interface{}:
+		output := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			output[fmt.Sprint(k)] = normalizeApiSpecificationValue(item)
+		}
+		return output
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeApiSpecificationValue(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
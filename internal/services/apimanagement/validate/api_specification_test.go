@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestApiSpecification(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Value  string
+		Errors int
+	}{
+		{
+			Name:   "not an object",
+			Value:  `["a"]`,
+			Errors: 1,
+		},
+		{
+			Name:   "invalid yaml",
+			Value:  "openapi: 3.0.1\n  info: [",
+			Errors: 1,
+		},
+		{
+			Name:   "missing version",
+			Value:  `{"info": {"title": "a", "version": "1"}, "paths": {}}`,
+			Errors: 1,
+		},
+		{
+			Name: "valid swagger json",
+			Value: `{
+  "swagger": "2.0",
+  "info": {"title": "Pets", "version": "1.0"},
+  "paths": {
+    "/pets/{petId}": {
+      "get": {
+        "operationId": "getPet",
+        "parameters": [{"name": "petId", "in": "path", "required": true, "type": "string"}],
+        "responses": {"200": {"description": "ok", "schema": {"$ref": "#/definitions/Pet"}}}
+      }
+    }
+  },
+  "definitions": {"Pet": {"type": "object"}}
+}`,
+			Errors: 0,
+		},
+		{
+			Name: "valid swagger yaml with an unquoted version",
+			Value: `swagger: 2.0
+info:
+  title: Pets
+  version: "1.0"
+paths:
+  /pets:
+    get:
+      responses:
+        200:
+          description: ok
+`,
+			Errors: 0,
+		},
+		{
+			Name: "swagger yaml with an unsupported unquoted version",
+			Value: `swagger: 1.2
+info:
+  title: Pets
+  version: "1.0"
+paths: {}
+`,
+			Errors: 1,
+		},
+		{
+			Name: "valid openapi yaml with an unquoted version",
+			Value: `openapi: 3.0
+info:
+  title: Pets
+  version: "1.0"
+paths: {}
+`,
+			Errors: 0,
+		},
+		{
+			Name: "valid openapi yaml",
+			Value: `openapi: 3.0.1
+info:
+  title: Pets
+  version: "1.0"
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        200:
+          description: ok
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pets'
+    post:
+      operationId: createPet
+      responses:
+        201:
+          description: created
+components:
+  schemas:
+    Pets:
+      type: array
+`,
+			Errors: 0,
+		},
+		{
+			Name: "openapi 3.1 without paths",
+			Value: `openapi: 3.1.0
+info:
+  title: Webhooks
+  version: "1.0"
+webhooks: {}
+`,
+			Errors: 0,
+		},
+		{
+			Name: "invalid openapi",
+			Value: `openapi: 3.0.1
+info:
+  title: Pets
+paths:
+  pets:
+    get:
+      operationId: listPets
+      responses: {}
+  /pets/{petId}:
+    get:
+      operationId: listPets
+      responses:
+        200:
+          $ref: '#/components/responses/Missing'
+`,
+			// missing `info.version`, path without a leading `/`, duplicate operationId, undefined path parameter and unresolved reference
+			Errors: 5,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if errors := ApiSpecification(v.Value); len(errors) != v.Errors {
+			t.Fatalf("expected %d errors but got %d: %+v", v.Errors, len(errors), errors)
+		}
+	}
+}
+
+func TestApiSpecificationOperations(t *testing.T) {
+	spec, err := ParseApiSpecification(`
+openapi: 3.0.1
+info:
+  title: Pets
+  version: "1.0"
+paths:
+  /pets:
+    summary: Pets
+    get:
+      responses: {}
+    post:
+      responses: {}
+  /pets/{petId}:
+    parameters: []
+    delete:
+      responses: {}
+`)
+	if err != nil {
+		t.Fatalf("parsing specification: %+v", err)
+	}
+
+	expected := []string{"DELETE /pets/{petId}", "GET /pets", "POST /pets"}
+	if actual := ApiSpecificationOperations(spec); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type graphQLToken struct {
+	value    string
+	isString bool
+	line     int
+}
+
+var graphQLTypeDefinitionKeywords = map[string]bool{
+	"enum":      true,
+	"input":     true,
+	"interface": true,
+	"scalar":    true,
+	"type":      true,
+	"union":     true,
+}
+
+// GraphQLSchemaDefinition performs an offline validation of a GraphQL Schema Definition Language (SDL) document, checking
+// the structure of the definitions, that type names are unique and that the query root operation type is defined.
+func GraphQLSchemaDefinition(content string) []error {
+	tokens, err := tokenizeGraphQL(content)
+	if err != nil {
+		return []error{err}
+	}
+
+	errors := make([]error, 0)
+	types := make(map[string]int)
+	rootTypes := make(map[string]string)
+
+	for i := 0; i < len(tokens); {
+		token := tokens[i]
+
+		// descriptions can precede any definition
+		if token.isString {
+			i++
+			continue
+		}
+
+		keyword := token.value
+		isExtension := keyword == "extend"
+		if isExtension {
+			i++
+			if i >= len(tokens) {
+				errors = append(errors, fmt.Errorf("line %d: expected a definition after `extend`", token.line))
+				break
+			}
+			keyword = tokens[i].value
+		}
+
+		switch {
+		case keyword == "schema":
+			i++
+			block, next, err := graphQLBlock(tokens, i, token.line)
+			if err != nil {
+				errors = append(errors, err)
+				return errors
+			}
+			for j := 0; j+2 < len(block); j++ {
+				if block[j+1].value == ":" {
+					rootTypes[block[j].value] = block[j+2].value
+					j += 2
+				}
+			}
+			i = next
+		case keyword == "directive":
+			i = graphQLSkipDefinition(tokens, i+1)
+		case graphQLTypeDefinitionKeywords[keyword]:
+			i++
+			if i >= len(tokens) || !isGraphQLName(tokens[i].value) {
+				errors = append(errors, fmt.Errorf("line %d: expected a name after `%s`", token.line, keyword))
+				i = graphQLSkipDefinition(tokens, i)
+				continue
+			}
+
+			name := tokens[i].value
+			if line, ok := types[name]; ok && !isExtension {
+				errors = append(errors, fmt.Errorf("line %d: the type %q is already defined on line %d", tokens[i].line, name, line))
+			} else if !ok {
+				types[name] = tokens[i].line
+			}
+			i = graphQLSkipDefinition(tokens, i+1)
+		default:
+			errors = append(errors, fmt.Errorf("line %d: unexpected %q, expected a definition", token.line, token.value))
+			i = graphQLSkipDefinition(tokens, i+1)
+		}
+	}
+
+	if len(types) == 0 && len(errors) == 0 {
+		return []error{fmt.Errorf("the schema does not contain any type definitions")}
+	}
+
+	queryType := "Query"
+	if v, ok := rootTypes["query"]; ok {
+		queryType = v
+	}
+	if _, ok := types[queryType]; !ok {
+		errors = append(errors, fmt.Errorf("the query root operation type %q is not defined", queryType))
+	}
+	for operation, name := range rootTypes {
+		if _, ok := types[name]; !ok && operation != "query" {
+			errors = append(errors, fmt.Errorf("the %s root operation type %q is not defined", operation, name))
+		}
+	}
+
+	return errors
+}
+
+// graphQLSkipDefinition returns the index of the token starting the next definition, skipping over any blocks
+func graphQLSkipDefinition(tokens []graphQLToken, i int) int {
+	depth := 0
+	for ; i < len(tokens); i++ {
+		switch tokens[i].value {
+		case "{", "(", "[":
+			if !tokens[i].isString {
+				depth++
+			}
+		case "}", ")", "]":
+			if !tokens[i].isString {
+				depth--
+				if depth == 0 && tokens[i].value == "}" {
+					return i + 1
+				}
+			}
+		default:
+			if depth == 0 && (tokens[i].isString || graphQLTypeDefinitionKeywords[tokens[i].value] || tokens[i].value == "extend" || tokens[i].value == "schema" || tokens[i].value == "directive") {
+				// a keyword is only the start of a definition when it's not used as a name, e.g. `union Type = type`
+				if i > 0 && (tokens[i-1].value == "=" || tokens[i-1].value == "|" || tokens[i-1].value == "&" || tokens[i-1].value == ":" || tokens[i-1].value == "@" || tokens[i-1].value == "on") {
+					continue
+				}
+				return i
+			}
+		}
+	}
+
+	return i
+}
+
+// graphQLBlock returns the tokens within the `{ }` block starting at i, and the index after the block
+func graphQLBlock(tokens []graphQLToken, i int, line int) ([]graphQLToken, int, error) {
+	for i < len(tokens) && tokens[i].value != "{" {
+		i++
+	}
+	if i >= len(tokens) {
+		return nil, i, fmt.Errorf("line %d: expected `{`", line)
+	}
+
+	start := i + 1
+	for i = start; i < len(tokens); i++ {
+		if tokens[i].value == "}" && !tokens[i].isString {
+			return tokens[start:i], i + 1, nil
+		}
+	}
+
+	return nil, i, fmt.Errorf("line %d: expected `}`", line)
+}
+
+func isGraphQLName(input string) bool {
+	if input == "" {
+		return false
+	}
+	for i, r := range input {
+		if r == '_' || (r < unicode.MaxASCII && unicode.IsLetter(r)) || (i > 0 && r >= '0' && r <= '9') {
+			continue
+		}
+		return false
+	}
+
+	return true
+}
+
+// tokenizeGraphQL splits a GraphQL document into tokens, ignoring comments and checking that brackets are balanced
+func tokenizeGraphQL(content string) ([]graphQLToken, error) {
+	tokens := make([]graphQLToken, 0)
+	brackets := make([]graphQLToken, 0)
+	pairs := map[string]string{"}": "{", ")": "(", "]": "["}
+
+	line := 1
+	runes := []rune(content)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case r == '\n':
+			line++
+		case unicode.IsSpace(r) || r == ',' || r == '\uFEFF':
+		case r == '#':
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case r == '"':
+			start := line
+			if i+2 < len(runes) && runes[i+1] == '"' && runes[i+2] == '"' {
+				j := i + 3
+				for ; j+2 < len(runes); j++ {
+					if runes[j] == '"' && runes[j+1] == '"' && runes[j+2] == '"' && runes[j-1] != '\\' {
+						break
+					}
+				}
+				if j+2 >= len(runes) {
+					return nil, fmt.Errorf("line %d: unterminated block string", start)
+				}
+				value := string(runes[i+3 : j])
+				line += strings.Count(value, "\n")
+				tokens = append(tokens, graphQLToken{value: value, isString: true, line: start})
+				i = j + 2
+				continue
+			}
+
+			j := i + 1
+			for ; j < len(runes) && runes[j] != '"' && runes[j] != '\n'; j++ {
+				if runes[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(runes) || runes[j] != '"' {
+				return nil, fmt.Errorf("line %d: unterminated string", start)
+			}
+			tokens = append(tokens, graphQLToken{value: string(runes[i+1 : j]), isString: true, line: start})
+			i = j
+		case strings.ContainsRune("{([", r):
+			token := graphQLToken{value: string(r), line: line}
+			tokens = append(tokens, token)
+			brackets = append(brackets, token)
+		case strings.ContainsRune("})]", r):
+			if len(brackets) == 0 || brackets[len(brackets)-1].value != pairs[string(r)] {
+				return nil, fmt.Errorf("line %d: unexpected `%c`", line, r)
+			}
+			brackets = brackets[:len(brackets)-1]
+			tokens = append(tokens, graphQLToken{value: string(r), line: line})
+		case strings.ContainsRune("!$&:=@|", r):
+			tokens = append(tokens, graphQLToken{value: string(r), line: line})
+		case r == '.':
+			if !strings.HasPrefix(string(runes[i:]), "...") {
+				return nil, fmt.Errorf("line %d: unexpected `.`", line)
+			}
+			tokens = append(tokens, graphQLToken{value: "...", line: line})
+			i += 2
+		case r == '_' || r == '-' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			j := i
+			for j < len(runes) && (runes[j] == '_' || runes[j] == '-' || runes[j] == '.' || runes[j] == '+' || unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j])) {
+				j++
+			}
+			tokens = append(tokens, graphQLToken{value: string(runes[i:j]), line: line})
+			i = j - 1
+		default:
+			return nil, fmt.Errorf("line %d: unexpected character %q", line, r)
+		}
+	}
+
+	if len(brackets) > 0 {
+		unclosed := brackets[len(brackets)-1]
+		return nil, fmt.Errorf("line %d: `%s` is not closed", unclosed.line, unclosed.value)
+	}
+
+	return tokens, nil
+}
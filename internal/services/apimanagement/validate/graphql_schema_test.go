@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestGraphQLSchemaDefinition(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Value  string
+		Errors int
+	}{
+		{
+			Name:   "empty",
+			Value:  "# nothing here",
+			Errors: 1,
+		},
+		{
+			Name: "valid",
+			Value: `
+"""
+The root query
+"""
+type Query {
+  "Finds a pet"
+  pet(id: ID!): Pet
+  pets(first: Int = 10, tags: [String!]): [Pet!]!
+}
+
+interface Node {
+  id: ID!
+}
+
+type Pet implements Node & Named @key(fields: "id") {
+  id: ID!
+  name: String
+  kind: Kind
+}
+
+interface Named {
+  name: String
+}
+
+enum Kind {
+  CAT
+  DOG
+}
+
+scalar Date
+
+union SearchResult = Pet | Query
+
+input PetFilter {
+  name: String = "type"
+}
+
+directive @key(fields: String!) repeatable on OBJECT | INTERFACE
+
+extend type Pet {
+  born: Date
+}
+`,
+			Errors: 0,
+		},
+		{
+			Name: "custom root types",
+			Value: `
+schema {
+  query: RootQuery
+  mutation: RootMutation
+}
+
+type RootQuery {
+  ping: String
+}
+
+type RootMutation {
+  ping: String
+}
+`,
+			Errors: 0,
+		},
+		{
+			Name: "missing query type",
+			Value: `
+type Pet {
+  id: ID!
+}
+`,
+			Errors: 1,
+		},
+		{
+			Name: "undefined mutation type",
+			Value: `
+schema {
+  query: Query
+  mutation: Mutation
+}
+
+type Query {
+  ping: String
+}
+`,
+			Errors: 1,
+		},
+		{
+			Name: "duplicate type",
+			Value: `
+type Query {
+  ping: String
+}
+
+type Query {
+  pong: String
+}
+`,
+			Errors: 1,
+		},
+		{
+			Name: "unbalanced braces",
+			Value: `
+type Query {
+  ping(id: ID!: String
+}
+`,
+			Errors: 1,
+		},
+		{
+			Name: "unterminated string",
+			Value: `
+"""
+type Query {
+  ping: String
+}
+`,
+			Errors: 1,
+		},
+		{
+			Name: "invalid name",
+			Value: `
+type Query {
+  ping: String
+}
+
+type my-type {
+  id: ID
+}
+`,
+			Errors: 1,
+		},
+		{
+			Name: "unknown definition",
+			Value: `
+type Query {
+  ping: String
+}
+
+query {
+  ping
+}
+`,
+			Errors: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if errors := GraphQLSchemaDefinition(v.Value); len(errors) != v.Errors {
+			t.Fatalf("expected %d errors but got %d: %+v", v.Errors, len(errors), errors)
+		}
+	}
+}
@@ -0,0 +1,420 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+const (
+	policySectionInbound  = "inbound"
+	policySectionBackend  = "backend"
+	policySectionOutbound = "outbound"
+	policySectionOnError  = "on-error"
+)
+
+var (
+	policySections = []string{policySectionInbound, policySectionBackend, policySectionOutbound, policySectionOnError}
+
+	policyNamedValueReference = regexp.MustCompile(`{{([^{}]*)}}`)
+	policyNamedValueName      = regexp.MustCompile(`^[0-9a-zA-Z_.-]{1,256}$`)
+)
+
+// policyDefinition describes a policy element, the sections it can be used in and the attributes which must be specified,
+// where each entry of `required` is a list of attributes of which at least one must be specified
+type policyDefinition struct {
+	sections []string
+	required [][]string
+}
+
+var (
+	policyAllSections             = policySections
+	policyInboundOnly             = []string{policySectionInbound}
+	policyOutboundOnly            = []string{policySectionOutbound}
+	policyInboundOutboundOnError  = []string{policySectionInbound, policySectionOutbound, policySectionOnError}
+	policyOutboundOnErrorSections = []string{policySectionOutbound, policySectionOnError}
+)
+
+// policyDefinitions contains the policies documented in the API Management policy reference
+// https://learn.microsoft.com/azure/api-management/api-management-policies
+var policyDefinitions = map[string]policyDefinition{
+	"authentication-basic":               {sections: policyInboundOnly, required: [][]string{{"username"}, {"password"}}},
+	"authentication-certificate":         {sections: policyInboundOnly, required: [][]string{{"thumbprint", "certificate-id", "body"}}},
+	"authentication-managed-identity":    {sections: policyInboundOnly, required: [][]string{{"resource"}}},
+	"azure-openai-emit-token-metric":     {sections: policyInboundOnly},
+	"azure-openai-semantic-cache-lookup": {sections: policyInboundOnly, required: [][]string{{"score-threshold"}, {"embeddings-backend-id"}}},
+	"azure-openai-semantic-cache-store":  {sections: policyOutboundOnly, required: [][]string{{"duration"}}},
+	"azure-openai-token-limit":           {sections: policyInboundOnly, required: [][]string{{"counter-key"}, {"tokens-per-minute", "token-quota"}}},
+	"base":                               {sections: policyAllSections},
+	"cache-lookup":                       {sections: policyInboundOnly, required: [][]string{{"vary-by-developer"}, {"vary-by-developer-groups"}}},
+	"cache-lookup-value":                 {sections: policyAllSections, required: [][]string{{"key"}, {"variable-name"}}},
+	"cache-remove-value":                 {sections: policyAllSections, required: [][]string{{"key"}}},
+	"cache-store":                        {sections: policyOutboundOnly, required: [][]string{{"duration"}}},
+	"cache-store-value":                  {sections: policyAllSections, required: [][]string{{"key"}, {"value"}, {"duration"}}},
+	"check-header":                       {sections: policyInboundOnly, required: [][]string{{"name"}, {"failed-check-httpcode"}, {"failed-check-error-message"}, {"ignore-case"}}},
+	"choose":                             {sections: policyAllSections},
+	"cors":                               {sections: policyInboundOnly},
+	"cross-domain":                       {sections: policyInboundOnly},
+	"emit-metric":                        {sections: policyAllSections, required: [][]string{{"name"}}},
+	"find-and-replace":                   {sections: policyAllSections, required: [][]string{{"from"}, {"to"}}},
+	"forward-request":                    {sections: []string{policySectionBackend}},
+	"get-authorization-context":          {sections: policyInboundOnly, required: [][]string{{"provider-id"}, {"authorization-id"}, {"context-variable-name"}}},
+	"include-fragment":                   {sections: policyAllSections, required: [][]string{{"fragment-id"}}},
+	"invoke-dapr-binding":                {sections: policyInboundOutboundOnError, required: [][]string{{"name"}}},
+	"ip-filter":                          {sections: policyInboundOnly, required: [][]string{{"action"}}},
+	"json-to-xml":                        {sections: policyInboundOutboundOnError, required: [][]string{{"apply"}}},
+	"jsonp":                              {sections: policyOutboundOnly, required: [][]string{{"callback-parameter-name"}}},
+	"limit-concurrency":                  {sections: policyAllSections, required: [][]string{{"key"}, {"max-count"}}},
+	"llm-content-safety":                 {sections: policyInboundOnly, required: [][]string{{"backend-id"}}},
+	"llm-emit-token-metric":              {sections: policyInboundOnly},
+	"llm-semantic-cache-lookup":          {sections: policyInboundOnly, required: [][]string{{"score-threshold"}, {"embeddings-backend-id"}}},
+	"llm-semantic-cache-store":           {sections: policyOutboundOnly, required: [][]string{{"duration"}}},
+	"llm-token-limit":                    {sections: policyInboundOnly, required: [][]string{{"counter-key"}, {"tokens-per-minute", "token-quota"}}},
+	"log-to-eventhub":                    {sections: policyAllSections, required: [][]string{{"logger-id"}}},
+	"mock-response":                      {sections: policyInboundOutboundOnError},
+	"proxy":                              {sections: policyAllSections, required: [][]string{{"url"}}},
+	"publish-to-dapr":                    {sections: policyAllSections, required: [][]string{{"topic"}}},
+	"quota":                              {sections: policyInboundOnly, required: [][]string{{"renewal-period"}, {"calls", "bandwidth"}}},
+	"quota-by-key":                       {sections: policyInboundOnly, required: [][]string{{"counter-key"}, {"renewal-period"}, {"calls", "bandwidth"}}},
+	"rate-limit":                         {sections: policyInboundOnly, required: [][]string{{"calls"}, {"renewal-period"}}},
+	"rate-limit-by-key":                  {sections: policyInboundOnly, required: [][]string{{"counter-key"}, {"calls"}, {"renewal-period"}}},
+	"redirect-content-urls":              {sections: []string{policySectionInbound, policySectionOutbound}},
+	"retry":                              {sections: policyAllSections, required: [][]string{{"condition"}, {"count"}, {"interval"}}},
+	"return-response":                    {sections: policyAllSections},
+	"rewrite-uri":                        {sections: policyInboundOnly, required: [][]string{{"template"}}},
+	"send-one-way-request":               {sections: policyAllSections},
+	"send-request":                       {sections: policyAllSections},
+	"set-backend-service":                {sections: []string{policySectionInbound, policySectionBackend}, required: [][]string{{"base-url", "backend-id"}}},
+	"set-body":                           {sections: policyAllSections},
+	"set-header":                         {sections: policyAllSections, required: [][]string{{"name"}}},
+	"set-method":                         {sections: []string{policySectionInbound, policySectionOnError}},
+	"set-query-parameter":                {sections: []string{policySectionInbound, policySectionBackend}, required: [][]string{{"name"}}},
+	"set-status":                         {sections: policyAllSections, required: [][]string{{"code"}}},
+	"set-variable":                       {sections: policyAllSections, required: [][]string{{"name"}, {"value"}}},
+	"trace":                              {sections: policyAllSections, required: [][]string{{"source"}}},
+	"validate-azure-ad-token":            {sections: policyInboundOnly, required: [][]string{{"tenant-id"}}},
+	"validate-client-certificate":        {sections: policyInboundOnly},
+	"validate-content":                   {sections: policyInboundOutboundOnError, required: [][]string{{"unspecified-content-type-action"}, {"max-size"}, {"size-exceeded-action"}}},
+	"validate-graphql-request":           {sections: policyInboundOnly},
+	"validate-headers":                   {sections: policyOutboundOnErrorSections, required: [][]string{{"specified-header-action"}, {"unspecified-header-action"}}},
+	"validate-jwt":                       {sections: policyInboundOnly, required: [][]string{{"header-name", "query-parameter-name", "token-value"}}},
+	"validate-odata-request":             {sections: policyInboundOnly},
+	"validate-parameters":                {sections: policyInboundOnly, required: [][]string{{"specified-parameter-action"}, {"unspecified-parameter-action"}}},
+	"validate-status-code":               {sections: policyOutboundOnErrorSections, required: [][]string{{"unspecified-status-code-action"}}},
+	"wait":                               {sections: policyAllSections},
+	"xml-to-json":                        {sections: policyInboundOutboundOnError, required: [][]string{{"kind"}, {"apply"}}},
+	"xsl-transform":                      {sections: []string{policySectionInbound, policySectionOutbound}},
+}
+
+// policyContainers are the policies which contain other policies, rather than configuration elements
+var policyContainers = map[string]bool{
+	"limit-concurrency": true,
+	"retry":             true,
+	"wait":              true,
+}
+
+// ApiManagementPolicyXmlWarnings returns a validation function which reports the elements in a policy document which
+// aren't a known policy, so that these are shown as warnings whenever the policy is planned. The errors returned by
+// ApiManagementPolicyXml aren't returned here, since these are only reported when the policy changes.
+func ApiManagementPolicyXmlWarnings(isFragment bool) func(interface{}, string) ([]string, []error) {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+		}
+		if v == "" {
+			return nil, nil
+		}
+
+		policyWarnings, _ := ApiManagementPolicyXml(v, isFragment)
+		for _, warning := range policyWarnings {
+			warnings = append(warnings, fmt.Sprintf("validating the policy in %q: %s", k, warning))
+		}
+
+		return warnings, nil
+	}
+}
+
+// ApiManagementPolicyNamedValueReferences returns the distinct names of the Named Values referenced in a policy
+// document, excluding any references within policy expressions or with an invalid name.
+func ApiManagementPolicyNamedValueReferences(content string) []string {
+	references := make([]string, 0)
+
+	masked, err := maskPolicyExpressions(content)
+	if err != nil {
+		return references
+	}
+
+	seen := make(map[string]bool)
+	for _, match := range policyNamedValueReference.FindAllStringSubmatch(masked, -1) {
+		if !policyNamedValueName.MatchString(match[1]) || seen[match[1]] {
+			continue
+		}
+		seen[match[1]] = true
+		references = append(references, match[1])
+	}
+
+	return references
+}
+
+type policyNode struct {
+	name       string
+	attributes map[string]string
+	children   []*policyNode
+	line       int
+}
+
+// ApiManagementPolicyXml performs an offline validation of an API Management policy document, checking that it's
+// well-formed XML, the structure of the `inbound`, `backend`, `outbound` and `on-error` sections, that the policies
+// are known and can be used in the section they're in, that required attributes are specified and that references to
+// Named Values have valid names. When `isFragment` is true the document is validated as a Policy Fragment.
+//
+// Elements which aren't a known policy are returned as warnings rather than errors, since new policies are added to API
+// Management over time and the list of known policies may be out of date. Errors are returned for problems with the
+// structure of the document, or with the known policies.
+//
+// Policy expressions (`@(...)` and `@{...}`) aren't required to be escaped, so these are masked before the document is
+// parsed and aren't validated.
+func ApiManagementPolicyXml(content string, isFragment bool) (warnings []string, errs []error) {
+	masked, err := maskPolicyExpressions(content)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	root, err := parsePolicyXml(masked)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	warnings = make([]string, 0)
+	errs = make([]error, 0)
+	for _, match := range policyNamedValueReference.FindAllStringSubmatch(masked, -1) {
+		if !policyNamedValueName.MatchString(match[1]) {
+			errs = append(errs, fmt.Errorf("the Named Value reference %q must only contain alphanumeric characters, periods, underscores and dashes", match[0]))
+		}
+	}
+
+	if isFragment {
+		if root.name != "fragment" {
+			return warnings, append(errs, fmt.Errorf("line %d: the root element of a policy fragment must be `fragment`, got `%s`", root.line, root.name))
+		}
+		w, e := validatePolicyElements(root.children, "")
+		return append(warnings, w...), append(errs, e...)
+	}
+
+	if root.name != "policies" {
+		return warnings, append(errs, fmt.Errorf("line %d: the root element of a policy must be `policies`, got `%s`", root.line, root.name))
+	}
+
+	sections := make(map[string]bool)
+	for _, section := range root.children {
+		if !isPolicySection(section.name) {
+			errs = append(errs, fmt.Errorf("line %d: `%s` is not a valid section, expected one of `%s`", section.line, section.name, strings.Join(policySections, "`, `")))
+			continue
+		}
+		if sections[section.name] {
+			errs = append(errs, fmt.Errorf("line %d: the section `%s` is specified more than once", section.line, section.name))
+			continue
+		}
+		sections[section.name] = true
+
+		w, e := validatePolicyElements(section.children, section.name)
+		warnings = append(warnings, w...)
+		errs = append(errs, e...)
+	}
+
+	return warnings, errs
+}
+
+// validatePolicyElements validates a list of policies within a section, or within a fragment when `section` is empty
+func validatePolicyElements(input []*policyNode, section string) (warnings []string, errs []error) {
+	warnings = make([]string, 0)
+	errs = make([]error, 0)
+
+	for _, node := range input {
+		if node.name == "choose" {
+			for _, child := range node.children {
+				switch child.name {
+				case "when":
+					if _, ok := child.attributes["condition"]; !ok {
+						errs = append(errs, fmt.Errorf("line %d: the attribute `condition` is required for `when`", child.line))
+					}
+				case "otherwise":
+				default:
+					errs = append(errs, fmt.Errorf("line %d: `choose` can only contain `when` and `otherwise` elements, got `%s`", child.line, child.name))
+					continue
+				}
+				w, e := validatePolicyElements(child.children, section)
+				warnings = append(warnings, w...)
+				errs = append(errs, e...)
+			}
+			continue
+		}
+
+		definition, ok := policyDefinitions[node.name]
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("line %d: `%s` is not a known policy", node.line, node.name))
+			continue
+		}
+
+		if section != "" && !isPolicyInSections(section, definition.sections) {
+			errs = append(errs, fmt.Errorf("line %d: the policy `%s` can't be used in the `%s` section, it can only be used in `%s`", node.line, node.name, section, strings.Join(definition.sections, "`, `")))
+		}
+
+		for _, attributes := range definition.required {
+			if !hasAnyPolicyAttribute(node, attributes) {
+				if len(attributes) == 1 {
+					errs = append(errs, fmt.Errorf("line %d: the attribute `%s` is required for `%s`", node.line, attributes[0], node.name))
+				} else {
+					errs = append(errs, fmt.Errorf("line %d: one of the attributes `%s` is required for `%s`", node.line, strings.Join(attributes, "`, `"), node.name))
+				}
+			}
+		}
+
+		if policyContainers[node.name] {
+			w, e := validatePolicyElements(node.children, section)
+			warnings = append(warnings, w...)
+			errs = append(errs, e...)
+		}
+	}
+
+	return warnings, errs
+}
+
+func hasAnyPolicyAttribute(node *policyNode, attributes []string) bool {
+	for _, attribute := range attributes {
+		if _, ok := node.attributes[attribute]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func isPolicySection(input string) bool {
+	return isPolicyInSections(input, policySections)
+}
+
+func isPolicyInSections(section string, sections []string) bool {
+	for _, v := range sections {
+		if v == section {
+			return true
+		}
+	}
+	return false
+}
+
+// parsePolicyXml parses the document into a tree of elements, returning an error if it isn't well-formed
+func parsePolicyXml(input string) (*policyNode, error) {
+	decoder := xml.NewDecoder(strings.NewReader(input))
+
+	var root *policyNode
+	stack := make([]*policyNode, 0)
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing the policy XML: %+v", err)
+		}
+
+		line, _ := decoder.InputPos()
+		switch v := token.(type) {
+		case xml.StartElement:
+			node := &policyNode{
+				name:       v.Name.Local,
+				attributes: make(map[string]string),
+				line:       line,
+			}
+			for _, attribute := range v.Attr {
+				node.attributes[attribute.Name.Local] = attribute.Value
+			}
+
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+			} else if root == nil {
+				root = node
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("parsing the policy XML: the document doesn't contain any elements")
+	}
+
+	return root, nil
+}
+
+// maskPolicyExpressions replaces the policy expressions in the document, which are C# and may contain characters
+// which aren't valid in XML such as `<` and `"`, with a placeholder
+func maskPolicyExpressions(input string) (string, error) {
+	var sb strings.Builder
+
+	line := 1
+	for i := 0; i < len(input); i++ {
+		if input[i] == '\n' {
+			line++
+		}
+		if input[i] != '@' || i+1 >= len(input) || (input[i+1] != '(' && input[i+1] != '{') {
+			sb.WriteByte(input[i])
+			continue
+		}
+
+		end, err := policyExpressionEnd(input, i+1)
+		if err != nil {
+			return "", fmt.Errorf("line %d: %+v", line, err)
+		}
+		line += strings.Count(input[i:end], "\n")
+		sb.WriteString("policy-expression")
+		i = end - 1
+	}
+
+	return sb.String(), nil
+}
+
+// policyExpressionEnd returns the index after the bracket closing the one at `start`, skipping over string and
+// character literals
+func policyExpressionEnd(input string, start int) (int, error) {
+	closing := map[byte]byte{'(': ')', '{': '}', '[': ']'}
+	brackets := []byte{closing[input[start]]}
+
+	for i := start + 1; i < len(input); i++ {
+		switch c := input[i]; c {
+		case '(', '{', '[':
+			brackets = append(brackets, closing[c])
+		case ')', '}', ']':
+			if c != brackets[len(brackets)-1] {
+				return 0, fmt.Errorf("unexpected `%c` in policy expression", c)
+			}
+			brackets = brackets[:len(brackets)-1]
+			if len(brackets) == 0 {
+				return i + 1, nil
+			}
+		case '"', '\'':
+			// verbatim strings (`@"..."`) escape quotes by doubling them, which is handled by treating them as two strings
+			verbatim := c == '"' && i > 0 && input[i-1] == '@'
+			j := i + 1
+			for ; j < len(input) && input[j] != c; j++ {
+				if input[j] == '\\' && !verbatim {
+					j++
+				}
+			}
+			if j >= len(input) {
+				return 0, fmt.Errorf("unterminated string in policy expression")
+			}
+			i = j
+		}
+	}
+
+	return 0, fmt.Errorf("unterminated policy expression")
+}
@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestApiManagementPolicyXml(t *testing.T) {
+	testData := []struct {
+		Name       string
+		Value      string
+		IsFragment bool
+		Warnings   int
+		Errors     int
+	}{
+		{
+			Name:   "not xml",
+			Value:  "not a policy",
+			Errors: 1,
+		},
+		{
+			Name:   "not well-formed",
+			Value:  "<policies><inbound><base /></policies>",
+			Errors: 1,
+		},
+		{
+			Name: "valid",
+			Value: `<policies>
+  <inbound>
+    <base />
+    <set-variable name="isMobile" value="@(context.Request.Headers.GetValueOrDefault("User-Agent","").Contains("iPad"))" />
+    <choose>
+      <when condition="@(context.Variables.GetValueOrDefault<bool>("isMobile"))">
+        <rate-limit-by-key calls="10" renewal-period="60" counter-key="@(context.Subscription.Id)" />
+      </when>
+      <otherwise>
+        <set-backend-service backend-id="{{backend-name}}" />
+      </otherwise>
+    </choose>
+    <set-header name="x-request" exists-action="override">
+      <value>@{
+        var id = context.RequestId.ToString();
+        return id.Length < 10 ? "short" : id;
+      }</value>
+    </set-header>
+  </inbound>
+  <backend>
+    <retry condition="@(context.Response.StatusCode == 429)" count="3" interval="1">
+      <forward-request />
+    </retry>
+  </backend>
+  <outbound>
+    <base />
+  </outbound>
+  <on-error>
+    <base />
+  </on-error>
+</policies>`,
+			Errors: 0,
+		},
+		{
+			Name: "invalid sections",
+			Value: `<policies>
+  <inbound>
+    <base />
+  </inbound>
+  <inbound>
+    <base />
+  </inbound>
+  <request />
+</policies>`,
+			Errors: 2,
+		},
+		{
+			Name: "invalid policies",
+			Value: `<policies>
+  <inbound>
+    <base />
+    <set-headers name="x" />
+    <rate-limit calls="10" />
+    <forward-request />
+    <choose>
+      <when>
+        <set-header />
+      </when>
+      <set-body />
+    </choose>
+  </inbound>
+</policies>`,
+			// unknown policy, missing `renewal-period`, `forward-request` in `inbound`, missing `condition` and `name` and invalid `choose` child
+			Warnings: 1,
+			Errors:   5,
+		},
+		{
+			Name: "unknown policies",
+			Value: `<policies>
+  <inbound>
+    <base />
+    <a-newly-released-policy enabled="true" />
+  </inbound>
+  <outbound>
+    <retry condition="@(true)" count="1" interval="1">
+      <another-new-policy />
+    </retry>
+  </outbound>
+</policies>`,
+			Warnings: 2,
+			Errors:   0,
+		},
+		{
+			Name: "invalid named value reference",
+			Value: `<policies>
+  <inbound>
+    <set-header name="x">
+      <value>{{my named value}}</value>
+    </set-header>
+  </inbound>
+</policies>`,
+			Errors: 1,
+		},
+		{
+			Name: "unterminated expression",
+			Value: `<policies>
+  <inbound>
+    <set-variable name="x" value="@(context.Request.Url.Path" />
+  </inbound>
+</policies>`,
+			Errors: 1,
+		},
+		{
+			Name: "valid fragment",
+			Value: `<fragment>
+  <set-header name="x-fragment" exists-action="override">
+    <value>@("fragment")</value>
+  </set-header>
+</fragment>`,
+			IsFragment: true,
+			Errors:     0,
+		},
+		{
+			Name:   "cache-store without duration",
+			Value:  `<policies><outbound><cache-store /></outbound></policies>`,
+			Errors: 1,
+		},
+		{
+			Name:   "cache-store with duration",
+			Value:  `<policies><outbound><cache-store duration="60" /></outbound></policies>`,
+			Errors: 0,
+		},
+		{
+			Name:       "fragment with sections",
+			Value:      `<policies><inbound /></policies>`,
+			IsFragment: true,
+			Errors:     1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := ApiManagementPolicyXml(v.Value, v.IsFragment)
+		if len(warnings) != v.Warnings {
+			t.Fatalf("expected %d warnings but got %d: %+v", v.Warnings, len(warnings), warnings)
+		}
+		if len(errors) != v.Errors {
+			t.Fatalf("expected %d errors but got %d: %+v", v.Errors, len(errors), errors)
+		}
+	}
+}
+
+func TestApiManagementPolicyXmlWarnings(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Value    string
+		Warnings int
+		Errors   int
+	}{
+		{
+			Name:  "empty",
+			Value: "",
+		},
+		{
+			Name:  "invalid",
+			Value: "not a policy",
+		},
+		{
+			Name:     "unknown policy",
+			Value:    `<policies><inbound><unknown-policy /></inbound></policies>`,
+			Warnings: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		warnings, errors := ApiManagementPolicyXmlWarnings(false)(v.Value, "xml_content")
+		if len(warnings) != v.Warnings {
+			t.Fatalf("expected %d warnings but got %d: %+v", v.Warnings, len(warnings), warnings)
+		}
+		if len(errors) != v.Errors {
+			t.Fatalf("expected %d errors but got %d: %+v", v.Errors, len(errors), errors)
+		}
+	}
+}
+
+func TestApiManagementPolicyNamedValueReferences(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Value    string
+		Expected []string
+	}{
+		{
+			Name:     "no references",
+			Value:    `<policies><inbound><base /></inbound></policies>`,
+			Expected: []string{},
+		},
+		{
+			Name:     "distinct references",
+			Value:    `<policies><inbound><set-header name="{{header-name}}"><value>{{header-value}}</value></set-header><set-variable name="{{header-name}}" value="1" /></inbound></policies>`,
+			Expected: []string{"header-name", "header-value"},
+		},
+		{
+			Name:     "references within policy expressions and invalid names are ignored",
+			Value:    `<policies><inbound><set-variable name="x" value="@("{{expression}}")" /><set-variable name="{{invalid name}}" value="1" /></inbound></policies>`,
+			Expected: []string{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := ApiManagementPolicyNamedValueReferences(v.Value)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
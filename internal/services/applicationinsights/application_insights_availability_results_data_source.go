@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2024-02-01/metrics"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	availabilityResultsMetricAvailabilityPercentage = "availabilityResults/availabilityPercentage"
+	availabilityResultsMetricCount                  = "availabilityResults/count"
+	availabilityResultsMetricDuration               = "availabilityResults/duration"
+)
+
+type ApplicationInsightsAvailabilityResultsDataSource struct{}
+
+var _ sdk.DataSource = ApplicationInsightsAvailabilityResultsDataSource{}
+
+type ApplicationInsightsAvailabilityResultsDataSourceModel struct {
+	ApplicationInsightsId         string  `tfschema:"application_insights_id"`
+	WebTestName                   string  `tfschema:"web_test_name"`
+	Timespan                      string  `tfschema:"timespan"`
+	AvailabilityPercentage        float64 `tfschema:"availability_percentage"`
+	ResultCount                   int64   `tfschema:"result_count"`
+	AverageDurationInMilliseconds float64 `tfschema:"average_duration_in_milliseconds"`
+}
+
+func (d ApplicationInsightsAvailabilityResultsDataSource) ModelObject() interface{} {
+	return &ApplicationInsightsAvailabilityResultsDataSourceModel{}
+}
+
+func (d ApplicationInsightsAvailabilityResultsDataSource) ResourceType() string {
+	return "azurerm_application_insights_availability_results"
+}
+
+func (d ApplicationInsightsAvailabilityResultsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"application_insights_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: components.ValidateComponentID,
+		},
+
+		"web_test_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"timespan": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "P1D",
+			ValidateFunc: validate.ISO8601Duration,
+		},
+	}
+}
+
+func (d ApplicationInsightsAvailabilityResultsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"availability_percentage": {
+			Type:     pluginsdk.TypeFloat,
+			Computed: true,
+		},
+
+		"result_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"average_duration_in_milliseconds": {
+			Type:     pluginsdk.TypeFloat,
+			Computed: true,
+		},
+	}
+}
+
+func (d ApplicationInsightsAvailabilityResultsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.MetricsClient
+
+			var state ApplicationInsightsAvailabilityResultsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			componentId, err := components.ParseComponentID(state.ApplicationInsightsId)
+			if err != nil {
+				return err
+			}
+
+			options := metrics.ListOperationOptions{
+				Aggregation: pointer.To("average,count"),
+				Metricnames: pointer.To(strings.Join([]string{
+					availabilityResultsMetricAvailabilityPercentage,
+					availabilityResultsMetricCount,
+					availabilityResultsMetricDuration,
+				}, ",")),
+				Timespan: pointer.To(state.Timespan),
+			}
+			if state.WebTestName != "" {
+				options.Filter = pointer.To(fmt.Sprintf("availabilityResult/name eq '%s'", strings.ReplaceAll(state.WebTestName, "'", "''")))
+			}
+
+			resp, err := client.List(ctx, commonids.NewScopeID(componentId.ID()), options)
+			if err != nil {
+				return fmt.Errorf("retrieving availability results for %s: %+v", componentId, err)
+			}
+
+			if model := resp.Model; model != nil {
+				for _, metric := range model.Value {
+					if metric.ErrorCode != nil && !strings.EqualFold(*metric.ErrorCode, "Success") {
+						return fmt.Errorf("retrieving the metric %q for %s: %s", metric.Name.Value, componentId, pointer.From(metric.ErrorMessage))
+					}
+
+					average, count := aggregateAvailabilityResultsMetric(metric)
+					switch {
+					case strings.EqualFold(metric.Name.Value, availabilityResultsMetricAvailabilityPercentage):
+						state.AvailabilityPercentage = average
+					case strings.EqualFold(metric.Name.Value, availabilityResultsMetricCount):
+						state.ResultCount = int64(count)
+					case strings.EqualFold(metric.Name.Value, availabilityResultsMetricDuration):
+						state.AverageDurationInMilliseconds = average
+					}
+				}
+			}
+
+			id := fmt.Sprintf("%s/availabilityResults/%s/%s", componentId.ID(), state.WebTestName, state.Timespan)
+			metadata.ResourceData.SetId(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// aggregateAvailabilityResultsMetric combines the data points of a metric across the timespan, returning the average (weighted
+// by the number of results within each interval) and the total number of results.
+func aggregateAvailabilityResultsMetric(input metrics.Metric) (average float64, count float64) {
+	var sum float64
+	for _, series := range input.Timeseries {
+		for _, v := range pointer.From(series.Data) {
+			c := pointer.From(v.Count)
+			sum += pointer.From(v.Average) * c
+			count += c
+		}
+	}
+
+	if count > 0 {
+		average = sum / count
+	}
+
+	return average, count
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AppInsightsAvailabilityResultsDataSource struct{}
+
+func TestAccApplicationInsightsAvailabilityResultsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_application_insights_availability_results", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: AppInsightsAvailabilityResultsDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("availability_percentage").Exists(),
+				check.That(data.ResourceName).Key("result_count").Exists(),
+				check.That(data.ResourceName).Key("average_duration_in_milliseconds").Exists(),
+			),
+		},
+	})
+}
+
+func (AppInsightsAvailabilityResultsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_application_insights_availability_results" "test" {
+  application_insights_id = azurerm_application_insights.test.id
+  web_test_name           = azurerm_application_insights_multistep_web_test.test.name
+  timespan                = "PT1H"
+}
+`, ApplicationInsightsMultiStepWebTestResource{}.basicConfig(data))
+}
@@ -0,0 +1,367 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// The Multi-Step Web Test API only accepts a Visual Studio Web Test (`.webtest`) XML document, the types below model
+// the subset of that schema which is exposed by `azurerm_application_insights_multistep_web_test`.
+
+const (
+	webTestNamespace = "http://microsoft.com/schemas/VisualStudio/TeamTest/2010"
+
+	webTestRuleAssembly                  = "Microsoft.VisualStudio.QualityTools.WebTestFramework, Version=10.0.0.0, Culture=neutral, PublicKeyToken=b03f5f7f11d50a3a"
+	webTestExtractRegularExpressionClass = "Microsoft.VisualStudio.TestTools.WebTesting.Rules.ExtractRegularExpression, " + webTestRuleAssembly
+	webTestExtractHttpHeaderClass        = "Microsoft.VisualStudio.TestTools.WebTesting.Rules.ExtractHttpHeader, " + webTestRuleAssembly
+	webTestValidationFindTextClass       = "Microsoft.VisualStudio.TestTools.WebTesting.Rules.ValidationRuleFindText, " + webTestRuleAssembly
+)
+
+type webTestDocument struct {
+	XMLName     xml.Name     `xml:"WebTest"`
+	Xmlns       string       `xml:"xmlns,attr"`
+	Name        string       `xml:"Name,attr"`
+	Id          string       `xml:"Id,attr"`
+	Enabled     string       `xml:"Enabled,attr"`
+	Timeout     string       `xml:"Timeout,attr"`
+	Description string       `xml:"Description,attr"`
+	StopOnError string       `xml:"StopOnError,attr"`
+	Items       webTestItems `xml:"Items"`
+}
+
+type webTestItems struct {
+	Requests []webTestRequest `xml:"Request"`
+}
+
+type webTestRequest struct {
+	Method                 string                  `xml:"Method,attr"`
+	Guid                   string                  `xml:"Guid,attr"`
+	Version                string                  `xml:"Version,attr"`
+	Url                    string                  `xml:"Url,attr"`
+	ThinkTime              string                  `xml:"ThinkTime,attr"`
+	Timeout                string                  `xml:"Timeout,attr"`
+	ParseDependentRequests string                  `xml:"ParseDependentRequests,attr"`
+	FollowRedirects        string                  `xml:"FollowRedirects,attr"`
+	RecordResult           string                  `xml:"RecordResult,attr"`
+	Cache                  string                  `xml:"Cache,attr"`
+	ResponseTimeGoal       string                  `xml:"ResponseTimeGoal,attr"`
+	Encoding               string                  `xml:"Encoding,attr"`
+	ExpectedHttpStatusCode string                  `xml:"ExpectedHttpStatusCode,attr"`
+	ExpectedResponseUrl    string                  `xml:"ExpectedResponseUrl,attr"`
+	ReportingName          string                  `xml:"ReportingName,attr"`
+	IgnoreHttpStatusCode   string                  `xml:"IgnoreHttpStatusCode,attr"`
+	Headers                *webTestHeaders         `xml:"Headers,omitempty"`
+	ExtractionRules        *webTestExtractionRules `xml:"ExtractionRules,omitempty"`
+	ValidationRules        *webTestValidationRules `xml:"ValidationRules,omitempty"`
+	Body                   *webTestStringBody      `xml:"StringHttpBody,omitempty"`
+}
+
+type webTestHeaders struct {
+	Headers []webTestParameter `xml:"Header"`
+}
+
+type webTestParameter struct {
+	Name  string `xml:"Name,attr"`
+	Value string `xml:"Value,attr"`
+}
+
+type webTestExtractionRules struct {
+	Rules []webTestExtractionRule `xml:"ExtractionRule"`
+}
+
+type webTestExtractionRule struct {
+	Classname    string             `xml:"Classname,attr"`
+	VariableName string             `xml:"VariableName,attr"`
+	DisplayName  string             `xml:"DisplayName,attr"`
+	Description  string             `xml:"Description,attr"`
+	Parameters   []webTestParameter `xml:"RuleParameters>RuleParameter"`
+}
+
+type webTestValidationRules struct {
+	Rules []webTestValidationRule `xml:"ValidationRule"`
+}
+
+type webTestValidationRule struct {
+	Classname      string             `xml:"Classname,attr"`
+	DisplayName    string             `xml:"DisplayName,attr"`
+	Description    string             `xml:"Description,attr"`
+	Level          string             `xml:"Level,attr"`
+	ExecutionOrder string             `xml:"ExectuionOrder,attr"` // sic, the typo is part of the schema
+	Parameters     []webTestParameter `xml:"RuleParameters>RuleParameter"`
+}
+
+type webTestStringBody struct {
+	ContentType         string `xml:"ContentType,attr"`
+	InsertByteOrderMark string `xml:"InsertByteOrderMark,attr"`
+	Value               string `xml:",chardata"`
+}
+
+func webTestBool(input bool) string {
+	if input {
+		return "True"
+	}
+	return "False"
+}
+
+func webTestParameterValue(input []webTestParameter, name string) string {
+	for _, v := range input {
+		if strings.EqualFold(v.Name, name) {
+			return v.Value
+		}
+	}
+	return ""
+}
+
+// webTestEncodeBody encodes the body of a request in the format used by `StringHttpBody`, which is Base64 encoded UTF-16LE.
+func webTestEncodeBody(input string) string {
+	encoded := utf16.Encode([]rune(input))
+	b := make([]byte, len(encoded)*2)
+	for i, v := range encoded {
+		binary.LittleEndian.PutUint16(b[i*2:], v)
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func webTestDecodeBody(input string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(input))
+	if err != nil {
+		return "", err
+	}
+	if len(b)%2 != 0 {
+		return "", fmt.Errorf("expected an even number of bytes for a UTF-16 encoded body but got %d", len(b))
+	}
+
+	encoded := make([]uint16, len(b)/2)
+	for i := range encoded {
+		encoded[i] = binary.LittleEndian.Uint16(b[i*2:])
+	}
+	return string(utf16.Decode(encoded)), nil
+}
+
+type webTestRequestHeader struct {
+	Name  string
+	Value string
+}
+
+// expandApplicationInsightsMultiStepWebTestConfiguration builds the `.webtest` XML document for the configured requests.
+// `headers` contains the resolved headers (from header sets, the request itself and the authorization header) for each request.
+func expandApplicationInsightsMultiStepWebTestConfiguration(model ApplicationInsightsMultiStepWebTestResourceModel, headers [][]webTestRequestHeader) (string, error) {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return "", fmt.Errorf("generating ID for the web test: %+v", err)
+	}
+
+	document := webTestDocument{
+		Xmlns:       webTestNamespace,
+		Name:        model.Name,
+		Id:          id,
+		Enabled:     "True",
+		Timeout:     strconv.FormatInt(model.Timeout, 10),
+		Description: model.Description,
+		StopOnError: "False",
+	}
+
+	for i, request := range model.Request {
+		requestId, err := uuid.GenerateUUID()
+		if err != nil {
+			return "", fmt.Errorf("generating ID for `request.%d`: %+v", i, err)
+		}
+
+		r := webTestRequest{
+			Method:                 request.HTTPVerb,
+			Guid:                   requestId,
+			Version:                "1.1",
+			Url:                    request.URL,
+			ThinkTime:              strconv.FormatInt(request.ThinkTimeInSeconds, 10),
+			Timeout:                strconv.FormatInt(model.Timeout, 10),
+			ParseDependentRequests: webTestBool(request.ParseDependentRequests),
+			FollowRedirects:        webTestBool(request.FollowRedirects),
+			RecordResult:           "True",
+			Cache:                  "False",
+			ResponseTimeGoal:       "0",
+			Encoding:               "utf-8",
+			ExpectedHttpStatusCode: strconv.FormatInt(request.ExpectedStatusCode, 10),
+			ReportingName:          request.Name,
+			IgnoreHttpStatusCode:   "False",
+		}
+
+		if i < len(headers) && len(headers[i]) > 0 {
+			r.Headers = &webTestHeaders{}
+			for _, h := range headers[i] {
+				r.Headers.Headers = append(r.Headers.Headers, webTestParameter{
+					Name:  h.Name,
+					Value: h.Value,
+				})
+			}
+		}
+
+		if len(request.ExtractionRule) > 0 {
+			r.ExtractionRules = &webTestExtractionRules{}
+			for _, rule := range request.ExtractionRule {
+				if rule.HeaderName != "" {
+					r.ExtractionRules.Rules = append(r.ExtractionRules.Rules, webTestExtractionRule{
+						Classname:    webTestExtractHttpHeaderClass,
+						VariableName: rule.ContextParameterName,
+						DisplayName:  "Extract HTTP Header",
+						Parameters: []webTestParameter{
+							{Name: "Header", Value: rule.HeaderName},
+							{Name: "Required", Value: "True"},
+						},
+					})
+					continue
+				}
+
+				r.ExtractionRules.Rules = append(r.ExtractionRules.Rules, webTestExtractionRule{
+					Classname:    webTestExtractRegularExpressionClass,
+					VariableName: rule.ContextParameterName,
+					DisplayName:  "Extract Regular Expression",
+					Parameters: []webTestParameter{
+						{Name: "RegularExpression", Value: rule.RegularExpression},
+						{Name: "IgnoreCase", Value: "False"},
+						{Name: "Required", Value: "True"},
+						{Name: "Index", Value: strconv.FormatInt(rule.Index, 10)},
+						{Name: "HtmlDecode", Value: "True"},
+						{Name: "UseGroups", Value: webTestBool(rule.UseGroups)},
+					},
+				})
+			}
+		}
+
+		if len(request.ContentValidation) > 0 {
+			r.ValidationRules = &webTestValidationRules{}
+			for _, rule := range request.ContentValidation {
+				r.ValidationRules.Rules = append(r.ValidationRules.Rules, webTestValidationRule{
+					Classname:      webTestValidationFindTextClass,
+					DisplayName:    "Find Text",
+					Level:          "High",
+					ExecutionOrder: "BeforeDependents",
+					Parameters: []webTestParameter{
+						{Name: "FindText", Value: rule.ContentMatch},
+						{Name: "IgnoreCase", Value: webTestBool(rule.IgnoreCase)},
+						{Name: "UseRegularExpression", Value: webTestBool(rule.UseRegularExpression)},
+						{Name: "PassIfTextFound", Value: webTestBool(rule.PassIfTextFound)},
+					},
+				})
+			}
+		}
+
+		if request.Body != "" {
+			r.Body = &webTestStringBody{
+				ContentType:         request.ContentType,
+				InsertByteOrderMark: "False",
+				Value:               webTestEncodeBody(request.Body),
+			}
+		}
+
+		document.Items.Requests = append(document.Items.Requests, r)
+	}
+
+	out, err := xml.Marshal(document)
+	if err != nil {
+		return "", fmt.Errorf("marshaling web test: %+v", err)
+	}
+
+	return string(out), nil
+}
+
+// flattenApplicationInsightsMultiStepWebTestConfiguration parses the `.webtest` XML document returned by the API. Headers are returned
+// separately since header sets and the authorization header are resolved into the document, and so can't be mapped back onto the request.
+func flattenApplicationInsightsMultiStepWebTestConfiguration(input string) ([]MultiStepWebTestRequestModel, [][]webTestRequestHeader, error) {
+	var document webTestDocument
+	if err := xml.Unmarshal([]byte(input), &document); err != nil {
+		return nil, nil, fmt.Errorf("unmarshaling web test: %+v", err)
+	}
+
+	requests := make([]MultiStepWebTestRequestModel, 0)
+	headers := make([][]webTestRequestHeader, 0)
+	for i, r := range document.Items.Requests {
+		request := MultiStepWebTestRequestModel{
+			Name:                   r.ReportingName,
+			URL:                    r.Url,
+			HTTPVerb:               r.Method,
+			FollowRedirects:        strings.EqualFold(r.FollowRedirects, "True"),
+			ParseDependentRequests: strings.EqualFold(r.ParseDependentRequests, "True"),
+			ExtractionRule:         make([]MultiStepWebTestExtractionRuleModel, 0),
+			ContentValidation:      make([]MultiStepWebTestContentValidationModel, 0),
+		}
+
+		if v, err := strconv.ParseInt(r.ThinkTime, 10, 64); err == nil {
+			request.ThinkTimeInSeconds = v
+		}
+		if v, err := strconv.ParseInt(r.ExpectedHttpStatusCode, 10, 64); err == nil {
+			request.ExpectedStatusCode = v
+		}
+
+		requestHeaders := make([]webTestRequestHeader, 0)
+		if r.Headers != nil {
+			for _, h := range r.Headers.Headers {
+				requestHeaders = append(requestHeaders, webTestRequestHeader{
+					Name:  h.Name,
+					Value: h.Value,
+				})
+			}
+		}
+		headers = append(headers, requestHeaders)
+
+		if r.ExtractionRules != nil {
+			for _, rule := range r.ExtractionRules.Rules {
+				extraction := MultiStepWebTestExtractionRuleModel{
+					ContextParameterName: rule.VariableName,
+				}
+
+				switch rule.Classname {
+				case webTestExtractHttpHeaderClass:
+					extraction.HeaderName = webTestParameterValue(rule.Parameters, "Header")
+				case webTestExtractRegularExpressionClass:
+					extraction.RegularExpression = webTestParameterValue(rule.Parameters, "RegularExpression")
+					extraction.UseGroups = strings.EqualFold(webTestParameterValue(rule.Parameters, "UseGroups"), "True")
+					if v, err := strconv.ParseInt(webTestParameterValue(rule.Parameters, "Index"), 10, 64); err == nil {
+						extraction.Index = v
+					}
+				default:
+					continue
+				}
+
+				request.ExtractionRule = append(request.ExtractionRule, extraction)
+			}
+		}
+
+		if r.ValidationRules != nil {
+			for _, rule := range r.ValidationRules.Rules {
+				if rule.Classname != webTestValidationFindTextClass {
+					continue
+				}
+
+				request.ContentValidation = append(request.ContentValidation, MultiStepWebTestContentValidationModel{
+					ContentMatch:         webTestParameterValue(rule.Parameters, "FindText"),
+					IgnoreCase:           strings.EqualFold(webTestParameterValue(rule.Parameters, "IgnoreCase"), "True"),
+					UseRegularExpression: strings.EqualFold(webTestParameterValue(rule.Parameters, "UseRegularExpression"), "True"),
+					PassIfTextFound:      strings.EqualFold(webTestParameterValue(rule.Parameters, "PassIfTextFound"), "True"),
+				})
+			}
+		}
+
+		if r.Body != nil && strings.TrimSpace(r.Body.Value) != "" {
+			body, err := webTestDecodeBody(r.Body.Value)
+			if err != nil {
+				return nil, nil, fmt.Errorf("decoding the body of request %d: %+v", i, err)
+			}
+			request.Body = body
+			request.ContentType = r.Body.ContentType
+		}
+
+		requests = append(requests, request)
+	}
+
+	return requests, headers, nil
+}
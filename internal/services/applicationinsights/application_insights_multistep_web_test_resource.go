@@ -0,0 +1,776 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
+	webtests "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-06-15/webtestsapis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = ApplicationInsightsMultiStepWebTestResource{}
+	_ sdk.ResourceWithCustomizeDiff = ApplicationInsightsMultiStepWebTestResource{}
+)
+
+type ApplicationInsightsMultiStepWebTestResource struct{}
+
+type ApplicationInsightsMultiStepWebTestResourceModel struct {
+	Name                  string                                     `tfschema:"name"`
+	ResourceGroupName     string                                     `tfschema:"resource_group_name"`
+	ApplicationInsightsID string                                     `tfschema:"application_insights_id"`
+	Location              string                                     `tfschema:"location"`
+	Frequency             int64                                      `tfschema:"frequency"`
+	Timeout               int64                                      `tfschema:"timeout"`
+	Enabled               bool                                       `tfschema:"enabled"`
+	Retry                 bool                                       `tfschema:"retry_enabled"`
+	HeaderSet             []MultiStepWebTestHeaderSetModel           `tfschema:"header_set"`
+	AuthorizationHeader   []MultiStepWebTestAuthorizationHeaderModel `tfschema:"authorization_header"`
+	Request               []MultiStepWebTestRequestModel             `tfschema:"request"`
+	GeoLocations          []string                                   `tfschema:"geo_locations"`
+	Description           string                                     `tfschema:"description"`
+	Tags                  map[string]string                          `tfschema:"tags"`
+
+	// ComputedOnly
+	SyntheticMonitorID string `tfschema:"synthetic_monitor_id"`
+}
+
+type MultiStepWebTestHeaderSetModel struct {
+	Name   string        `tfschema:"name"`
+	Header []HeaderModel `tfschema:"header"`
+}
+
+type MultiStepWebTestAuthorizationHeaderModel struct {
+	Name             string `tfschema:"name"`
+	ValuePrefix      string `tfschema:"value_prefix"`
+	KeyVaultSecretId string `tfschema:"key_vault_secret_id"`
+}
+
+type MultiStepWebTestRequestModel struct {
+	Name                   string                                   `tfschema:"name"`
+	URL                    string                                   `tfschema:"url"`
+	HTTPVerb               string                                   `tfschema:"http_verb"`
+	Body                   string                                   `tfschema:"body"`
+	ContentType            string                                   `tfschema:"content_type"`
+	FollowRedirects        bool                                     `tfschema:"follow_redirects_enabled"`
+	ParseDependentRequests bool                                     `tfschema:"parse_dependent_requests_enabled"`
+	ThinkTimeInSeconds     int64                                    `tfschema:"think_time_in_seconds"`
+	ExpectedStatusCode     int64                                    `tfschema:"expected_status_code"`
+	HeaderSetNames         []string                                 `tfschema:"header_set_names"`
+	Header                 []HeaderModel                            `tfschema:"header"`
+	ExtractionRule         []MultiStepWebTestExtractionRuleModel    `tfschema:"extraction_rule"`
+	ContentValidation      []MultiStepWebTestContentValidationModel `tfschema:"content_validation"`
+}
+
+type MultiStepWebTestExtractionRuleModel struct {
+	ContextParameterName string `tfschema:"context_parameter_name"`
+	RegularExpression    string `tfschema:"regular_expression"`
+	Index                int64  `tfschema:"index"`
+	UseGroups            bool   `tfschema:"use_groups"`
+	HeaderName           string `tfschema:"header_name"`
+}
+
+type MultiStepWebTestContentValidationModel struct {
+	ContentMatch         string `tfschema:"content_match"`
+	IgnoreCase           bool   `tfschema:"ignore_case"`
+	UseRegularExpression bool   `tfschema:"use_regular_expression"`
+	PassIfTextFound      bool   `tfschema:"pass_if_text_found"`
+}
+
+// webTestContextParameterRegex matches references to context parameters, e.g. `{{token}}`, which are populated by extraction rules
+var webTestContextParameterRegex = regexp.MustCompile(`\{\{([^{}]+)\}\}`)
+
+func (ApplicationInsightsMultiStepWebTestResource) Arguments() map[string]*pluginsdk.Schema {
+	headerSchema := &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"value": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"application_insights_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: components.ValidateComponentID,
+		},
+
+		"location": commonschema.Location(),
+
+		"geo_locations": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:             pluginsdk.TypeString,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+		},
+
+		"request": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"http_verb": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  "GET",
+						ValidateFunc: validation.StringInSlice([]string{
+							"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS",
+						}, false),
+					},
+
+					"body": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"content_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"follow_redirects_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"parse_dependent_requests_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"think_time_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntBetween(0, 60),
+					},
+
+					"expected_status_code": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+						Default:  200,
+					},
+
+					"header_set_names": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"header": headerSchema,
+
+					"extraction_rule": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"context_parameter_name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"regular_expression": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsValidRegExp,
+								},
+
+								"index": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      0,
+									ValidateFunc: validation.IntAtLeast(0),
+								},
+
+								"use_groups": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+
+								"header_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"content_validation": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"content_match": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"ignore_case": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+
+								"use_regular_expression": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+
+								"pass_if_text_found": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"header_set": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"header": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+								"value": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"authorization_header": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key_vault_secret_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "Authorization",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value_prefix": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"frequency": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Default:  300,
+			ValidateFunc: validation.IntInSlice([]int{
+				300,
+				600,
+				900,
+			}),
+		},
+
+		"timeout": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Default:  30,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"retry_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (ApplicationInsightsMultiStepWebTestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"synthetic_monitor_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (ApplicationInsightsMultiStepWebTestResource) ModelObject() interface{} {
+	return &ApplicationInsightsMultiStepWebTestResourceModel{}
+}
+
+func (ApplicationInsightsMultiStepWebTestResource) ResourceType() string {
+	return "azurerm_application_insights_multistep_web_test"
+}
+
+func (ApplicationInsightsMultiStepWebTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return webtests.ValidateWebTestID
+}
+
+func (r ApplicationInsightsMultiStepWebTestResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config ApplicationInsightsMultiStepWebTestResourceModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			headerSets := make(map[string]struct{})
+			for _, v := range config.HeaderSet {
+				if v.Name == "" {
+					continue
+				}
+				if _, ok := headerSets[v.Name]; ok {
+					return fmt.Errorf("the `header_set` %q is defined more than once", v.Name)
+				}
+				headerSets[v.Name] = struct{}{}
+			}
+
+			// context parameters can only be referenced by requests which follow the request that extracts them
+			contextParameters := make(map[string]struct{})
+			for i, request := range config.Request {
+				for _, name := range request.HeaderSetNames {
+					if _, ok := headerSets[name]; name != "" && !ok {
+						return fmt.Errorf("`request.%d` references the `header_set` %q which is not defined", i, name)
+					}
+				}
+
+				references := []string{request.URL, request.Body}
+				for _, h := range request.Header {
+					references = append(references, h.Value)
+				}
+				for _, v := range references {
+					for _, match := range webTestContextParameterRegex.FindAllStringSubmatch(v, -1) {
+						if _, ok := contextParameters[match[1]]; !ok {
+							return fmt.Errorf("`request.%d` references the context parameter %q which is not extracted by an `extraction_rule` of a previous request", i, match[1])
+						}
+					}
+				}
+
+				for j, rule := range request.ExtractionRule {
+					if (rule.RegularExpression == "") == (rule.HeaderName == "") {
+						return fmt.Errorf("exactly one of `regular_expression` or `header_name` must be specified for `request.%d.extraction_rule.%d`", i, j)
+					}
+					if rule.HeaderName != "" && (rule.UseGroups || rule.Index != 0) {
+						return fmt.Errorf("`index` and `use_groups` can only be specified with `regular_expression` for `request.%d.extraction_rule.%d`", i, j)
+					}
+					contextParameters[rule.ContextParameterName] = struct{}{}
+				}
+
+				if request.ContentType != "" && request.Body == "" {
+					return fmt.Errorf("`content_type` can only be specified with `body` for `request.%d`", i)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationInsightsMultiStepWebTestResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.WebTestsClient
+
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ApplicationInsightsMultiStepWebTestResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id := webtests.NewWebTestID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.WebTestsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			param, err := expandApplicationInsightsMultiStepWebTest(ctx, metadata, id, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.WebTestsCreateOrUpdate(ctx, id, *param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationInsightsMultiStepWebTestResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.WebTestsClient
+
+			id, err := webtests.ParseWebTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationInsightsMultiStepWebTestResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			// the configuration is regenerated on every update since it contains the current value of the authorization secret
+			param, err := expandApplicationInsightsMultiStepWebTest(ctx, metadata, *id, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.WebTestsCreateOrUpdate(ctx, *id, *param); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (ApplicationInsightsMultiStepWebTestResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.WebTestsClient
+
+			id, err := webtests.ParseWebTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.WebTestsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// header sets and the authorization header are resolved into the configuration, so these are taken from the state
+			var existing ApplicationInsightsMultiStepWebTestResourceModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ApplicationInsightsMultiStepWebTestResourceModel{
+				Name:                id.WebTestName,
+				ResourceGroupName:   id.ResourceGroupName,
+				HeaderSet:           existing.HeaderSet,
+				AuthorizationHeader: existing.AuthorizationHeader,
+			}
+
+			if model := resp.Model; model != nil {
+				tags := pointer.From(model.Tags)
+				for i := range tags {
+					if strings.HasPrefix(i, "hidden-link") {
+						appInsightsId := strings.Split(i, ":")[1]
+
+						parsedAppInsightsId, err := webtests.ParseComponentIDInsensitively(appInsightsId)
+						if err != nil {
+							log.Printf("[DEBUG] Error parsing hidden-link id: %+v", err)
+							delete(tags, i)
+							continue
+						}
+						state.ApplicationInsightsID = parsedAppInsightsId.ID()
+						delete(tags, i)
+					}
+				}
+
+				state.Tags = tags
+				state.Location = location.Normalize(model.Location)
+
+				if props := model.Properties; props != nil {
+					state.SyntheticMonitorID = props.SyntheticMonitorId
+					state.Description = pointer.From(props.Description)
+					state.Enabled = pointer.From(props.Enabled)
+					state.Frequency = pointer.From(props.Frequency)
+					state.Timeout = pointer.From(props.Timeout)
+					state.Retry = pointer.From(props.RetryEnabled)
+					state.GeoLocations = flattenApplicationInsightsStandardWebTestGeoLocations(props.Locations)
+
+					if props.Configuration != nil && props.Configuration.WebTest != nil {
+						requests, headers, err := flattenApplicationInsightsMultiStepWebTestConfiguration(*props.Configuration.WebTest)
+						if err != nil {
+							return fmt.Errorf("flattening configuration for %s: %+v", *id, err)
+						}
+
+						for i := range requests {
+							if i < len(existing.Request) {
+								requests[i].HeaderSetNames = existing.Request[i].HeaderSetNames
+								requests[i].Header = existing.Request[i].Header
+								continue
+							}
+
+							// when importing, any headers which aren't the authorization header are exposed on the request
+							requests[i].Header = make([]HeaderModel, 0)
+							for _, h := range headers[i] {
+								if len(existing.AuthorizationHeader) > 0 && strings.EqualFold(h.Name, existing.AuthorizationHeader[0].Name) {
+									continue
+								}
+								requests[i].Header = append(requests[i].Header, HeaderModel{
+									Name:  h.Name,
+									Value: h.Value,
+								})
+							}
+						}
+						state.Request = requests
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (ApplicationInsightsMultiStepWebTestResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.WebTestsClient
+
+			id, err := webtests.ParseWebTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err = client.WebTestsDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplicationInsightsMultiStepWebTest(ctx context.Context, metadata sdk.ResourceMetaData, id webtests.WebTestId, model ApplicationInsightsMultiStepWebTestResourceModel) (*webtests.WebTest, error) {
+	appInsightsId, err := webtests.ParseComponentID(model.ApplicationInsightsID)
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := expandApplicationInsightsMultiStepWebTestHeaders(ctx, metadata, model)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration, err := expandApplicationInsightsMultiStepWebTestConfiguration(model, headers)
+	if err != nil {
+		return nil, fmt.Errorf("building configuration for %s: %+v", id, err)
+	}
+
+	if model.Tags == nil {
+		model.Tags = make(map[string]string)
+	}
+	model.Tags[fmt.Sprintf("hidden-link:%s", appInsightsId.ID())] = "Resource"
+
+	props := webtests.WebTestProperties{
+		Name:               id.WebTestName, // API requires this to be specified despite ARM spec guidance that it should come from the ID
+		Enabled:            pointer.To(model.Enabled),
+		Frequency:          pointer.To(model.Frequency),
+		Kind:               webtests.WebTestKindMultistep,
+		SyntheticMonitorId: id.WebTestName,
+		RetryEnabled:       pointer.To(model.Retry),
+		Timeout:            pointer.To(model.Timeout),
+		Locations:          expandApplicationInsightsStandardWebTestGeoLocations(model.GeoLocations),
+		Configuration: &webtests.WebTestPropertiesConfiguration{
+			WebTest: pointer.To(configuration),
+		},
+	}
+
+	if model.Description != "" {
+		props.Description = pointer.To(model.Description)
+	}
+
+	return &webtests.WebTest{
+		Kind:       pointer.To(webtests.WebTestKindMultistep),
+		Location:   location.Normalize(model.Location),
+		Properties: &props,
+		Tags:       pointer.To(model.Tags),
+	}, nil
+}
+
+// expandApplicationInsightsMultiStepWebTestHeaders resolves the headers sent by each request, in the order: header sets, request headers
+// and finally the authorization header, whose value is retrieved from Key Vault.
+func expandApplicationInsightsMultiStepWebTestHeaders(ctx context.Context, metadata sdk.ResourceMetaData, model ApplicationInsightsMultiStepWebTestResourceModel) ([][]webTestRequestHeader, error) {
+	headerSets := make(map[string][]HeaderModel)
+	for _, v := range model.HeaderSet {
+		headerSets[v.Name] = v.Header
+	}
+
+	var authorization *webTestRequestHeader
+	if len(model.AuthorizationHeader) > 0 {
+		v := model.AuthorizationHeader[0]
+
+		secretId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(v.KeyVaultSecretId)
+		if err != nil {
+			return nil, err
+		}
+
+		secret, err := metadata.Client.KeyVault.ManagementClient.GetSecret(ctx, secretId.KeyVaultBaseUrl, secretId.Name, secretId.Version)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s for the authorization header: %+v", secretId, err)
+		}
+		if secret.Value == nil {
+			return nil, fmt.Errorf("retrieving %s for the authorization header: `value` was nil", secretId)
+		}
+
+		authorization = &webTestRequestHeader{
+			Name:  v.Name,
+			Value: v.ValuePrefix + *secret.Value,
+		}
+	}
+
+	result := make([][]webTestRequestHeader, 0)
+	for i, request := range model.Request {
+		headers := make([]webTestRequestHeader, 0)
+		for _, name := range request.HeaderSetNames {
+			set, ok := headerSets[name]
+			if !ok {
+				return nil, fmt.Errorf("`request.%d` references the `header_set` %q which is not defined", i, name)
+			}
+			for _, h := range set {
+				headers = append(headers, webTestRequestHeader{
+					Name:  h.Name,
+					Value: h.Value,
+				})
+			}
+		}
+
+		for _, h := range request.Header {
+			headers = append(headers, webTestRequestHeader{
+				Name:  h.Name,
+				Value: h.Value,
+			})
+		}
+
+		if authorization != nil {
+			headers = append(headers, *authorization)
+		}
+
+		result = append(result, headers)
+	}
+
+	return result, nil
+}
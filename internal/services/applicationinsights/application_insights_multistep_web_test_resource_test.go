@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	webtests "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-06-15/webtestsapis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApplicationInsightsMultiStepWebTestResource struct{}
+
+func TestAccApplicationInsightsMultiStepWebTest_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_multistep_web_test", "test")
+	r := ApplicationInsightsMultiStepWebTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationInsightsMultiStepWebTest_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_multistep_web_test", "test")
+	r := ApplicationInsightsMultiStepWebTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImportConfig),
+	})
+}
+
+func TestAccApplicationInsightsMultiStepWebTest_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_multistep_web_test", "test")
+	r := ApplicationInsightsMultiStepWebTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.completeConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("request.#").HasValue("2"),
+			),
+		},
+		// header sets and the authorization header can't be mapped back from the configuration when importing
+		data.ImportStep("header_set", "authorization_header", "request.0.header", "request.1.header"),
+	})
+}
+
+func TestAccApplicationInsightsMultiStepWebTest_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_multistep_web_test", "test")
+	r := ApplicationInsightsMultiStepWebTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.completeConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("header_set", "authorization_header", "request.0.header", "request.1.header"),
+		{
+			Config: r.basicConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationInsightsMultiStepWebTest_undefinedContextParameter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_multistep_web_test", "test")
+	r := ApplicationInsightsMultiStepWebTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.undefinedContextParameterConfig(data),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("which is not extracted by an `extraction_rule` of a previous request"),
+		},
+	})
+}
+
+func (ApplicationInsightsMultiStepWebTestResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := webtests.ParseWebTestID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppInsights.WebTestsClient.WebTestsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil && resp.Model.Properties != nil), nil
+}
+
+func (ApplicationInsightsMultiStepWebTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appinsights-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  application_type    = "web"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ApplicationInsightsMultiStepWebTestResource) basicConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_multistep_web_test" "test" {
+  name                    = "acctestappinsightswebtests-%d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  application_insights_id = azurerm_application_insights.test.id
+  geo_locations           = ["us-tx-sn1-azr"]
+
+  request {
+    url = "https://microsoft.com"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationInsightsMultiStepWebTestResource) requiresImportConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_multistep_web_test" "import" {
+  name                    = azurerm_application_insights_multistep_web_test.test.name
+  location                = azurerm_application_insights_multistep_web_test.test.location
+  resource_group_name     = azurerm_application_insights_multistep_web_test.test.resource_group_name
+  application_insights_id = azurerm_application_insights_multistep_web_test.test.application_insights_id
+  geo_locations           = azurerm_application_insights_multistep_web_test.test.geo_locations
+
+  request {
+    url = "https://microsoft.com"
+  }
+}
+`, r.basicConfig(data))
+}
+
+func (r ApplicationInsightsMultiStepWebTestResource) completeConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acctestkv%[3]s"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+
+  access_policy {
+    tenant_id          = data.azurerm_client_config.current.tenant_id
+    object_id          = data.azurerm_client_config.current.object_id
+    secret_permissions = ["Get", "Set", "Delete", "Purge", "Recover"]
+  }
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "login-token"
+  value        = "not-a-real-token"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_application_insights_multistep_web_test" "test" {
+  name                    = "acctestappinsightswebtests-%[2]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  application_insights_id = azurerm_application_insights.test.id
+  geo_locations           = ["us-tx-sn1-azr", "us-il-ch1-azr"]
+  frequency               = 900
+  timeout                 = 120
+  enabled                 = true
+  retry_enabled           = true
+  description             = "Login flow"
+
+  header_set {
+    name = "common"
+
+    header {
+      name  = "Accept"
+      value = "text/html"
+    }
+
+    header {
+      name  = "x-synthetic-test"
+      value = "true"
+    }
+  }
+
+  authorization_header {
+    key_vault_secret_id = azurerm_key_vault_secret.test.versionless_id
+    value_prefix        = "Bearer "
+  }
+
+  request {
+    name             = "home"
+    url              = "https://www.microsoft.com/en-us"
+    header_set_names = ["common"]
+
+    extraction_rule {
+      context_parameter_name = "Location"
+      header_name            = "Content-Language"
+    }
+
+    extraction_rule {
+      context_parameter_name = "Title"
+      regular_expression     = "<title>(.*)</title>"
+      use_groups             = true
+    }
+
+    content_validation {
+      content_match = "Microsoft"
+      ignore_case   = true
+    }
+  }
+
+  request {
+    name                  = "search"
+    url                   = "https://www.microsoft.com/{{Location}}/search"
+    http_verb             = "POST"
+    body                  = "{\"query\":\"{{Title}}\"}"
+    content_type          = "application/json"
+    think_time_in_seconds = 2
+    expected_status_code  = 0
+    header_set_names      = ["common"]
+
+    header {
+      name  = "x-step"
+      value = "2"
+    }
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r ApplicationInsightsMultiStepWebTestResource) undefinedContextParameterConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_multistep_web_test" "test" {
+  name                    = "acctestappinsightswebtests-%d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  application_insights_id = azurerm_application_insights.test.id
+  geo_locations           = ["us-tx-sn1-azr"]
+
+  request {
+    url = "https://microsoft.com/{{Token}}"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -14,6 +14,7 @@ import (
 	workbooktemplates "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-11-20/workbooktemplatesapis"
 	workbooks "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-04-01/workbooksapis"
 	webtests "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-06-15/webtestsapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2024-02-01/metrics"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -24,6 +25,7 @@ type Client struct {
 	WebTestsClient           *webtests.WebTestsAPIsClient
 	StandardWebTestsClient   *webtests.WebTestsAPIsClient
 	BillingClient            *billing.ComponentFeaturesAndPricingAPIsClient
+	MetricsClient            *metrics.MetricsClient
 	SmartDetectionRuleClient *smartdetection.ComponentProactiveDetectionAPIsClient
 	WorkbookClient           *workbooks.WorkbooksAPIsClient
 	WorkbookTemplateClient   *workbooktemplates.WorkbookTemplatesAPIsClient
@@ -66,6 +68,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(billingClient.Client, o.Authorizers.ResourceManager)
 
+	metricsClient, err := metrics.NewMetricsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Metrics client: %+v", err)
+	}
+	o.Configure(metricsClient.Client, o.Authorizers.ResourceManager)
+
 	smartDetectionRuleClient, err := smartdetection.NewComponentProactiveDetectionAPIsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building SmartDetection client: %+v", err)
@@ -90,6 +98,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ComponentsClient:         componentsClient,
 		WebTestsClient:           webTestsClient,
 		BillingClient:            billingClient,
+		MetricsClient:            metricsClient,
 		SmartDetectionRuleClient: smartDetectionRuleClient,
 		WorkbookClient:           workbookClient,
 		WorkbookTemplateClient:   workbookTemplateClient,
@@ -54,7 +54,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 // DataSources returns a list of Data Sources supported by this Service
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		ApplicationInsightsAvailabilityResultsDataSource{},
+	}
 }
 
 // Resources returns a list of Resources supported by this Service
@@ -63,6 +65,7 @@ func (r Registration) Resources() []sdk.Resource {
 		ApplicationInsightsWorkbookResource{},
 		ApplicationInsightsWorkbookTemplateResource{},
 		ApplicationInsightsStandardWebTestResource{},
+		ApplicationInsightsMultiStepWebTestResource{},
 	}
 }
 
@@ -0,0 +1,281 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type FunctionAppFunctionKeyResource struct{}
+
+type FunctionAppFunctionKeyModel struct {
+	FunctionId     string `tfschema:"function_id"`
+	Name           string `tfschema:"name"`
+	Value          string `tfschema:"value"`
+	ValueWoVersion int64  `tfschema:"value_wo_version"`
+}
+
+var _ sdk.ResourceWithUpdate = FunctionAppFunctionKeyResource{}
+
+func (r FunctionAppFunctionKeyResource) ModelObject() interface{} {
+	return &FunctionAppFunctionKeyModel{}
+}
+
+func (r FunctionAppFunctionKeyResource) ResourceType() string {
+	return "azurerm_function_app_function_key"
+}
+
+func (r FunctionAppFunctionKeyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.Any(webapps.ValidateKeyID, webapps.ValidateFunctionKeyID)
+}
+
+func (r FunctionAppFunctionKeyResource) Arguments() map[string]*pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		"function_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "The ID of the Function, in a Function App or Function App Slot, the Function Key belongs to.",
+			ValidateFunc: validation.Any(webapps.ValidateFunctionID, webapps.ValidateSlotFunctionID),
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "The name of the Function Key.",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+
+	for k, v := range helpers.FunctionAppKeyValueSchema() {
+		s[k] = v
+	}
+
+	return s
+}
+
+func (r FunctionAppFunctionKeyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r FunctionAppFunctionKeyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var key FunctionAppFunctionKeyModel
+			if err := metadata.Decode(&key); err != nil {
+				return err
+			}
+
+			value, err := helpers.ExpandFunctionAppKeyValue(metadata.ResourceData)
+			if err != nil {
+				return err
+			}
+
+			payload := webapps.KeyInfo{
+				Name:  pointer.To(key.Name),
+				Value: value,
+			}
+
+			if slotFunctionId, err := webapps.ParseSlotFunctionID(key.FunctionId); err == nil {
+				id := webapps.NewFunctionKeyID(slotFunctionId.SubscriptionId, slotFunctionId.ResourceGroupName, slotFunctionId.SiteName, slotFunctionId.SlotName, slotFunctionId.FunctionName, key.Name)
+
+				locks.ByID(slotFunctionId.ID())
+				defer locks.UnlockByID(slotFunctionId.ID())
+
+				existing, err := client.ListFunctionKeysSlot(ctx, *slotFunctionId)
+				if err != nil {
+					return fmt.Errorf("listing keys for %s: %+v", slotFunctionId, err)
+				}
+				if existing.Model != nil && existing.Model.Properties != nil {
+					if _, ok := (*existing.Model.Properties)[key.Name]; ok {
+						return metadata.ResourceRequiresImport(r.ResourceType(), id)
+					}
+				}
+
+				if _, err := client.CreateOrUpdateFunctionSecretSlot(ctx, id, payload); err != nil {
+					return fmt.Errorf("creating %s: %+v", id, err)
+				}
+
+				metadata.SetID(id)
+				return nil
+			}
+
+			functionId, err := webapps.ParseFunctionID(key.FunctionId)
+			if err != nil {
+				return err
+			}
+
+			id := webapps.NewKeyID(functionId.SubscriptionId, functionId.ResourceGroupName, functionId.SiteName, functionId.FunctionName, key.Name)
+
+			locks.ByID(functionId.ID())
+			defer locks.UnlockByID(functionId.ID())
+
+			existing, err := client.ListFunctionKeys(ctx, *functionId)
+			if err != nil {
+				return fmt.Errorf("listing keys for %s: %+v", functionId, err)
+			}
+			if existing.Model != nil && existing.Model.Properties != nil {
+				if _, ok := (*existing.Model.Properties)[key.Name]; ok {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			if _, err := client.CreateOrUpdateFunctionSecret(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r FunctionAppFunctionKeyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			state := FunctionAppFunctionKeyModel{
+				ValueWoVersion: int64(metadata.ResourceData.Get("value_wo_version").(int)),
+			}
+
+			var id resourceids.ResourceId
+			var keys *webapps.StringDictionary
+			if slotKeyId, err := webapps.ParseFunctionKeyID(metadata.ResourceData.Id()); err == nil {
+				id = slotKeyId
+				slotFunctionId := webapps.NewSlotFunctionID(slotKeyId.SubscriptionId, slotKeyId.ResourceGroupName, slotKeyId.SiteName, slotKeyId.SlotName, slotKeyId.FunctionName)
+				resp, err := client.ListFunctionKeysSlot(ctx, slotFunctionId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("listing keys for %s: %+v", slotFunctionId, err)
+				}
+
+				state.FunctionId = slotFunctionId.ID()
+				state.Name = slotKeyId.KeyName
+				keys = resp.Model
+			} else {
+				keyId, err := webapps.ParseKeyID(metadata.ResourceData.Id())
+				if err != nil {
+					return err
+				}
+
+				id = keyId
+				functionId := webapps.NewFunctionID(keyId.SubscriptionId, keyId.ResourceGroupName, keyId.SiteName, keyId.FunctionName)
+				resp, err := client.ListFunctionKeys(ctx, functionId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("listing keys for %s: %+v", functionId, err)
+				}
+
+				state.FunctionId = functionId.ID()
+				state.Name = keyId.KeyName
+				keys = resp.Model
+			}
+
+			if keys == nil || keys.Properties == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			value, ok := (*keys.Properties)[state.Name]
+			if !ok {
+				return metadata.MarkAsGone(id)
+			}
+			state.Value = helpers.FunctionAppKeyValueForState(metadata.ResourceData, pointer.To(value))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r FunctionAppFunctionKeyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			if !metadata.ResourceData.HasChanges("value", "value_wo_version") {
+				return nil
+			}
+
+			value, err := helpers.ExpandFunctionAppKeyValue(metadata.ResourceData)
+			if err != nil {
+				return err
+			}
+
+			if id, err := webapps.ParseFunctionKeyID(metadata.ResourceData.Id()); err == nil {
+				payload := webapps.KeyInfo{
+					Name:  pointer.To(id.KeyName),
+					Value: value,
+				}
+				if _, err := client.CreateOrUpdateFunctionSecretSlot(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+				return nil
+			}
+
+			id, err := webapps.ParseKeyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			payload := webapps.KeyInfo{
+				Name:  pointer.To(id.KeyName),
+				Value: value,
+			}
+			if _, err := client.CreateOrUpdateFunctionSecret(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r FunctionAppFunctionKeyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			if id, err := webapps.ParseFunctionKeyID(metadata.ResourceData.Id()); err == nil {
+				if resp, err := client.DeleteFunctionSecretSlot(ctx, *id); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", id, err)
+				}
+				return nil
+			}
+
+			id, err := webapps.ParseKeyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.DeleteFunctionSecret(ctx, *id); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
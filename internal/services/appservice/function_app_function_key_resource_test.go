@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FunctionAppFunctionKeyResource struct{}
+
+func TestAccFunctionAppFunctionKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_function_key", "test")
+	r := FunctionAppFunctionKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFunctionAppFunctionKey_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_function_key", "test")
+	r := FunctionAppFunctionKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFunctionAppFunctionKey_writeOnlyValue(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_function_key", "test")
+	r := FunctionAppFunctionKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.writeOnlyValue(data, "acctestFunctionKeyValue1234567890abcdef", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").IsEmpty(),
+			),
+		},
+		data.ImportStep("value", "value_wo_version"),
+		{
+			Config: r.writeOnlyValue(data, "acctestFunctionKeyValue0987654321fedcba", 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("value", "value_wo_version"),
+	})
+}
+
+func (r FunctionAppFunctionKeyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	var keys *webapps.StringDictionary
+	var name string
+	if id, err := webapps.ParseFunctionKeyID(state.ID); err == nil {
+		resp, err := client.AppService.WebAppsClient.ListFunctionKeysSlot(ctx, webapps.NewSlotFunctionID(id.SubscriptionId, id.ResourceGroupName, id.SiteName, id.SlotName, id.FunctionName))
+		if err != nil {
+			return nil, fmt.Errorf("listing keys for %s: %+v", id, err)
+		}
+		keys = resp.Model
+		name = id.KeyName
+	} else {
+		id, err := webapps.ParseKeyID(state.ID)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.AppService.WebAppsClient.ListFunctionKeys(ctx, webapps.NewFunctionID(id.SubscriptionId, id.ResourceGroupName, id.SiteName, id.FunctionName))
+		if err != nil {
+			return nil, fmt.Errorf("listing keys for %s: %+v", id, err)
+		}
+		keys = resp.Model
+		name = id.KeyName
+	}
+
+	if keys == nil || keys.Properties == nil {
+		return pointer.To(false), nil
+	}
+	_, ok := (*keys.Properties)[name]
+
+	return pointer.To(ok), nil
+}
+
+func (r FunctionAppFunctionKeyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_function_app_function_key" "test" {
+  function_id = azurerm_function_app_function.test.id
+  name        = "acctest-functionkey"
+}
+`, r.template(data))
+}
+
+func (r FunctionAppFunctionKeyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_function_app_function_key" "import" {
+  function_id = azurerm_function_app_function_key.test.function_id
+  name        = azurerm_function_app_function_key.test.name
+}
+`, r.basic(data))
+}
+
+func (r FunctionAppFunctionKeyResource) writeOnlyValue(data acceptance.TestData, value string, version int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_function_app_function_key" "test" {
+  function_id      = azurerm_function_app_function.test.id
+  name             = "acctest-functionkey"
+  value_wo         = %q
+  value_wo_version = %d
+}
+`, r.template(data), value, version)
+}
+
+func (r FunctionAppFunctionKeyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_function_app_function" "test" {
+  name            = "testAcc-FnAppFn-%d"
+  function_app_id = azurerm_linux_function_app.test.id
+  language        = "Python"
+  config_json = jsonencode({
+    "bindings" = [
+      {
+        "authLevel" = "function"
+        "direction" = "in"
+        "methods" = [
+          "get",
+          "post",
+        ]
+        "name" = "req"
+        "type" = "httpTrigger"
+      },
+      {
+        "direction" = "out"
+        "name"      = "$return"
+        "type"      = "http"
+      },
+    ]
+  })
+}
+`, functionAppKeyTemplate(data), data.RandomInteger)
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FunctionAppHostKeyResource struct{}
+
+var _ sdk.ResourceWithUpdate = FunctionAppHostKeyResource{}
+
+func (r FunctionAppHostKeyResource) base() functionAppHostKeyResourceBase {
+	return functionAppHostKeyResourceBase{
+		resourceType: r.ResourceType(),
+		keyType:      functionAppHostKeyTypeFunctionKeys,
+		displayName:  "Host Key",
+	}
+}
+
+func (r FunctionAppHostKeyResource) ModelObject() interface{} {
+	return &FunctionAppHostKeyModel{}
+}
+
+func (r FunctionAppHostKeyResource) ResourceType() string {
+	return "azurerm_function_app_host_key"
+}
+
+func (r FunctionAppHostKeyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return r.base().idValidationFunc()
+}
+
+func (r FunctionAppHostKeyResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.base().arguments()
+}
+
+func (r FunctionAppHostKeyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r FunctionAppHostKeyResource) Create() sdk.ResourceFunc {
+	return r.base().createFunc()
+}
+
+func (r FunctionAppHostKeyResource) Read() sdk.ResourceFunc {
+	return r.base().readFunc()
+}
+
+func (r FunctionAppHostKeyResource) Update() sdk.ResourceFunc {
+	return r.base().updateFunc()
+}
+
+func (r FunctionAppHostKeyResource) Delete() sdk.ResourceFunc {
+	return r.base().deleteFunc()
+}
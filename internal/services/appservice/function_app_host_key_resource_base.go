@@ -0,0 +1,318 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	functionAppHostKeyTypeFunctionKeys = "functionKeys"
+	functionAppHostKeyTypeSystemKeys   = "systemKeys"
+)
+
+type FunctionAppHostKeyModel struct {
+	FunctionAppId  string `tfschema:"function_app_id"`
+	Name           string `tfschema:"name"`
+	Value          string `tfschema:"value"`
+	ValueWoVersion int64  `tfschema:"value_wo_version"`
+}
+
+// functionAppHostKeyResourceBase contains the behaviour shared by the resources managing a single key of a Function App
+// or Function App Slot host, which differ only in the type of key they manage.
+type functionAppHostKeyResourceBase struct {
+	// resourceType is the name of the resource, e.g. `azurerm_function_app_host_key`
+	resourceType string
+
+	// keyType is the type of the key within the ID, either functionAppHostKeyTypeFunctionKeys or functionAppHostKeyTypeSystemKeys
+	keyType string
+
+	// displayName is the name of the type of key used within descriptions and errors, e.g. `Host Key`
+	displayName string
+}
+
+// keys returns the keys of the type managed by the resource from the keys of the host
+func (br functionAppHostKeyResourceBase) keys(input *webapps.HostKeys) *map[string]string {
+	if input == nil {
+		return nil
+	}
+
+	if br.keyType == functionAppHostKeyTypeSystemKeys {
+		return input.SystemKeys
+	}
+
+	return input.FunctionKeys
+}
+
+func (br functionAppHostKeyResourceBase) idValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.Any(webapps.ValidateDefaultID, webapps.ValidateHostDefaultID)
+}
+
+func (br functionAppHostKeyResourceBase) arguments() map[string]*pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		"function_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  fmt.Sprintf("The ID of the Function App or Function App Slot the %s belongs to.", br.displayName),
+			ValidateFunc: validation.Any(commonids.ValidateAppServiceID, webapps.ValidateSlotID),
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  fmt.Sprintf("The name of the %s.", br.displayName),
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+
+	for k, v := range helpers.FunctionAppKeyValueSchema() {
+		s[k] = v
+	}
+
+	return s
+}
+
+func (br functionAppHostKeyResourceBase) createFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var key FunctionAppHostKeyModel
+			if err := metadata.Decode(&key); err != nil {
+				return err
+			}
+
+			value, err := helpers.ExpandFunctionAppKeyValue(metadata.ResourceData)
+			if err != nil {
+				return err
+			}
+
+			payload := webapps.KeyInfo{
+				Name:  pointer.To(key.Name),
+				Value: value,
+			}
+
+			if slotId, err := webapps.ParseSlotID(key.FunctionAppId); err == nil {
+				id := webapps.NewHostDefaultID(slotId.SubscriptionId, slotId.ResourceGroupName, slotId.SiteName, slotId.SlotName, br.keyType, key.Name)
+
+				locks.ByID(slotId.ID())
+				defer locks.UnlockByID(slotId.ID())
+
+				existing, err := client.ListHostKeysSlot(ctx, *slotId)
+				if err != nil {
+					return fmt.Errorf("listing %ss for %s: %+v", br.displayName, slotId, err)
+				}
+				if keys := br.keys(existing.Model); keys != nil {
+					if _, ok := (*keys)[key.Name]; ok {
+						return metadata.ResourceRequiresImport(br.resourceType, id)
+					}
+				}
+
+				if _, err := client.CreateOrUpdateHostSecretSlot(ctx, id, payload); err != nil {
+					return fmt.Errorf("creating %s: %+v", id, err)
+				}
+
+				metadata.SetID(id)
+				return nil
+			}
+
+			appId, err := commonids.ParseAppServiceID(key.FunctionAppId)
+			if err != nil {
+				return err
+			}
+
+			id := webapps.NewDefaultID(appId.SubscriptionId, appId.ResourceGroupName, appId.SiteName, br.keyType, key.Name)
+
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			existing, err := client.ListHostKeys(ctx, *appId)
+			if err != nil {
+				return fmt.Errorf("listing %ss for %s: %+v", br.displayName, appId, err)
+			}
+			if keys := br.keys(existing.Model); keys != nil {
+				if _, ok := (*keys)[key.Name]; ok {
+					return metadata.ResourceRequiresImport(br.resourceType, id)
+				}
+			}
+
+			if _, err := client.CreateOrUpdateHostSecret(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (br functionAppHostKeyResourceBase) readFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			state := FunctionAppHostKeyModel{
+				ValueWoVersion: int64(metadata.ResourceData.Get("value_wo_version").(int)),
+			}
+
+			var id resourceids.ResourceId
+			var hostKeys *webapps.HostKeys
+			if slotKeyId, err := webapps.ParseHostDefaultID(metadata.ResourceData.Id()); err == nil {
+				id = slotKeyId
+				slotId := webapps.NewSlotID(slotKeyId.SubscriptionId, slotKeyId.ResourceGroupName, slotKeyId.SiteName, slotKeyId.SlotName)
+				resp, err := client.ListHostKeysSlot(ctx, slotId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("listing %ss for %s: %+v", br.displayName, slotId, err)
+				}
+
+				state.FunctionAppId = slotId.ID()
+				state.Name = slotKeyId.KeyName
+				hostKeys = resp.Model
+			} else {
+				appKeyId, err := webapps.ParseDefaultID(metadata.ResourceData.Id())
+				if err != nil {
+					return err
+				}
+
+				id = appKeyId
+				appId := commonids.NewAppServiceID(appKeyId.SubscriptionId, appKeyId.ResourceGroupName, appKeyId.SiteName)
+				resp, err := client.ListHostKeys(ctx, appId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("listing %ss for %s: %+v", br.displayName, appId, err)
+				}
+
+				state.FunctionAppId = appId.ID()
+				state.Name = appKeyId.KeyName
+				hostKeys = resp.Model
+			}
+
+			keys := br.keys(hostKeys)
+			if keys == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			value, ok := (*keys)[state.Name]
+			if !ok {
+				return metadata.MarkAsGone(id)
+			}
+			state.Value = helpers.FunctionAppKeyValueForState(metadata.ResourceData, pointer.To(value))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (br functionAppHostKeyResourceBase) updateFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			if !metadata.ResourceData.HasChanges("value", "value_wo_version") {
+				return nil
+			}
+
+			value, err := helpers.ExpandFunctionAppKeyValue(metadata.ResourceData)
+			if err != nil {
+				return err
+			}
+
+			if id, err := webapps.ParseHostDefaultID(metadata.ResourceData.Id()); err == nil {
+				slotId := webapps.NewSlotID(id.SubscriptionId, id.ResourceGroupName, id.SiteName, id.SlotName)
+
+				locks.ByID(slotId.ID())
+				defer locks.UnlockByID(slotId.ID())
+
+				payload := webapps.KeyInfo{
+					Name:  pointer.To(id.KeyName),
+					Value: value,
+				}
+				if _, err := client.CreateOrUpdateHostSecretSlot(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+				return nil
+			}
+
+			id, err := webapps.ParseDefaultID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			appId := commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroupName, id.SiteName)
+
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			payload := webapps.KeyInfo{
+				Name:  pointer.To(id.KeyName),
+				Value: value,
+			}
+			if _, err := client.CreateOrUpdateHostSecret(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (br functionAppHostKeyResourceBase) deleteFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			if id, err := webapps.ParseHostDefaultID(metadata.ResourceData.Id()); err == nil {
+				slotId := webapps.NewSlotID(id.SubscriptionId, id.ResourceGroupName, id.SiteName, id.SlotName)
+
+				locks.ByID(slotId.ID())
+				defer locks.UnlockByID(slotId.ID())
+
+				if resp, err := client.DeleteHostSecretSlot(ctx, *id); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", id, err)
+				}
+				return nil
+			}
+
+			id, err := webapps.ParseDefaultID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			appId := commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroupName, id.SiteName)
+
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			if resp, err := client.DeleteHostSecret(ctx, *id); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
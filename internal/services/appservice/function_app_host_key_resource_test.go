@@ -0,0 +1,272 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FunctionAppHostKeyResource struct{}
+
+func TestAccFunctionAppHostKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_host_key", "test")
+	r := FunctionAppHostKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFunctionAppHostKey_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_host_key", "test")
+	r := FunctionAppHostKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFunctionAppHostKey_value(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_host_key", "test")
+	r := FunctionAppHostKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.value(data, "acctestHostKeyValue1234567890abcdef"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").HasValue("acctestHostKeyValue1234567890abcdef"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.value(data, "acctestHostKeyValue0987654321fedcba"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").HasValue("acctestHostKeyValue0987654321fedcba"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFunctionAppHostKey_writeOnlyValue(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_host_key", "test")
+	r := FunctionAppHostKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.writeOnlyValue(data, "acctestHostKeyValue1234567890abcdef", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").IsEmpty(),
+			),
+		},
+		data.ImportStep("value", "value_wo_version"),
+		{
+			Config: r.writeOnlyValue(data, "acctestHostKeyValue0987654321fedcba", 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("value", "value_wo_version"),
+	})
+}
+
+func TestAccFunctionAppHostKey_slot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_host_key", "test")
+	r := FunctionAppHostKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.slot(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r FunctionAppHostKeyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	var keys *webapps.HostKeys
+	var name string
+	if id, err := webapps.ParseHostDefaultID(state.ID); err == nil {
+		resp, err := client.AppService.WebAppsClient.ListHostKeysSlot(ctx, webapps.NewSlotID(id.SubscriptionId, id.ResourceGroupName, id.SiteName, id.SlotName))
+		if err != nil {
+			return nil, fmt.Errorf("listing keys for %s: %+v", id, err)
+		}
+		keys = resp.Model
+		name = id.KeyName
+	} else {
+		id, err := webapps.ParseDefaultID(state.ID)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.AppService.WebAppsClient.ListHostKeys(ctx, commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroupName, id.SiteName))
+		if err != nil {
+			return nil, fmt.Errorf("listing keys for %s: %+v", id, err)
+		}
+		keys = resp.Model
+		name = id.KeyName
+	}
+
+	if keys == nil || keys.FunctionKeys == nil {
+		return pointer.To(false), nil
+	}
+	_, ok := (*keys.FunctionKeys)[name]
+
+	return pointer.To(ok), nil
+}
+
+func (r FunctionAppHostKeyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_function_app_host_key" "test" {
+  function_app_id = azurerm_linux_function_app.test.id
+  name            = "acctest-hostkey"
+}
+`, functionAppKeyTemplate(data))
+}
+
+func (r FunctionAppHostKeyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_function_app_host_key" "import" {
+  function_app_id = azurerm_function_app_host_key.test.function_app_id
+  name            = azurerm_function_app_host_key.test.name
+}
+`, r.basic(data))
+}
+
+func (r FunctionAppHostKeyResource) value(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_function_app_host_key" "test" {
+  function_app_id = azurerm_linux_function_app.test.id
+  name            = "acctest-hostkey"
+  value           = %q
+}
+`, functionAppKeyTemplate(data), value)
+}
+
+func (r FunctionAppHostKeyResource) writeOnlyValue(data acceptance.TestData, value string, version int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_function_app_host_key" "test" {
+  function_app_id  = azurerm_linux_function_app.test.id
+  name             = "acctest-hostkey"
+  value_wo         = %q
+  value_wo_version = %d
+}
+`, functionAppKeyTemplate(data), value, version)
+}
+
+func (r FunctionAppHostKeyResource) slot(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_function_app_slot" "test" {
+  name                       = "acctest-LFAS-%d"
+  function_app_id            = azurerm_linux_function_app.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  site_config {
+    application_stack {
+      python_version = "3.9"
+    }
+  }
+}
+
+resource "azurerm_function_app_host_key" "test" {
+  function_app_id = azurerm_linux_function_app_slot.test.id
+  name            = "acctest-hostkey"
+}
+`, functionAppKeyTemplate(data), data.RandomInteger)
+}
+
+func functionAppKeyTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-LFA-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "S1"
+}
+
+resource "azurerm_linux_function_app" "test" {
+  name                = "acctest-LFA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  site_config {
+    application_stack {
+      python_version = "3.9"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FunctionAppSystemKeyResource struct{}
+
+var _ sdk.ResourceWithUpdate = FunctionAppSystemKeyResource{}
+
+func (r FunctionAppSystemKeyResource) base() functionAppHostKeyResourceBase {
+	return functionAppHostKeyResourceBase{
+		resourceType: r.ResourceType(),
+		keyType:      functionAppHostKeyTypeSystemKeys,
+		displayName:  "System Key",
+	}
+}
+
+func (r FunctionAppSystemKeyResource) ModelObject() interface{} {
+	return &FunctionAppHostKeyModel{}
+}
+
+func (r FunctionAppSystemKeyResource) ResourceType() string {
+	return "azurerm_function_app_system_key"
+}
+
+func (r FunctionAppSystemKeyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return r.base().idValidationFunc()
+}
+
+func (r FunctionAppSystemKeyResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.base().arguments()
+}
+
+func (r FunctionAppSystemKeyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r FunctionAppSystemKeyResource) Create() sdk.ResourceFunc {
+	return r.base().createFunc()
+}
+
+func (r FunctionAppSystemKeyResource) Read() sdk.ResourceFunc {
+	return r.base().readFunc()
+}
+
+func (r FunctionAppSystemKeyResource) Update() sdk.ResourceFunc {
+	return r.base().updateFunc()
+}
+
+func (r FunctionAppSystemKeyResource) Delete() sdk.ResourceFunc {
+	return r.base().deleteFunc()
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FunctionAppSystemKeyResource struct{}
+
+func TestAccFunctionAppSystemKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_system_key", "test")
+	r := FunctionAppSystemKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFunctionAppSystemKey_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_system_key", "test")
+	r := FunctionAppSystemKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFunctionAppSystemKey_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_system_key", "test")
+	r := FunctionAppSystemKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.value(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").HasValue("acctestSystemKeyValue1234567890abcdef"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r FunctionAppSystemKeyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	var keys *webapps.HostKeys
+	var name string
+	if id, err := webapps.ParseHostDefaultID(state.ID); err == nil {
+		resp, err := client.AppService.WebAppsClient.ListHostKeysSlot(ctx, webapps.NewSlotID(id.SubscriptionId, id.ResourceGroupName, id.SiteName, id.SlotName))
+		if err != nil {
+			return nil, fmt.Errorf("listing keys for %s: %+v", id, err)
+		}
+		keys = resp.Model
+		name = id.KeyName
+	} else {
+		id, err := webapps.ParseDefaultID(state.ID)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.AppService.WebAppsClient.ListHostKeys(ctx, commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroupName, id.SiteName))
+		if err != nil {
+			return nil, fmt.Errorf("listing keys for %s: %+v", id, err)
+		}
+		keys = resp.Model
+		name = id.KeyName
+	}
+
+	if keys == nil || keys.SystemKeys == nil {
+		return pointer.To(false), nil
+	}
+	_, ok := (*keys.SystemKeys)[name]
+
+	return pointer.To(ok), nil
+}
+
+func (r FunctionAppSystemKeyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_function_app_system_key" "test" {
+  function_app_id = azurerm_linux_function_app.test.id
+  name            = "eventgrid_extension"
+}
+`, functionAppKeyTemplate(data))
+}
+
+func (r FunctionAppSystemKeyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_function_app_system_key" "import" {
+  function_app_id = azurerm_function_app_system_key.test.function_app_id
+  name            = azurerm_function_app_system_key.test.name
+}
+`, r.basic(data))
+}
+
+func (r FunctionAppSystemKeyResource) value(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_function_app_system_key" "test" {
+  function_app_id = azurerm_linux_function_app.test.id
+  name            = "eventgrid_extension"
+  value           = "acctestSystemKeyValue1234567890abcdef"
+}
+`, functionAppKeyTemplate(data))
+}
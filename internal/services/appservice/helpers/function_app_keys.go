@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// FunctionAppKeyValueSchema returns the schema for the value of a Function App Host, System or Function key, which can
+// be specified directly, as a write-only value, or omitted for the value to be generated.
+func FunctionAppKeyValueSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"value": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Computed:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"value_wo"},
+			Description:   "The value of the key. If omitted a value is generated.",
+		},
+
+		"value_wo": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			WriteOnly:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			RequiredWith:  []string{"value_wo_version"},
+			ConflictsWith: []string{"value"},
+			Description:   "The write-only value of the key.",
+		},
+
+		"value_wo_version": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			RequiredWith: []string{"value_wo"},
+			Description:  "The version of `value_wo`, which must be changed to update the key to a new `value_wo`.",
+		},
+	}
+}
+
+// ExpandFunctionAppKeyValue returns the value to send for a Function App key, which is nil when the value should be
+// generated by the service.
+func ExpandFunctionAppKeyValue(d *pluginsdk.ResourceData) (*string, error) {
+	woValue, err := pluginsdk.GetWriteOnly(d, "value_wo", cty.String)
+	if err != nil {
+		return nil, err
+	}
+	if !woValue.IsNull() {
+		return pointer.To(woValue.AsString()), nil
+	}
+
+	// `value` is Computed so only send it when set in the config, otherwise a key that was previously generated is re-sent as is
+	if v := d.GetRawConfig().GetAttr("value"); !v.IsNull() && v.IsKnown() {
+		return pointer.To(v.AsString()), nil
+	}
+
+	return nil, nil
+}
+
+// FunctionAppKeyValueForState returns the value of a key to store in the state, which is omitted when the value
+// was specified using the write-only `value_wo`.
+func FunctionAppKeyValueForState(d *pluginsdk.ResourceData, value *string) string {
+	if _, ok := d.GetOk("value_wo_version"); ok {
+		return ""
+	}
+
+	return pointer.From(value)
+}
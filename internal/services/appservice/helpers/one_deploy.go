@@ -0,0 +1,300 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type OneDeployType string
+
+const (
+	OneDeployTypeEar     OneDeployType = "ear"
+	OneDeployTypeJar     OneDeployType = "jar"
+	OneDeployTypeLib     OneDeployType = "lib"
+	OneDeployTypeScript  OneDeployType = "script"
+	OneDeployTypeStartup OneDeployType = "startup"
+	OneDeployTypeStatic  OneDeployType = "static"
+	OneDeployTypeWar     OneDeployType = "war"
+	OneDeployTypeZip     OneDeployType = "zip"
+)
+
+func PossibleValuesForOneDeployType() []string {
+	return []string{
+		string(OneDeployTypeEar),
+		string(OneDeployTypeJar),
+		string(OneDeployTypeLib),
+		string(OneDeployTypeScript),
+		string(OneDeployTypeStartup),
+		string(OneDeployTypeStatic),
+		string(OneDeployTypeWar),
+		string(OneDeployTypeZip),
+	}
+}
+
+type OneDeployOptions struct {
+	Type       OneDeployType
+	SourceFile string
+	PackageUri string
+	TargetPath string
+	Clean      *bool
+	Restart    *bool
+}
+
+type oneDeployStatus struct {
+	Id       string `json:"id"`
+	Status   *int64 `json:"status"`
+	Complete bool   `json:"complete"`
+}
+
+type oneDeployLogEntry struct {
+	Id         string `json:"id"`
+	LogTime    string `json:"log_time"`
+	Message    string `json:"message"`
+	DetailsUrl string `json:"details_url"`
+}
+
+// ScmHostFromSiteProperties returns the host of the Kudu (SCM) site from the Host Name SSL States of a Web App or Slot.
+func ScmHostFromSiteProperties(props *webapps.SiteProperties) (string, error) {
+	if props == nil || props.HostNameSslStates == nil {
+		return "", fmt.Errorf("could not determine the SCM site, `properties.hostNameSslStates` was nil")
+	}
+
+	for _, v := range *props.HostNameSslStates {
+		if v.Name != nil && *v.Name != "" && pointer.From(v.HostType) == webapps.HostTypeRepository {
+			return fmt.Sprintf("https://%s", *v.Name), nil
+		}
+	}
+
+	return "", fmt.Errorf("could not determine the SCM site, no `Repository` host name was found")
+}
+
+// PublishOneDeploy publishes a package using the Kudu OneDeploy (`/api/publish`) API and waits for the deployment to complete,
+// returning the ID of the deployment. When the deployment fails the deployment log is included in the returned error.
+func PublishOneDeploy(ctx context.Context, host string, user string, passwd string, userAgent string, options OneDeployOptions) (*string, error) {
+	query := url.Values{}
+	query.Set("type", string(options.Type))
+	query.Set("async", "true")
+	if options.TargetPath != "" {
+		query.Set("path", options.TargetPath)
+	}
+	if options.Clean != nil {
+		query.Set("clean", strconv.FormatBool(*options.Clean))
+	}
+	if options.Restart != nil {
+		query.Set("restart", strconv.FormatBool(*options.Restart))
+	}
+	publishEndpoint := fmt.Sprintf("%s/api/publish?%s", host, query.Encode())
+
+	var body io.Reader
+	contentType := "application/octet-stream"
+	if options.SourceFile != "" {
+		f, err := os.Open(options.SourceFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		body = f
+	} else {
+		payload, err := json.Marshal(map[string]string{
+			"packageUri": options.PackageUri,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("marshaling publish request: %+v", err)
+		}
+		body = bytes.NewReader(payload)
+		contentType = "application/json"
+	}
+
+	// The deployment service can be unavailable if the app is recycling, so wait for it to become available first
+	if err := pollDeploymentServiceStatus(ctx, host, user, passwd); err != nil {
+		return nil, fmt.Errorf("checking deployment service status: %+v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, publishEndpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("preparing publish request: %+v", err)
+	}
+
+	req.SetBasicAuth(user, passwd)
+	req.Header["Cache-Control"] = []string{"no-cache"}
+	req.Header["User-Agent"] = []string{userAgent}
+	req.Header["Content-Type"] = []string{contentType}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending publish request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		if resp.StatusCode == http.StatusConflict {
+			return nil, fmt.Errorf("publishing failed with %s - Another deployment is in progress", resp.Status)
+		}
+		message, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("publishing failed with status code %s: %s", resp.Status, string(message))
+	}
+
+	statusEndpoint := resp.Header.Get("Location")
+	if statusEndpoint == "" {
+		statusEndpoint = fmt.Sprintf("%s/api/deployments/latest", host)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil, fmt.Errorf("publish request context had no deadline")
+	}
+
+	deployWait := &pluginsdk.StateChangeConf{
+		Pending:      []string{"pending"},
+		Target:       []string{"complete", "failed"},
+		PollInterval: 10 * time.Second,
+		Delay:        10 * time.Second,
+		Timeout:      time.Until(deadline),
+		Refresh:      oneDeployStatusRefreshFunc(ctx, statusEndpoint, user, passwd),
+	}
+
+	result, err := deployWait.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for the deployment to complete: %+v", err)
+	}
+
+	status, ok := result.(*oneDeployStatus)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T for the deployment status", result)
+	}
+
+	if pointer.From(status.Status) == zipDeployError {
+		log, err := oneDeployLog(ctx, host, user, passwd, status.Id)
+		if err != nil {
+			return nil, fmt.Errorf("deployment %q failed, additionally retrieving the deployment log failed: %+v", status.Id, err)
+		}
+		return nil, fmt.Errorf("deployment %q failed:\n%s", status.Id, log)
+	}
+
+	return pointer.To(status.Id), nil
+}
+
+func oneDeployStatusRefreshFunc(ctx context.Context, endpoint string, user string, passwd string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+		if err != nil {
+			return nil, "", err
+		}
+		req.SetBasicAuth(user, passwd)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, "", err
+		}
+		defer resp.Body.Close()
+
+		// the deployment may not have been registered yet
+		if resp.StatusCode == http.StatusNotFound {
+			return &oneDeployStatus{}, "pending", nil
+		}
+
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+			return nil, "", fmt.Errorf("failed to read deployment status: %s", resp.Status)
+		}
+
+		status := &oneDeployStatus{}
+		if err := json.NewDecoder(resp.Body).Decode(status); err != nil {
+			return nil, "", fmt.Errorf("could not parse the deployment status response: %+v", err)
+		}
+
+		switch pointer.From(status.Status) {
+		case zipDeployError:
+			return status, "failed", nil
+		case zipDeployComplete:
+			return status, "complete", nil
+		default:
+			return status, "pending", nil
+		}
+	}
+}
+
+// oneDeployLog retrieves the log of a deployment, including the details of each log entry where available
+func oneDeployLog(ctx context.Context, host string, user string, passwd string, deploymentId string) (string, error) {
+	entries, err := getOneDeployLogEntries(ctx, fmt.Sprintf("%s/api/deployments/%s/log", host, url.PathEscape(deploymentId)), user, passwd)
+	if err != nil {
+		return "", err
+	}
+
+	lines := make([]string, 0)
+	for _, entry := range entries {
+		lines = append(lines, fmt.Sprintf("%s %s", entry.LogTime, entry.Message))
+
+		if entry.DetailsUrl == "" {
+			continue
+		}
+		details, err := getOneDeployLogEntries(ctx, entry.DetailsUrl, user, passwd)
+		if err != nil {
+			continue
+		}
+		for _, detail := range details {
+			lines = append(lines, fmt.Sprintf("  %s %s", detail.LogTime, detail.Message))
+		}
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+func getOneDeployLogEntries(ctx context.Context, endpoint string, user string, passwd string) ([]oneDeployLogEntry, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(user, passwd)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to read deployment log: %s", resp.Status)
+	}
+
+	entries := make([]oneDeployLogEntry, 0)
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("could not parse the deployment log: %+v", err)
+	}
+
+	return entries, nil
+}
+
+// OneDeploySourceFileSha256 returns the hex encoded SHA256 hash of the contents of a local package, which is used to
+// detect changes to the package between deployments.
+func OneDeploySourceFileSha256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
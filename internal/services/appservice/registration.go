@@ -48,7 +48,10 @@ func (r Registration) Resources() []sdk.Resource {
 		FunctionAppActiveSlotResource{},
 		FunctionAppFlexConsumptionResource{},
 		FunctionAppFunctionResource{},
+		FunctionAppFunctionKeyResource{},
+		FunctionAppHostKeyResource{},
 		FunctionAppHybridConnectionResource{},
+		FunctionAppSystemKeyResource{},
 		LinuxFunctionAppResource{},
 		LinuxFunctionAppSlotResource{},
 		LinuxWebAppResource{},
@@ -60,6 +63,7 @@ func (r Registration) Resources() []sdk.Resource {
 		StaticWebAppCustomDomainResource{},
 		StaticWebAppFunctionAppRegistrationResource{},
 		WebAppActiveSlotResource{},
+		WebAppDeploymentResource{},
 		WebAppHybridConnectionResource{},
 		WindowsFunctionAppResource{},
 		WindowsFunctionAppSlotResource{},
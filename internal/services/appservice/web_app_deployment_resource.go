@@ -0,0 +1,348 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type WebAppDeploymentResource struct{}
+
+type WebAppDeploymentModel struct {
+	AppId                  string `tfschema:"app_id"`
+	Type                   string `tfschema:"type"`
+	SourceFilePath         string `tfschema:"source_file_path"`
+	PackageUrl             string `tfschema:"package_url"`
+	TargetPath             string `tfschema:"target_path"`
+	CleanDeploymentEnabled bool   `tfschema:"clean_deployment_enabled"`
+	RestartEnabled         bool   `tfschema:"restart_enabled"`
+	SourceFileSha256       string `tfschema:"source_file_sha256"`
+	Status                 int64  `tfschema:"status"`
+	StartTime              string `tfschema:"start_time"`
+	EndTime                string `tfschema:"end_time"`
+}
+
+var _ sdk.ResourceWithCustomizeDiff = WebAppDeploymentResource{}
+
+func (r WebAppDeploymentResource) ModelObject() interface{} {
+	return &WebAppDeploymentModel{}
+}
+
+func (r WebAppDeploymentResource) ResourceType() string {
+	return "azurerm_web_app_deployment"
+}
+
+func (r WebAppDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.Any(webapps.ValidateDeploymentID, webapps.ValidateSlotDeploymentID)
+}
+
+func (r WebAppDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "The ID of the Web App, Function App or Slot to deploy to.",
+			ValidateFunc: validation.Any(commonids.ValidateAppServiceID, webapps.ValidateSlotID),
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "The type of the package to deploy.",
+			ValidateFunc: validation.StringInSlice(helpers.PossibleValuesForOneDeployType(), false),
+		},
+
+		"source_file_path": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Description:  "The local path and filename of the package to deploy.",
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"source_file_path", "package_url"},
+		},
+
+		"package_url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Description:  "The URL of the package to deploy, which the App downloads the package from.",
+			ValidateFunc: validation.IsURLWithHTTPS,
+			ExactlyOneOf: []string{"source_file_path", "package_url"},
+		},
+
+		"target_path": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Description:  "The absolute path to deploy the package to, for example `/home/site/wwwroot/webapps`.",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"clean_deployment_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Should files in the target directory which are not part of the package be removed? Defaults to the default behaviour of the package `type`.",
+		},
+
+		"restart_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     true,
+			Description: "Should the App be restarted after the deployment? Defaults to `true`.",
+		},
+	}
+}
+
+func (r WebAppDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"source_file_sha256": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The SHA256 hash of the file specified in `source_file_path`, a change to which triggers a new deployment.",
+		},
+
+		"status": {
+			Type:        pluginsdk.TypeInt,
+			Computed:    true,
+			Description: "The status of the deployment.",
+		},
+
+		"start_time": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The time the deployment started.",
+		},
+
+		"end_time": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The time the deployment ended.",
+		},
+	}
+}
+
+func (r WebAppDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var deployment WebAppDeploymentModel
+			if err := metadata.Decode(&deployment); err != nil {
+				return err
+			}
+
+			options := helpers.OneDeployOptions{
+				Type:       helpers.OneDeployType(deployment.Type),
+				SourceFile: deployment.SourceFilePath,
+				PackageUri: deployment.PackageUrl,
+				TargetPath: deployment.TargetPath,
+				Restart:    pointer.To(deployment.RestartEnabled),
+			}
+			// `clean` has a different default per package type, so is only sent when explicitly set
+			if v, ok := metadata.ResourceData.GetOkExists("clean_deployment_enabled"); ok {
+				options.Clean = pointer.To(v.(bool))
+			}
+
+			if deployment.SourceFilePath != "" {
+				hash, err := helpers.OneDeploySourceFileSha256(deployment.SourceFilePath)
+				if err != nil {
+					return fmt.Errorf("hashing `source_file_path`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("source_file_sha256", hash); err != nil {
+					return fmt.Errorf("setting `source_file_sha256`: %+v", err)
+				}
+			}
+
+			if slotId, err := webapps.ParseSlotID(deployment.AppId); err == nil {
+				appId := commonids.NewAppServiceID(slotId.SubscriptionId, slotId.ResourceGroupName, slotId.SiteName)
+
+				slot, err := client.GetSlot(ctx, *slotId)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", slotId, err)
+				}
+				if slot.Model == nil {
+					return fmt.Errorf("retrieving %s: `model` was nil", slotId)
+				}
+
+				host, err := helpers.ScmHostFromSiteProperties(slot.Model.Properties)
+				if err != nil {
+					return fmt.Errorf("deploying to %s: %+v", slotId, err)
+				}
+
+				user, passwd, err := helpers.GetSitePublishingCredentialsSlot(ctx, client, *slotId)
+				if err != nil {
+					return err
+				}
+
+				locks.ByID(appId.ID())
+				defer locks.UnlockByID(appId.ID())
+
+				deploymentId, err := helpers.PublishOneDeploy(ctx, host, pointer.From(user), pointer.From(passwd), client.Client.UserAgent, options)
+				if err != nil {
+					return fmt.Errorf("deploying to %s: %+v", slotId, err)
+				}
+
+				metadata.SetID(webapps.NewSlotDeploymentID(slotId.SubscriptionId, slotId.ResourceGroupName, slotId.SiteName, slotId.SlotName, *deploymentId))
+				return nil
+			}
+
+			appId, err := commonids.ParseAppServiceID(deployment.AppId)
+			if err != nil {
+				return err
+			}
+
+			app, err := client.Get(ctx, *appId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", appId, err)
+			}
+			if app.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", appId)
+			}
+
+			host, err := helpers.ScmHostFromSiteProperties(app.Model.Properties)
+			if err != nil {
+				return fmt.Errorf("deploying to %s: %+v", appId, err)
+			}
+
+			user, passwd, err := helpers.GetSitePublishingCredentials(ctx, client, *appId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			deploymentId, err := helpers.PublishOneDeploy(ctx, host, pointer.From(user), pointer.From(passwd), client.Client.UserAgent, options)
+			if err != nil {
+				return fmt.Errorf("deploying to %s: %+v", appId, err)
+			}
+
+			metadata.SetID(webapps.NewDeploymentID(appId.SubscriptionId, appId.ResourceGroupName, appId.SiteName, *deploymentId))
+			return nil
+		},
+	}
+}
+
+func (r WebAppDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			// the deployment details which are only part of the publish request are kept from the config / state
+			var state WebAppDeploymentModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+			if _, ok := metadata.ResourceData.GetOk("restart_enabled"); !ok {
+				state.RestartEnabled = true
+			}
+
+			var props *webapps.DeploymentProperties
+			if slotDeploymentId, err := webapps.ParseSlotDeploymentID(metadata.ResourceData.Id()); err == nil {
+				resp, err := client.GetDeploymentSlot(ctx, *slotDeploymentId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(slotDeploymentId)
+					}
+					return fmt.Errorf("retrieving %s: %+v", slotDeploymentId, err)
+				}
+
+				state.AppId = webapps.NewSlotID(slotDeploymentId.SubscriptionId, slotDeploymentId.ResourceGroupName, slotDeploymentId.SiteName, slotDeploymentId.SlotName).ID()
+				if model := resp.Model; model != nil {
+					props = model.Properties
+				}
+			} else {
+				id, err := webapps.ParseDeploymentID(metadata.ResourceData.Id())
+				if err != nil {
+					return err
+				}
+
+				resp, err := client.GetDeployment(ctx, *id)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("retrieving %s: %+v", id, err)
+				}
+
+				state.AppId = commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroupName, id.SiteName).ID()
+				if model := resp.Model; model != nil {
+					props = model.Properties
+				}
+			}
+
+			if props != nil {
+				state.Status = pointer.From(props.Status)
+				state.StartTime = pointer.From(props.StartTime)
+				state.EndTime = pointer.From(props.EndTime)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WebAppDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Nothing to do here - deleting the deployment record does not remove the deployed content from the App,
+			// and keeping it retains the deployment history of the App.
+			return nil
+		},
+	}
+}
+
+func (r WebAppDeploymentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			if !rd.NewValueKnown("source_file_path") {
+				return rd.SetNewComputed("source_file_sha256")
+			}
+
+			sourceFile := rd.Get("source_file_path").(string)
+			if sourceFile == "" {
+				return nil
+			}
+
+			// the file may not exist yet when it's created during the apply, in which case the hash is determined on apply
+			hash, err := helpers.OneDeploySourceFileSha256(sourceFile)
+			if err != nil {
+				return rd.SetNewComputed("source_file_sha256")
+			}
+
+			if old := rd.Get("source_file_sha256").(string); old != hash {
+				if err := rd.SetNew("source_file_sha256", hash); err != nil {
+					return err
+				}
+				if rd.Id() != "" {
+					return rd.ForceNew("source_file_sha256")
+				}
+			}
+
+			return nil
+		},
+	}
+}
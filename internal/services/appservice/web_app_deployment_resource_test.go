@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type WebAppDeploymentResource struct{}
+
+func TestAccWebAppDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_deployment", "test")
+	r := WebAppDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_file_sha256").IsNotEmpty(),
+			),
+		},
+		// the package details are only part of the publish request, and can't be read back from the deployment
+		data.ImportStep("type", "source_file_path", "source_file_sha256", "restart_enabled"),
+	})
+}
+
+func TestAccWebAppDeployment_slot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_deployment", "test")
+	r := WebAppDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.slot(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("type", "source_file_path", "source_file_sha256", "target_path", "clean_deployment_enabled", "restart_enabled"),
+	})
+}
+
+func TestAccWebAppDeployment_packageUrl(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_deployment", "test")
+	r := WebAppDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.packageUrl(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_file_sha256").IsEmpty(),
+			),
+		},
+		data.ImportStep("type", "package_url", "restart_enabled"),
+	})
+}
+
+func TestAccWebAppDeployment_sourceFileChanged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_deployment", "test")
+	r := WebAppDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.sourceFileChanged(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r WebAppDeploymentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if id, err := webapps.ParseSlotDeploymentID(state.ID); err == nil {
+		resp, err := client.AppService.WebAppsClient.GetDeploymentSlot(ctx, *id)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		return pointer.To(resp.Model != nil), nil
+	}
+
+	id, err := webapps.ParseDeploymentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.WebAppsClient.GetDeployment(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r WebAppDeploymentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_deployment" "test" {
+  app_id           = azurerm_linux_web_app.test.id
+  type             = "zip"
+  source_file_path = "./testdata/msdocs-python-flask-webapp-quickstart-main.zip"
+}
+`, r.template(data))
+}
+
+func (r WebAppDeploymentResource) sourceFileChanged(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_deployment" "test" {
+  app_id           = azurerm_linux_web_app.test.id
+  type             = "zip"
+  source_file_path = "./testdata/dotnet-zipdeploy.zip"
+}
+`, r.template(data))
+}
+
+func (r WebAppDeploymentResource) slot(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app_slot" "test" {
+  name           = "acctestWAS-%[2]d"
+  app_service_id = azurerm_linux_web_app.test.id
+
+  site_config {
+    application_stack {
+      python_version = "3.12"
+    }
+  }
+}
+
+resource "azurerm_web_app_deployment" "test" {
+  app_id                   = azurerm_linux_web_app_slot.test.id
+  type                     = "static"
+  source_file_path         = "./testdata/host.json"
+  target_path              = "/home/site/wwwroot/host.json"
+  clean_deployment_enabled = false
+  restart_enabled          = false
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r WebAppDeploymentResource) packageUrl(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_deployment" "test" {
+  app_id      = azurerm_linux_web_app.test.id
+  type        = "zip"
+  package_url = "https://github.com/Azure-Samples/msdocs-python-flask-webapp-quickstart/archive/refs/heads/main.zip"
+}
+`, r.template(data))
+}
+
+func (WebAppDeploymentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "S1"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  app_settings = {
+    SCM_DO_BUILD_DURING_DEPLOYMENT = "true"
+  }
+
+  site_config {
+    application_stack {
+      python_version = "3.12"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,359 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-02-01/profiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/frontdoor/2020-05-01/frontdoors"
+	waf "github.com/hashicorp/go-azure-sdk/resource-manager/frontdoor/2025-03-01/webapplicationfirewallpolicies"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+)
+
+type FrontDoorClassicMigrationAction struct {
+	sdk.ActionMetadata
+}
+
+type FrontDoorClassicMigrationActionModel struct {
+	FrontDoorId                          types.String `tfsdk:"front_door_id"`
+	ProfileName                          types.String `tfsdk:"profile_name"`
+	SkuName                              types.String `tfsdk:"sku_name"`
+	WebApplicationFirewallPolicyMappings types.Map    `tfsdk:"web_application_firewall_policy_mappings"`
+	CommitEnabled                        types.Bool   `tfsdk:"commit_enabled"`
+	Timeout                              types.String `tfsdk:"timeout"`
+}
+
+var _ sdk.Action = &FrontDoorClassicMigrationAction{}
+
+func newCDNFrontDoorClassicMigrationAction() action.Action {
+	return &FrontDoorClassicMigrationAction{}
+}
+
+func (a *FrontDoorClassicMigrationAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"front_door_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the classic Front Door to migrate.",
+				MarkdownDescription: "The ID of the classic Front Door to migrate.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: frontdoors.ValidateFrontDoorID,
+					},
+				},
+			},
+
+			"profile_name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the Front Door Profile which should be created by the migration, within the Resource Group of the classic Front Door.",
+				MarkdownDescription: "The name of the Front Door Profile which should be created by the migration, within the Resource Group of the classic Front Door.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.FrontDoorName,
+					},
+				},
+			},
+
+			"sku_name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The SKU of the Front Door Profile which should be created by the migration.",
+				MarkdownDescription: "The SKU of the Front Door Profile which should be created by the migration.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(profiles.SkuNamePremiumAzureFrontDoor),
+						string(profiles.SkuNameStandardAzureFrontDoor),
+					),
+				},
+			},
+
+			"web_application_firewall_policy_mappings": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "A mapping of the IDs of the classic Web Application Firewall Policies associated with the Front Door to the IDs of the Web Application Firewall Policies which should be used by the Front Door Profile.",
+				MarkdownDescription: "A mapping of the IDs of the classic Web Application Firewall Policies associated with the Front Door to the IDs of the Web Application Firewall Policies which should be used by the Front Door Profile.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						typehelpers.WrappedStringValidator{
+							Func: waf.ValidateFrontDoorWebApplicationFirewallPolicyID,
+						},
+					),
+					mapvalidator.ValueStringsAre(
+						typehelpers.WrappedStringValidator{
+							Func: waf.ValidateFrontDoorWebApplicationFirewallPolicyID,
+						},
+					),
+				},
+			},
+
+			"commit_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether the migration should be committed once the Front Door Profile has been created, which removes the classic Front Door. Defaults to `true`.",
+				MarkdownDescription: "Whether the migration should be committed once the Front Door Profile has been created, which removes the classic Front Door. Defaults to `true`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the Front Door Classic Migration action to complete. Defaults to `2h`.",
+				MarkdownDescription: "Timeout duration for the Front Door Classic Migration action to complete. Defaults to `2h`.",
+			},
+		},
+	}
+}
+
+func (a *FrontDoorClassicMigrationAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_cdn_front_door_classic_migration"
+}
+
+func (a *FrontDoorClassicMigrationAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Cdn.FrontDoorProfilesClient
+
+	model := FrontDoorClassicMigrationActionModel{}
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 2 * time.Hour
+	if t := model.Timeout; !t.IsNull() {
+		timeout, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+		ctxTimeout = timeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	frontDoorId, err := frontdoors.ParseFrontDoorID(model.FrontDoorId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing `front_door_id`", err)
+		return
+	}
+
+	resourceGroupId := commonids.NewResourceGroupID(frontDoorId.SubscriptionId, frontDoorId.ResourceGroupName)
+	profileId := profiles.NewProfileID(frontDoorId.SubscriptionId, frontDoorId.ResourceGroupName, model.ProfileName.ValueString())
+
+	commit := true
+	if !model.CommitEnabled.IsNull() {
+		commit = model.CommitEnabled.ValueBool()
+	}
+
+	// the migration may have been performed by a previous invocation which wasn't committed, in which case only the
+	// commit remains to be done
+	state, err := frontDoorClassicMigrationProfileState(ctx, client, profileId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("checking for existing %s", profileId), err)
+		return
+	}
+
+	migrate := true
+	if state != nil {
+		switch *state {
+		case profiles.ProfileResourceStatePendingMigrationCommit:
+			migrate = false
+		case profiles.ProfileResourceStateActive, profiles.ProfileResourceStateMigrated:
+			// once the migration has been committed the classic Front Door is removed
+			exists, err := frontDoorClassicExists(ctx, a.Client.Frontdoor.FrontDoorsClient, *frontDoorId)
+			if err != nil {
+				sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("checking for existing %s", frontDoorId), err)
+				return
+			}
+			if exists {
+				sdk.SetResponseErrorDiagnostic(response, "migrating Front Door", fmt.Errorf("%s already exists and isn't pending the commit of a migration", profileId))
+				return
+			}
+
+			response.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("%s has already been migrated to %s", frontDoorId, profileId),
+			})
+			return
+		default:
+			sdk.SetResponseErrorDiagnostic(response, "migrating Front Door", fmt.Errorf("%s already exists in the state %q", profileId, *state))
+			return
+		}
+	}
+
+	if migrate {
+		classicReference := profiles.ResourceReference{
+			Id: pointer.To(frontDoorId.ID()),
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("checking whether %s can be migrated", frontDoorId),
+		})
+
+		if err := checkFrontDoorClassicCanMigrate(ctx, client, resourceGroupId, classicReference); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("checking whether %s can be migrated", frontDoorId), err)
+			return
+		}
+
+		mappings := make(map[string]string)
+		if !model.WebApplicationFirewallPolicyMappings.IsNull() {
+			response.Diagnostics.Append(model.WebApplicationFirewallPolicyMappings.ElementsAs(ctx, &mappings, false)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		payload := profiles.MigrationParameters{
+			ClassicResourceReference:                classicReference,
+			MigrationWebApplicationFirewallMappings: expandFrontDoorClassicMigrationFirewallMappings(mappings),
+			ProfileName:                             profileId.ProfileName,
+			Sku: profiles.Sku{
+				Name: pointer.To(profiles.SkuName(model.SkuName.ValueString())),
+			},
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("migrating %s to %s", frontDoorId, profileId),
+		})
+
+		resp, err := client.Migrate(ctx, resourceGroupId, payload)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("migrating %s", frontDoorId), err)
+			return
+		}
+		if err := resp.Poller.PollUntilDone(ctx); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("waiting for the migration of %s", frontDoorId), err)
+			return
+		}
+
+		// the result of this long running operation is only available from the final polling response
+		var result profiles.MigrateResult
+		if err := resp.Poller.FinalResult(&result); err == nil && result.Properties != nil && result.Properties.MigratedProfileResourceId != nil {
+			migratedId, err := profiles.ParseProfileIDInsensitively(pointer.From(result.Properties.MigratedProfileResourceId.Id))
+			if err == nil {
+				profileId = *migratedId
+			}
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("migrated %s to the Front Door Profile %q", frontDoorId, profileId.ID()),
+	})
+
+	if !commit {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("skipping the commit of the migration to %s since `commit_enabled` is `false`", profileId),
+		})
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("committing the migration to %s", profileId),
+	})
+
+	if err := client.MigrationCommitThenPoll(ctx, profileId); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, fmt.Sprintf("committing the migration to %s", profileId), err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("committed the migration of %s to the Front Door Profile %q", frontDoorId, profileId.ID()),
+	})
+}
+
+func (a *FrontDoorClassicMigrationAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
+
+// frontDoorClassicMigrationProfileState returns the resource state of the Front Door Profile, or nil when it doesn't exist
+func frontDoorClassicMigrationProfileState(ctx context.Context, client *profiles.ProfilesClient, id profiles.ProfileId) (*profiles.ProfileResourceState, error) {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	state := profiles.ProfileResourceState("")
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ResourceState != nil {
+		state = *model.Properties.ResourceState
+	}
+
+	return &state, nil
+}
+
+func frontDoorClassicExists(ctx context.Context, client *frontdoors.FrontDoorsClient, id frontdoors.FrontDoorId) (bool, error) {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func checkFrontDoorClassicCanMigrate(ctx context.Context, client *profiles.ProfilesClient, id commonids.ResourceGroupId, classicReference profiles.ResourceReference) error {
+	resp, err := client.CanMigrate(ctx, id, profiles.CanMigrateParameters{
+		ClassicResourceReference: classicReference,
+	})
+	if err != nil {
+		return err
+	}
+	if err := resp.Poller.PollUntilDone(ctx); err != nil {
+		return err
+	}
+
+	// the result of this long running operation is only available from the final polling response
+	var result profiles.CanMigrateResult
+	if err := resp.Poller.FinalResult(&result); err != nil {
+		return fmt.Errorf("retrieving result: %+v", err)
+	}
+
+	if result.Properties == nil || pointer.From(result.Properties.CanMigrate) {
+		return nil
+	}
+
+	reasons := make([]string, 0)
+	for _, e := range pointer.From(result.Properties.Errors) {
+		reason := fmt.Sprintf("%s: %s", pointer.From(e.ResourceName), pointer.From(e.ErrorMessage))
+		if nextSteps := pointer.From(e.NextSteps); nextSteps != "" {
+			reason = fmt.Sprintf("%s (%s)", reason, nextSteps)
+		}
+		reasons = append(reasons, reason)
+	}
+
+	return fmt.Errorf("the Front Door can't be migrated: %s", strings.Join(reasons, "; "))
+}
+
+func expandFrontDoorClassicMigrationFirewallMappings(input map[string]string) *[]profiles.MigrationWebApplicationFirewallMapping {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]profiles.MigrationWebApplicationFirewallMapping, 0)
+	for from, to := range input {
+		results = append(results, profiles.MigrationWebApplicationFirewallMapping{
+			MigratedFrom: &profiles.ResourceReference{
+				Id: pointer.To(from),
+			},
+			MigratedTo: &profiles.ResourceReference{
+				Id: pointer.To(to),
+			},
+		})
+	}
+
+	return &results
+}
@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-02-01/profiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-02-01/rulesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2024-09-01/rules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2025-04-15/afdcustomdomains"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cdn/2025-06-01/afdendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = CdnFrontDoorProfileImportIdsDataSource{}
+
+type CdnFrontDoorProfileImportIdsDataSource struct{}
+
+type CdnFrontDoorProfileImportIdsDataSourceModel struct {
+	CdnFrontDoorProfileId string            `tfschema:"cdn_frontdoor_profile_id"`
+	CustomDomainIds       map[string]string `tfschema:"custom_domain_ids"`
+	EndpointIds           map[string]string `tfschema:"endpoint_ids"`
+	OriginGroupIds        map[string]string `tfschema:"origin_group_ids"`
+	OriginIds             map[string]string `tfschema:"origin_ids"`
+	RouteIds              map[string]string `tfschema:"route_ids"`
+	RuleSetIds            map[string]string `tfschema:"rule_set_ids"`
+	RuleIds               map[string]string `tfschema:"rule_ids"`
+}
+
+func (CdnFrontDoorProfileImportIdsDataSource) ResourceType() string {
+	return "azurerm_cdn_frontdoor_profile_import_ids"
+}
+
+func (CdnFrontDoorProfileImportIdsDataSource) ModelObject() interface{} {
+	return &CdnFrontDoorProfileImportIdsDataSourceModel{}
+}
+
+func (CdnFrontDoorProfileImportIdsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cdn_frontdoor_profile_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.FrontDoorProfileID,
+		},
+	}
+}
+
+func (CdnFrontDoorProfileImportIdsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	idMap := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"custom_domain_ids": idMap(),
+
+		"endpoint_ids": idMap(),
+
+		"origin_group_ids": idMap(),
+
+		"origin_ids": idMap(),
+
+		"route_ids": idMap(),
+
+		"rule_set_ids": idMap(),
+
+		"rule_ids": idMap(),
+	}
+}
+
+func (CdnFrontDoorProfileImportIdsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cdn
+
+			var state CdnFrontDoorProfileImportIdsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.FrontDoorProfileID(state.CdnFrontDoorProfileId)
+			if err != nil {
+				return err
+			}
+
+			state.CustomDomainIds = make(map[string]string)
+			state.EndpointIds = make(map[string]string)
+			state.OriginGroupIds = make(map[string]string)
+			state.OriginIds = make(map[string]string)
+			state.RouteIds = make(map[string]string)
+			state.RuleSetIds = make(map[string]string)
+			state.RuleIds = make(map[string]string)
+
+			customDomains, err := client.AFDCustomDomainsClient.ListByProfileComplete(ctx, afdcustomdomains.NewProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName))
+			if err != nil {
+				return fmt.Errorf("listing Custom Domains for %s: %+v", *id, err)
+			}
+			for _, v := range customDomains.Items {
+				name := pointer.From(v.Name)
+				state.CustomDomainIds[name] = parse.NewFrontDoorCustomDomainID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, name).ID()
+			}
+
+			endpoints, err := client.AFDEndpointsClient.ListByProfileComplete(ctx, afdendpoints.NewProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName))
+			if err != nil {
+				return fmt.Errorf("listing Endpoints for %s: %+v", *id, err)
+			}
+			for _, v := range endpoints.Items {
+				endpointName := pointer.From(v.Name)
+				state.EndpointIds[endpointName] = parse.NewFrontDoorEndpointID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, endpointName).ID()
+
+				routes, err := client.FrontDoorRoutesClient.ListByEndpointComplete(ctx, id.ResourceGroup, id.ProfileName, endpointName)
+				if err != nil {
+					return fmt.Errorf("listing Routes for Endpoint %q within %s: %+v", endpointName, *id, err)
+				}
+				for routes.NotDone() {
+					routeName := pointer.From(routes.Value().Name)
+					state.RouteIds[fmt.Sprintf("%s/%s", endpointName, routeName)] = parse.NewFrontDoorRouteID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, endpointName, routeName).ID()
+
+					if err := routes.NextWithContext(ctx); err != nil {
+						return fmt.Errorf("listing Routes for Endpoint %q within %s: %+v", endpointName, *id, err)
+					}
+				}
+			}
+
+			originGroups, err := client.FrontDoorOriginGroupsClient.ListByProfileComplete(ctx, id.ResourceGroup, id.ProfileName)
+			if err != nil {
+				return fmt.Errorf("listing Origin Groups for %s: %+v", *id, err)
+			}
+			originGroupNames := make([]string, 0)
+			for originGroups.NotDone() {
+				originGroupName := pointer.From(originGroups.Value().Name)
+				originGroupNames = append(originGroupNames, originGroupName)
+				state.OriginGroupIds[originGroupName] = parse.NewFrontDoorOriginGroupID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, originGroupName).ID()
+
+				if err := originGroups.NextWithContext(ctx); err != nil {
+					return fmt.Errorf("listing Origin Groups for %s: %+v", *id, err)
+				}
+			}
+
+			for _, originGroupName := range originGroupNames {
+				origins, err := client.FrontDoorOriginsClient.ListByOriginGroupComplete(ctx, id.ResourceGroup, id.ProfileName, originGroupName)
+				if err != nil {
+					return fmt.Errorf("listing Origins for Origin Group %q within %s: %+v", originGroupName, *id, err)
+				}
+				for origins.NotDone() {
+					originName := pointer.From(origins.Value().Name)
+					state.OriginIds[fmt.Sprintf("%s/%s", originGroupName, originName)] = parse.NewFrontDoorOriginID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, originGroupName, originName).ID()
+
+					if err := origins.NextWithContext(ctx); err != nil {
+						return fmt.Errorf("listing Origins for Origin Group %q within %s: %+v", originGroupName, *id, err)
+					}
+				}
+			}
+
+			ruleSets, err := client.FrontDoorRuleSetsClient.ListByProfileComplete(ctx, rulesets.NewProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName))
+			if err != nil {
+				return fmt.Errorf("listing Rule Sets for %s: %+v", *id, err)
+			}
+			for _, v := range ruleSets.Items {
+				ruleSetName := pointer.From(v.Name)
+				state.RuleSetIds[ruleSetName] = parse.NewFrontDoorRuleSetID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, ruleSetName).ID()
+
+				ruleSetRules, err := client.FrontDoorRulesClient.ListByRuleSetComplete(ctx, rules.NewRuleSetID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, ruleSetName))
+				if err != nil {
+					return fmt.Errorf("listing Rules for Rule Set %q within %s: %+v", ruleSetName, *id, err)
+				}
+				for _, rule := range ruleSetRules.Items {
+					ruleName := pointer.From(rule.Name)
+					state.RuleIds[fmt.Sprintf("%s/%s", ruleSetName, ruleName)] = parse.NewFrontDoorRuleID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, ruleSetName, ruleName).ID()
+				}
+			}
+
+			state.CdnFrontDoorProfileId = id.ID()
+
+			metadata.SetID(profiles.NewProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
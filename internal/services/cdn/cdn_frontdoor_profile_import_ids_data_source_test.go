@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CdnFrontDoorProfileImportIdsDataSource struct{}
+
+func TestAccCdnFrontDoorProfileImportIdsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cdn_frontdoor_profile_import_ids", "test")
+	d := CdnFrontDoorProfileImportIdsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("endpoint_ids.%").HasValue("1"),
+				check.That(data.ResourceName).Key(fmt.Sprintf("endpoint_ids.acctest-cdnfdendpoint-%d", data.RandomInteger)).MatchesOtherKey(check.That("azurerm_cdn_frontdoor_endpoint.test").Key("id")),
+				check.That(data.ResourceName).Key("origin_group_ids.%").HasValue("1"),
+				check.That(data.ResourceName).Key(fmt.Sprintf("origin_group_ids.acctest-origingroup-%d", data.RandomInteger)).MatchesOtherKey(check.That("azurerm_cdn_frontdoor_origin_group.test").Key("id")),
+				check.That(data.ResourceName).Key("origin_ids.%").HasValue("1"),
+				check.That(data.ResourceName).Key(fmt.Sprintf("origin_ids.acctest-origingroup-%[1]d/acctest-origin-%[1]d", data.RandomInteger)).MatchesOtherKey(check.That("azurerm_cdn_frontdoor_origin.test").Key("id")),
+				check.That(data.ResourceName).Key("route_ids.%").HasValue("1"),
+				check.That(data.ResourceName).Key(fmt.Sprintf("route_ids.acctest-cdnfdendpoint-%[1]d/acctest-route-%[1]d", data.RandomInteger)).MatchesOtherKey(check.That("azurerm_cdn_frontdoor_route.test").Key("id")),
+				check.That(data.ResourceName).Key("rule_set_ids.%").HasValue("1"),
+				check.That(data.ResourceName).Key("rule_ids.%").HasValue("1"),
+				check.That(data.ResourceName).Key("custom_domain_ids.%").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (CdnFrontDoorProfileImportIdsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cdn-afdx-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cdn_frontdoor_profile" "test" {
+  name                = "acctest-cdnfdprofile-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Standard_AzureFrontDoor"
+}
+
+resource "azurerm_cdn_frontdoor_endpoint" "test" {
+  name                     = "acctest-cdnfdendpoint-%[1]d"
+  cdn_frontdoor_profile_id = azurerm_cdn_frontdoor_profile.test.id
+}
+
+resource "azurerm_cdn_frontdoor_origin_group" "test" {
+  name                     = "acctest-origingroup-%[1]d"
+  cdn_frontdoor_profile_id = azurerm_cdn_frontdoor_profile.test.id
+
+  load_balancing {}
+}
+
+resource "azurerm_cdn_frontdoor_origin" "test" {
+  name                          = "acctest-origin-%[1]d"
+  cdn_frontdoor_origin_group_id = azurerm_cdn_frontdoor_origin_group.test.id
+  enabled                       = true
+
+  certificate_name_check_enabled = false
+  host_name                      = "contoso.com"
+}
+
+resource "azurerm_cdn_frontdoor_rule_set" "test" {
+  name                     = "acctestruleset%[1]d"
+  cdn_frontdoor_profile_id = azurerm_cdn_frontdoor_profile.test.id
+}
+
+resource "azurerm_cdn_frontdoor_rule" "test" {
+  depends_on = [azurerm_cdn_frontdoor_origin_group.test, azurerm_cdn_frontdoor_origin.test]
+
+  name                      = "acctestrule%[1]d"
+  cdn_frontdoor_rule_set_id = azurerm_cdn_frontdoor_rule_set.test.id
+  order                     = 1
+
+  actions {
+    response_header_action {
+      header_action = "Append"
+      header_name   = "Example"
+      value         = "Example"
+    }
+  }
+}
+
+resource "azurerm_cdn_frontdoor_route" "test" {
+  name                          = "acctest-route-%[1]d"
+  cdn_frontdoor_endpoint_id     = azurerm_cdn_frontdoor_endpoint.test.id
+  cdn_frontdoor_origin_group_id = azurerm_cdn_frontdoor_origin_group.test.id
+  cdn_frontdoor_origin_ids      = [azurerm_cdn_frontdoor_origin.test.id]
+  cdn_frontdoor_rule_set_ids    = [azurerm_cdn_frontdoor_rule_set.test.id]
+
+  patterns_to_match   = ["/*"]
+  supported_protocols = ["Http", "Https"]
+}
+
+data "azurerm_cdn_frontdoor_profile_import_ids" "test" {
+  cdn_frontdoor_profile_id = azurerm_cdn_frontdoor_profile.test.id
+
+  depends_on = [azurerm_cdn_frontdoor_route.test, azurerm_cdn_frontdoor_rule.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -54,6 +54,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		CdnFrontDoorProfileImportIdsDataSource{},
 		CdnFrontDoorSecurityPolicyDataSource{},
 	}
 }
@@ -90,6 +91,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) Actions() []func() action.Action {
 	return []func() action.Action{
 		newCDNFrontDoorCachePurgeAction,
+		newCDNFrontDoorClassicMigrationAction,
 	}
 }
 
@@ -9,17 +9,21 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/cognitiveservicesaccounts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/cognitiveservicesprojects"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/deployments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/modelcapacities"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/raiblocklists"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/raipolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/usages"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	AccountsClient      *cognitiveservicesaccounts.CognitiveServicesAccountsClient
-	DeploymentsClient   *deployments.DeploymentsClient
-	ProjectsClient      *cognitiveservicesprojects.CognitiveServicesProjectsClient
-	RaiBlocklistsClient *raiblocklists.RaiBlocklistsClient
-	RaiPoliciesClient   *raipolicies.RaiPoliciesClient
+	AccountsClient        *cognitiveservicesaccounts.CognitiveServicesAccountsClient
+	DeploymentsClient     *deployments.DeploymentsClient
+	ModelCapacitiesClient *modelcapacities.ModelCapacitiesClient
+	ProjectsClient        *cognitiveservicesprojects.CognitiveServicesProjectsClient
+	RaiBlocklistsClient   *raiblocklists.RaiBlocklistsClient
+	RaiPoliciesClient     *raipolicies.RaiPoliciesClient
+	UsagesClient          *usages.UsagesClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -35,6 +39,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(deploymentsClient.Client, o.Authorizers.ResourceManager)
 
+	modelCapacitiesClient, err := modelcapacities.NewModelCapacitiesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Model Capacities client: %+v", err)
+	}
+	o.Configure(modelCapacitiesClient.Client, o.Authorizers.ResourceManager)
+
 	projectsClient, err := cognitiveservicesprojects.NewCognitiveServicesProjectsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Projects client: %+v", err)
@@ -53,11 +63,19 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(raiBlobklistsClient.Client, o.Authorizers.ResourceManager)
 
+	usagesClient, err := usages.NewUsagesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Usages client: %+v", err)
+	}
+	o.Configure(usagesClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
-		AccountsClient:      accountsClient,
-		DeploymentsClient:   deploymentsClient,
-		ProjectsClient:      projectsClient,
-		RaiBlocklistsClient: raiBlobklistsClient,
-		RaiPoliciesClient:   raiPoliciesClient,
+		AccountsClient:        accountsClient,
+		DeploymentsClient:     deploymentsClient,
+		ModelCapacitiesClient: modelCapacitiesClient,
+		ProjectsClient:        projectsClient,
+		RaiBlocklistsClient:   raiBlobklistsClient,
+		RaiPoliciesClient:     raiPoliciesClient,
+		UsagesClient:          usagesClient,
 	}, nil
 }
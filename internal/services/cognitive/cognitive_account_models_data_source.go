@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/cognitiveservicesaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type CognitiveAccountModelsDataSourceModel struct {
+	CognitiveAccountId string                  `tfschema:"cognitive_account_id"`
+	Models             []CognitiveAccountModel `tfschema:"model"`
+}
+
+type CognitiveAccountModel struct {
+	Capabilities             map[string]string          `tfschema:"capabilities"`
+	DefaultVersion           bool                       `tfschema:"default_version"`
+	FineTuneDeprecationDate  string                     `tfschema:"fine_tune_deprecation_date"`
+	Format                   string                     `tfschema:"format"`
+	InferenceDeprecationDate string                     `tfschema:"inference_deprecation_date"`
+	LifecycleStatus          string                     `tfschema:"lifecycle_status"`
+	MaxCapacity              int64                      `tfschema:"max_capacity"`
+	Name                     string                     `tfschema:"name"`
+	Publisher                string                     `tfschema:"publisher"`
+	Skus                     []CognitiveAccountModelSku `tfschema:"sku"`
+	Version                  string                     `tfschema:"version"`
+}
+
+type CognitiveAccountModelSku struct {
+	AllowedCapacities []int64 `tfschema:"allowed_capacities"`
+	CapacityStep      int64   `tfschema:"capacity_step"`
+	DefaultCapacity   int64   `tfschema:"default_capacity"`
+	DeprecationDate   string  `tfschema:"deprecation_date"`
+	MaximumCapacity   int64   `tfschema:"maximum_capacity"`
+	MinimumCapacity   int64   `tfschema:"minimum_capacity"`
+	Name              string  `tfschema:"name"`
+	UsageName         string  `tfschema:"usage_name"`
+}
+
+var _ sdk.DataSource = CognitiveAccountModelsDataSource{}
+
+type CognitiveAccountModelsDataSource struct{}
+
+func (r CognitiveAccountModelsDataSource) ResourceType() string {
+	return "azurerm_cognitive_account_models"
+}
+
+func (r CognitiveAccountModelsDataSource) ModelObject() interface{} {
+	return &CognitiveAccountModelsDataSourceModel{}
+}
+
+func (r CognitiveAccountModelsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cognitive_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: cognitiveservicesaccounts.ValidateAccountID,
+		},
+	}
+}
+
+func (r CognitiveAccountModelsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"model": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"format": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"capabilities": {
+						Type:     pluginsdk.TypeMap,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"default_version": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"fine_tune_deprecation_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"inference_deprecation_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"lifecycle_status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"max_capacity": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"publisher": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"sku": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"allowed_capacities": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeInt,
+									},
+								},
+
+								"capacity_step": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"default_capacity": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"deprecation_date": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"maximum_capacity": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"minimum_capacity": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"usage_name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CognitiveAccountModelsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.AccountsClient
+
+			var state CognitiveAccountModelsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := cognitiveservicesaccounts.ParseAccountID(state.CognitiveAccountId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.AccountsListModelsComplete(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing models for %s: %+v", *id, err)
+			}
+
+			state.CognitiveAccountId = id.ID()
+			state.Models = flattenCognitiveAccountModels(resp.Items)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenCognitiveAccountModels(input []cognitiveservicesaccounts.AccountModel) []CognitiveAccountModel {
+	output := make([]CognitiveAccountModel, 0)
+
+	for _, item := range input {
+		model := CognitiveAccountModel{
+			Capabilities:   pointer.From(item.Capabilities),
+			DefaultVersion: pointer.From(item.IsDefaultVersion),
+			Format:         pointer.From(item.Format),
+			MaxCapacity:    pointer.From(item.MaxCapacity),
+			Name:           pointer.From(item.Name),
+			Publisher:      pointer.From(item.Publisher),
+			Skus:           make([]CognitiveAccountModelSku, 0),
+			Version:        pointer.From(item.Version),
+		}
+
+		if item.LifecycleStatus != nil {
+			model.LifecycleStatus = string(*item.LifecycleStatus)
+		}
+
+		if deprecation := item.Deprecation; deprecation != nil {
+			model.FineTuneDeprecationDate = pointer.From(deprecation.FineTune)
+			model.InferenceDeprecationDate = pointer.From(deprecation.Inference)
+		}
+
+		if item.Skus != nil {
+			for _, sku := range *item.Skus {
+				modelSku := CognitiveAccountModelSku{
+					AllowedCapacities: make([]int64, 0),
+					DeprecationDate:   pointer.From(sku.DeprecationDate),
+					Name:              pointer.From(sku.Name),
+					UsageName:         pointer.From(sku.UsageName),
+				}
+
+				if capacity := sku.Capacity; capacity != nil {
+					modelSku.AllowedCapacities = pointer.From(capacity.AllowedValues)
+					modelSku.CapacityStep = pointer.From(capacity.Step)
+					modelSku.DefaultCapacity = pointer.From(capacity.Default)
+					modelSku.MaximumCapacity = pointer.From(capacity.Maximum)
+					modelSku.MinimumCapacity = pointer.From(capacity.Minimum)
+				}
+
+				model.Skus = append(model.Skus, modelSku)
+			}
+		}
+
+		output = append(output, model)
+	}
+
+	return output
+}
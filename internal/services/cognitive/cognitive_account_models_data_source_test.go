@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CognitiveAccountModelsDataSource struct{}
+
+func TestAccCognitiveAccountModelsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cognitive_account_models", "test")
+	r := CognitiveAccountModelsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("model.#").IsSet(),
+				check.That(data.ResourceName).Key("model.0.format").Exists(),
+				check.That(data.ResourceName).Key("model.0.name").Exists(),
+				check.That(data.ResourceName).Key("model.0.version").Exists(),
+				check.That(data.ResourceName).Key("model.0.lifecycle_status").Exists(),
+			),
+		},
+	})
+}
+
+func (CognitiveAccountModelsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_cognitive_account_models" "test" {
+  cognitive_account_id = azurerm_cognitive_account.test.id
+}
+`, CognitiveDeploymentTestResource{}.template(data))
+}
@@ -6,6 +6,8 @@ package cognitive
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -19,13 +21,14 @@ import (
 )
 
 type cognitiveDeploymentModel struct {
-	Name                     string                 `tfschema:"name"`
-	CognitiveAccountId       string                 `tfschema:"cognitive_account_id"`
-	DynamicThrottlingEnabled bool                   `tfschema:"dynamic_throttling_enabled"`
-	Model                    []DeploymentModelModel `tfschema:"model"`
-	RaiPolicyName            string                 `tfschema:"rai_policy_name"`
-	Sku                      []DeploymentSkuModel   `tfschema:"sku"`
-	VersionUpgradeOption     string                 `tfschema:"version_upgrade_option"`
+	Name                       string                 `tfschema:"name"`
+	CognitiveAccountId         string                 `tfschema:"cognitive_account_id"`
+	DynamicThrottlingEnabled   bool                   `tfschema:"dynamic_throttling_enabled"`
+	Model                      []DeploymentModelModel `tfschema:"model"`
+	RaiPolicyName              string                 `tfschema:"rai_policy_name"`
+	RetiredVersionCheckEnabled bool                   `tfschema:"retired_version_check_enabled"`
+	Sku                        []DeploymentSkuModel   `tfschema:"sku"`
+	VersionUpgradeOption       string                 `tfschema:"version_upgrade_option"`
 }
 
 type DeploymentModelModel struct {
@@ -44,7 +47,10 @@ type DeploymentSkuModel struct {
 
 type CognitiveDeploymentResource struct{}
 
-var _ sdk.Resource = CognitiveDeploymentResource{}
+var (
+	_ sdk.Resource                  = CognitiveDeploymentResource{}
+	_ sdk.ResourceWithCustomizeDiff = CognitiveDeploymentResource{}
+)
 
 func (r CognitiveDeploymentResource) ResourceType() string {
 	return "azurerm_cognitive_deployment"
@@ -173,6 +179,12 @@ func (r CognitiveDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 
+		"retired_version_check_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"version_upgrade_option": {
 			Type:     pluginsdk.TypeString,
 			Optional: true,
@@ -334,6 +346,8 @@ func (r CognitiveDeploymentResource) Read() sdk.ResourceFunc {
 			state := cognitiveDeploymentModel{
 				Name:               id.DeploymentName,
 				CognitiveAccountId: cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID(),
+				// `retired_version_check_enabled` only affects the plan and isn't returned by the API
+				RetiredVersionCheckEnabled: metadata.ResourceData.Get("retired_version_check_enabled").(bool),
 			}
 
 			if properties := model.Properties; properties != nil {
@@ -375,6 +389,101 @@ func (r CognitiveDeploymentResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+func (r CognitiveDeploymentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			// the retirement check is opt-in, since the retirement dates are owned by the service and would otherwise
+			// cause a configuration which planned successfully to fail once the version it deploys has been retired
+			if !rd.Get("retired_version_check_enabled").(bool) {
+				return nil
+			}
+
+			// the retirement of a model version is only checked when it's being deployed, since existing deployments
+			// are upgraded by the service according to the `version_upgrade_option` - this also limits listing the models
+			// of the account to the plans which create the deployment or change its model or version
+			if rd.Id() != "" && !rd.HasChanges("model.0.name", "model.0.version") {
+				return nil
+			}
+
+			if !rd.NewValueKnown("cognitive_account_id") || !rd.NewValueKnown("model.0.version") || !rd.NewValueKnown("sku.0.name") {
+				return nil
+			}
+
+			version := rd.Get("model.0.version").(string)
+			if version == "" {
+				return nil
+			}
+
+			accountId, err := cognitiveservicesaccounts.ParseAccountID(rd.Get("cognitive_account_id").(string))
+			if err != nil {
+				return err
+			}
+
+			format := rd.Get("model.0.format").(string)
+			name := rd.Get("model.0.name").(string)
+			skuName := rd.Get("sku.0.name").(string)
+
+			// this is a best-effort check, so failing to retrieve the available models shouldn't block the plan
+			resp, err := metadata.Client.Cognitive.AccountsClient.AccountsListModelsComplete(ctx, *accountId)
+			if err != nil {
+				log.Printf("[DEBUG] unable to list the models for %s, skipping the retirement check for version %q of model %q: %+v", *accountId, version, name, err)
+				return nil
+			}
+
+			if retirement := cognitiveModelRetirementDate(resp.Items, format, name, version, skuName); retirement != nil && retirement.Before(time.Now()) {
+				return fmt.Errorf("version %q of the %s model %q with the SKU %q was retired on %s and can no longer be deployed, use the `azurerm_cognitive_account_models` data source to list the available versions", version, format, name, skuName, retirement.Format(time.DateOnly))
+			}
+
+			return nil
+		},
+	}
+}
+
+// cognitiveModelRetirementDate returns the earliest date on which the inference of the specified model version is no
+// longer available, either for the model version as a whole or for the specified SKU
+func cognitiveModelRetirementDate(input []cognitiveservicesaccounts.AccountModel, format, name, version, skuName string) *time.Time {
+	var retirement *time.Time
+	earliest := func(value *string) {
+		date := parseCognitiveModelDate(pointer.From(value))
+		if date != nil && (retirement == nil || date.Before(*retirement)) {
+			retirement = date
+		}
+	}
+
+	for _, model := range input {
+		if !strings.EqualFold(pointer.From(model.Format), format) || !strings.EqualFold(pointer.From(model.Name), name) || !strings.EqualFold(pointer.From(model.Version), version) {
+			continue
+		}
+
+		if model.Deprecation != nil {
+			earliest(model.Deprecation.Inference)
+		}
+
+		if model.Skus != nil {
+			for _, sku := range *model.Skus {
+				if strings.EqualFold(pointer.From(sku.Name), skuName) {
+					earliest(sku.DeprecationDate)
+				}
+			}
+		}
+	}
+
+	return retirement
+}
+
+func parseCognitiveModelDate(input string) *time.Time {
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if v, err := time.Parse(layout, input); err == nil {
+			return &v
+		}
+	}
+
+	return nil
+}
+
 func expandDeploymentModelModel(inputList []DeploymentModelModel) *deployments.DeploymentModel {
 	if len(inputList) == 0 {
 		return nil
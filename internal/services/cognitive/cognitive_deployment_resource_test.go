@@ -60,7 +60,8 @@ func TestAccCognitiveDeployment_complete(t *testing.T) {
 				check.That(data.ResourceName).Key("dynamic_throttling_enabled").HasValue("true"),
 			),
 		},
-		data.ImportStep(),
+		// `retired_version_check_enabled` isn't returned by the API, so it defaults to `false` on import
+		data.ImportStep("retired_version_check_enabled"),
 	})
 }
 
@@ -210,8 +211,9 @@ resource "azurerm_cognitive_deployment" "test" {
   sku {
     name = "Standard"
   }
-  rai_policy_name        = "Microsoft.DefaultV2"
-  version_upgrade_option = "OnceNewDefaultVersionAvailable"
+  rai_policy_name               = "Microsoft.DefaultV2"
+  retired_version_check_enabled = true
+  version_upgrade_option        = "OnceNewDefaultVersionAvailable"
 }
 `, template, data.RandomInteger)
 }
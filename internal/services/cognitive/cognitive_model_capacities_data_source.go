@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/modelcapacities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CognitiveModelCapacitiesDataSourceModel struct {
+	ModelFormat  string                        `tfschema:"model_format"`
+	ModelName    string                        `tfschema:"model_name"`
+	ModelVersion string                        `tfschema:"model_version"`
+	SkuName      string                        `tfschema:"sku_name"`
+	Capacities   []CognitiveModelCapacityModel `tfschema:"capacity"`
+}
+
+type CognitiveModelCapacityModel struct {
+	AvailableCapacity         float64 `tfschema:"available_capacity"`
+	AvailableFineTuneCapacity float64 `tfschema:"available_fine_tune_capacity"`
+	Location                  string  `tfschema:"location"`
+	SkuName                   string  `tfschema:"sku_name"`
+}
+
+var _ sdk.DataSource = CognitiveModelCapacitiesDataSource{}
+
+type CognitiveModelCapacitiesDataSource struct{}
+
+func (r CognitiveModelCapacitiesDataSource) ResourceType() string {
+	return "azurerm_cognitive_model_capacities"
+}
+
+func (r CognitiveModelCapacitiesDataSource) ModelObject() interface{} {
+	return &CognitiveModelCapacitiesDataSourceModel{}
+}
+
+func (r CognitiveModelCapacitiesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"model_format": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"model_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"model_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r CognitiveModelCapacitiesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"capacity": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"location": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"sku_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"available_capacity": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"available_fine_tune_capacity": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CognitiveModelCapacitiesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.ModelCapacitiesClient
+			subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+
+			var state CognitiveModelCapacitiesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			options := modelcapacities.ListOperationOptions{
+				ModelFormat:  pointer.To(state.ModelFormat),
+				ModelName:    pointer.To(state.ModelName),
+				ModelVersion: pointer.To(state.ModelVersion),
+			}
+			resp, err := client.ListComplete(ctx, subscriptionId, options)
+			if err != nil {
+				return fmt.Errorf("listing model capacities for %s (Format %q / Name %q / Version %q): %+v", subscriptionId, state.ModelFormat, state.ModelName, state.ModelVersion, err)
+			}
+
+			state.Capacities = make([]CognitiveModelCapacityModel, 0)
+			for _, item := range resp.Items {
+				props := item.Properties
+				if props == nil {
+					continue
+				}
+
+				skuName := pointer.From(props.SkuName)
+				if state.SkuName != "" && !strings.EqualFold(state.SkuName, skuName) {
+					continue
+				}
+
+				state.Capacities = append(state.Capacities, CognitiveModelCapacityModel{
+					AvailableCapacity:         pointer.From(props.AvailableCapacity),
+					AvailableFineTuneCapacity: pointer.From(props.AvailableFinetuneCapacity),
+					Location:                  location.NormalizeNilable(item.Location),
+					SkuName:                   skuName,
+				})
+			}
+
+			idHash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%s/%s", subscriptionId.ID(), state.ModelFormat, state.ModelName, state.ModelVersion, state.SkuName)))
+			metadata.ResourceData.SetId(hex.EncodeToString(idHash[:]))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
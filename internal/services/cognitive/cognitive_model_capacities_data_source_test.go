@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CognitiveModelCapacitiesDataSource struct{}
+
+func TestAccCognitiveModelCapacitiesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cognitive_model_capacities", "test")
+	r := CognitiveModelCapacitiesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("capacity.#").IsSet(),
+				check.That(data.ResourceName).Key("capacity.0.location").Exists(),
+				check.That(data.ResourceName).Key("capacity.0.available_capacity").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccCognitiveModelCapacitiesDataSource_skuName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cognitive_model_capacities", "test")
+	r := CognitiveModelCapacitiesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.skuName(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("capacity.#").IsSet(),
+				check.That(data.ResourceName).Key("capacity.0.sku_name").HasValue("GlobalStandard"),
+			),
+		},
+	})
+}
+
+func (CognitiveModelCapacitiesDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_cognitive_model_capacities" "test" {
+  model_format  = "OpenAI"
+  model_name    = "gpt-4o"
+  model_version = "2024-11-20"
+}
+`
+}
+
+func (CognitiveModelCapacitiesDataSource) skuName() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_cognitive_model_capacities" "test" {
+  model_format  = "OpenAI"
+  model_name    = "gpt-4o"
+  model_version = "2024-11-20"
+  sku_name      = "GlobalStandard"
+}
+`
+}
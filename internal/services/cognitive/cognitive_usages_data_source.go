@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/usages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type CognitiveUsagesDataSourceModel struct {
+	Location string                `tfschema:"location"`
+	Usages   []CognitiveUsageModel `tfschema:"usage"`
+}
+
+type CognitiveUsageModel struct {
+	CurrentValue  float64 `tfschema:"current_value"`
+	Limit         float64 `tfschema:"limit"`
+	LocalizedName string  `tfschema:"localized_name"`
+	Name          string  `tfschema:"name"`
+	NextResetTime string  `tfschema:"next_reset_time"`
+	QuotaPeriod   string  `tfschema:"quota_period"`
+	Remaining     float64 `tfschema:"remaining"`
+	Status        string  `tfschema:"status"`
+	Unit          string  `tfschema:"unit"`
+}
+
+var _ sdk.DataSource = CognitiveUsagesDataSource{}
+
+type CognitiveUsagesDataSource struct{}
+
+func (r CognitiveUsagesDataSource) ResourceType() string {
+	return "azurerm_cognitive_usages"
+}
+
+func (r CognitiveUsagesDataSource) ModelObject() interface{} {
+	return &CognitiveUsagesDataSourceModel{}
+}
+
+func (r CognitiveUsagesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+	}
+}
+
+func (r CognitiveUsagesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"usage": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"current_value": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"limit": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"localized_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"next_reset_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"quota_period": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"remaining": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"unit": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CognitiveUsagesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.UsagesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state CognitiveUsagesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := usages.NewLocationID(subscriptionId, location.Normalize(state.Location))
+
+			resp, err := client.ListComplete(ctx, id, usages.DefaultListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing usages for %s: %+v", id, err)
+			}
+
+			state.Location = id.LocationName
+			state.Usages = flattenCognitiveUsages(resp.Items)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenCognitiveUsages(input []usages.Usage) []CognitiveUsageModel {
+	output := make([]CognitiveUsageModel, 0)
+
+	for _, item := range input {
+		usage := CognitiveUsageModel{
+			CurrentValue:  pointer.From(item.CurrentValue),
+			Limit:         pointer.From(item.Limit),
+			NextResetTime: pointer.From(item.NextResetTime),
+			QuotaPeriod:   pointer.From(item.QuotaPeriod),
+		}
+		usage.Remaining = usage.Limit - usage.CurrentValue
+
+		if name := item.Name; name != nil {
+			usage.LocalizedName = pointer.From(name.LocalizedValue)
+			usage.Name = pointer.From(name.Value)
+		}
+
+		if item.Status != nil {
+			usage.Status = string(*item.Status)
+		}
+
+		if item.Unit != nil {
+			usage.Unit = string(*item.Unit)
+		}
+
+		output = append(output, usage)
+	}
+
+	return output
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CognitiveUsagesDataSource struct{}
+
+func TestAccCognitiveUsagesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cognitive_usages", "test")
+	r := CognitiveUsagesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("usage.#").IsSet(),
+				check.That(data.ResourceName).Key("usage.0.name").Exists(),
+				check.That(data.ResourceName).Key("usage.0.limit").Exists(),
+				check.That(data.ResourceName).Key("usage.0.remaining").Exists(),
+			),
+		},
+	})
+}
+
+func (CognitiveUsagesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_cognitive_usages" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
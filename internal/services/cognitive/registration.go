@@ -52,7 +52,10 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 // DataSources returns a list of Data Sources supported by this Service
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		CognitiveAccountModelsDataSource{},
 		CognitiveAccountProjectDataSource{},
+		CognitiveModelCapacitiesDataSource{},
+		CognitiveUsagesDataSource{},
 	}
 }
 
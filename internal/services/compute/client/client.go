@@ -39,6 +39,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetrollingupgrades"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetvms"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/imagebuilder/2024-02-01/virtualmachineimagetemplate"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/standbypool/2025-03-01/standbyvirtualmachinepools"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -61,6 +62,7 @@ type Client struct {
 	GalleryImagesClient                         *galleryimages.GalleryImagesClient
 	GalleryImageVersionsClient                  *galleryimageversions.GalleryImageVersionsClient
 	GallerySharingUpdateClient                  *gallerysharingupdate.GallerySharingUpdateClient
+	ImageBuilderTemplatesClient                 *virtualmachineimagetemplate.VirtualMachineImageTemplateClient
 	ImagesClient                                *images.ImagesClient
 	MarketplaceAgreementsClient                 *agreements.AgreementsClient
 	ProximityPlacementGroupsClient              *proximityplacementgroups.ProximityPlacementGroupsClient
@@ -165,6 +167,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(gallerySharingUpdateClient.Client, o.Authorizers.ResourceManager)
 
+	imageBuilderTemplatesClient, err := virtualmachineimagetemplate.NewVirtualMachineImageTemplateClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building VirtualMachineImageTemplate client: %+v", err)
+	}
+	o.Configure(imageBuilderTemplatesClient.Client, o.Authorizers.ResourceManager)
+
 	imagesClient, err := images.NewImagesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Images client: %+v", err)
@@ -282,6 +290,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		GalleryImagesClient:                         galleryImagesClient,
 		GalleryImageVersionsClient:                  galleryImageVersionsClient,
 		GallerySharingUpdateClient:                  gallerySharingUpdateClient,
+		ImageBuilderTemplatesClient:                 imageBuilderTemplatesClient,
 		ImagesClient:                                imagesClient,
 		MarketplaceAgreementsClient:                 marketplaceAgreementsClient,
 		ProximityPlacementGroupsClient:              proximityPlacementGroupsClient,
@@ -0,0 +1,946 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/images"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleryimages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-07-03/galleryimageversions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/imagebuilder/2024-02-01/virtualmachineimagetemplate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	imageBuilderCustomizerTypeFile           = "File"
+	imageBuilderCustomizerTypePowerShell     = "PowerShell"
+	imageBuilderCustomizerTypeShell          = "Shell"
+	imageBuilderCustomizerTypeWindowsRestart = "WindowsRestart"
+	imageBuilderCustomizerTypeWindowsUpdate  = "WindowsUpdate"
+)
+
+type ImageBuilderTemplateModel struct {
+	Name                   string                                    `tfschema:"name"`
+	ResourceGroupName      string                                    `tfschema:"resource_group_name"`
+	Location               string                                    `tfschema:"location"`
+	Identity               []identity.ModelUserAssigned              `tfschema:"identity"`
+	PlatformImageSource    []ImageBuilderTemplatePlatformImageSource `tfschema:"platform_image_source"`
+	ManagedImageSourceId   string                                    `tfschema:"managed_image_source_id"`
+	SharedImageVersionId   string                                    `tfschema:"shared_image_version_source_id"`
+	Customizer             []ImageBuilderTemplateCustomizer          `tfschema:"customizer"`
+	SharedImageDistributor []ImageBuilderTemplateSharedImage         `tfschema:"shared_image_distributor"`
+	BuildTimeoutInMinutes  int64                                     `tfschema:"build_timeout_in_minutes"`
+	VMSize                 string                                    `tfschema:"vm_size"`
+	OsDiskSizeGB           int64                                     `tfschema:"os_disk_size_gb"`
+	SubnetId               string                                    `tfschema:"subnet_id"`
+	StagingResourceGroupId string                                    `tfschema:"staging_resource_group_id"`
+	RunOnCreate            bool                                      `tfschema:"run_on_create"`
+	Tags                   map[string]string                         `tfschema:"tags"`
+}
+
+type ImageBuilderTemplatePlatformImageSource struct {
+	Publisher string `tfschema:"publisher"`
+	Offer     string `tfschema:"offer"`
+	Sku       string `tfschema:"sku"`
+	Version   string `tfschema:"version"`
+}
+
+type ImageBuilderTemplateCustomizer struct {
+	Type                string   `tfschema:"type"`
+	Name                string   `tfschema:"name"`
+	Inline              []string `tfschema:"inline"`
+	ScriptUri           string   `tfschema:"script_uri"`
+	Sha256Checksum      string   `tfschema:"sha256_checksum"`
+	RunElevated         bool     `tfschema:"run_elevated"`
+	RunAsSystem         bool     `tfschema:"run_as_system"`
+	ValidExitCodes      []int64  `tfschema:"valid_exit_codes"`
+	SourceUri           string   `tfschema:"source_uri"`
+	Destination         string   `tfschema:"destination"`
+	RestartCommand      string   `tfschema:"restart_command"`
+	RestartCheckCommand string   `tfschema:"restart_check_command"`
+	RestartTimeout      string   `tfschema:"restart_timeout"`
+	SearchCriteria      string   `tfschema:"search_criteria"`
+	Filters             []string `tfschema:"filters"`
+	UpdateLimit         int64    `tfschema:"update_limit"`
+}
+
+type ImageBuilderTemplateSharedImage struct {
+	SharedImageId      string            `tfschema:"shared_image_id"`
+	RunOutputName      string            `tfschema:"run_output_name"`
+	ReplicationRegions []string          `tfschema:"replication_regions"`
+	StorageAccountType string            `tfschema:"storage_account_type"`
+	ExcludeFromLatest  bool              `tfschema:"exclude_from_latest"`
+	ArtifactTags       map[string]string `tfschema:"artifact_tags"`
+}
+
+type ImageBuilderTemplateResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = ImageBuilderTemplateResource{}
+	_ sdk.ResourceWithCustomizeDiff = ImageBuilderTemplateResource{}
+)
+
+func (r ImageBuilderTemplateResource) ResourceType() string {
+	return "azurerm_image_builder_template"
+}
+
+func (r ImageBuilderTemplateResource) ModelObject() interface{} {
+	return &ImageBuilderTemplateModel{}
+}
+
+func (r ImageBuilderTemplateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return virtualmachineimagetemplate.ValidateImageTemplateID
+}
+
+func (r ImageBuilderTemplateResource) Arguments() map[string]*pluginsdk.Schema {
+	sources := []string{
+		"managed_image_source_id",
+		"platform_image_source",
+		"shared_image_version_source_id",
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,62}$`),
+				"`name` must be between 1 and 63 characters in length, start with a letter or number and may contain only letters, numbers, underscores (_), periods (.) and hyphens (-).",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.UserAssignedIdentityRequired(),
+
+		"platform_image_source": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sources,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"offer": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sku": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      "latest",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"managed_image_source_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: sources,
+			ValidateFunc: images.ValidateImageID,
+		},
+
+		"shared_image_version_source_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: sources,
+			ValidateFunc: galleryimageversions.ValidateImageVersionID,
+		},
+
+		"shared_image_distributor": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"shared_image_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: galleryimages.ValidateGalleryImageID,
+					},
+
+					"run_output_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9-_.]{1,64}$`), "`run_output_name` must be between 1 and 64 characters in length and may contain only letters, numbers, underscores (_), periods (.) and hyphens (-)."),
+					},
+
+					"replication_regions": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:             pluginsdk.TypeString,
+							ValidateFunc:     location.EnhancedValidate,
+							StateFunc:        location.StateFunc,
+							DiffSuppressFunc: location.DiffSuppressFunc,
+						},
+					},
+
+					"storage_account_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(virtualmachineimagetemplate.SharedImageStorageAccountTypeStandardLRS),
+						ValidateFunc: validation.StringInSlice(virtualmachineimagetemplate.PossibleValuesForSharedImageStorageAccountType(), false),
+					},
+
+					"exclude_from_latest": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"artifact_tags": commonschema.Tags(),
+				},
+			},
+		},
+
+		"customizer": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							imageBuilderCustomizerTypeFile,
+							imageBuilderCustomizerTypePowerShell,
+							imageBuilderCustomizerTypeShell,
+							imageBuilderCustomizerTypeWindowsRestart,
+							imageBuilderCustomizerTypeWindowsUpdate,
+						}, false),
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"inline": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"sha256_checksum": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-fA-F0-9]{64}$`), "`sha256_checksum` must be a 64 character hexadecimal SHA256 checksum"),
+					},
+
+					"run_elevated": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"run_as_system": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"valid_exit_codes": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeInt,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+
+					"source_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"destination": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"restart_command": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"restart_check_command": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"restart_timeout": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+[smh]$`), "`restart_timeout` must be a magnitude and unit, e.g. `5m` or `2h`"),
+					},
+
+					"search_criteria": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"filters": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"update_limit": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+
+		"build_timeout_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      240,
+			ValidateFunc: validation.IntBetween(1, 960),
+		},
+
+		"vm_size": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"os_disk_size_gb": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateSubnetID,
+		},
+
+		"staging_resource_group_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateResourceGroupID,
+		},
+
+		"run_on_create": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ImageBuilderTemplateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ImageBuilderTemplateResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config ImageBuilderTemplateModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for i, customizer := range config.Customizer {
+				if err := validateImageBuilderTemplateCustomizer(customizer); err != nil {
+					return fmt.Errorf("`customizer.%d`: %+v", i, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ImageBuilderTemplateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// the template build can optionally be run as part of creation
+		Timeout: 4 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.ImageBuilderTemplatesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ImageBuilderTemplateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := virtualmachineimagetemplate.NewImageTemplateID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			customizers, err := expandImageBuilderTemplateCustomizers(model.Customizer)
+			if err != nil {
+				return fmt.Errorf("expanding `customizer`: %+v", err)
+			}
+
+			properties := virtualmachineimagetemplate.ImageTemplate{
+				Location: location.Normalize(model.Location),
+				Identity: pointer.From(expandedIdentity),
+				Properties: &virtualmachineimagetemplate.ImageTemplateProperties{
+					BuildTimeoutInMinutes: pointer.To(model.BuildTimeoutInMinutes),
+					Customize:             customizers,
+					Distribute:            expandImageBuilderTemplateSharedImageDistributors(model.SharedImageDistributor),
+					Source:                expandImageBuilderTemplateSource(model),
+					VMProfile:             expandImageBuilderTemplateVMProfile(model),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if model.StagingResourceGroupId != "" {
+				properties.Properties.StagingResourceGroup = pointer.To(model.StagingResourceGroupId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if model.RunOnCreate {
+				if err := client.RunThenPoll(ctx, id); err != nil {
+					return fmt.Errorf("running %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ImageBuilderTemplateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.ImageBuilderTemplatesClient
+
+			id, err := virtualmachineimagetemplate.ParseImageTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ImageBuilderTemplateModel{
+				Name:              id.ImageTemplateName,
+				ResourceGroupName: id.ResourceGroupName,
+				// `run_on_create` isn't returned by the API
+				RunOnCreate: metadata.ResourceData.Get("run_on_create").(bool),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				flattenedIdentity, err := identity.FlattenUserAssignedMapToModel(&model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(flattenedIdentity)
+
+				if props := model.Properties; props != nil {
+					state.BuildTimeoutInMinutes = pointer.From(props.BuildTimeoutInMinutes)
+					state.StagingResourceGroupId = pointer.From(props.ExactStagingResourceGroup)
+					if v := pointer.From(props.StagingResourceGroup); v != "" {
+						state.StagingResourceGroupId = v
+					}
+
+					state.Customizer = flattenImageBuilderTemplateCustomizers(props.Customize)
+					state.SharedImageDistributor = flattenImageBuilderTemplateSharedImageDistributors(props.Distribute)
+
+					switch source := props.Source.(type) {
+					case virtualmachineimagetemplate.ImageTemplatePlatformImageSource:
+						state.PlatformImageSource = []ImageBuilderTemplatePlatformImageSource{
+							{
+								Publisher: pointer.From(source.Publisher),
+								Offer:     pointer.From(source.Offer),
+								Sku:       pointer.From(source.Sku),
+								Version:   pointer.From(source.Version),
+							},
+						}
+					case virtualmachineimagetemplate.ImageTemplateManagedImageSource:
+						state.ManagedImageSourceId = source.ImageId
+					case virtualmachineimagetemplate.ImageTemplateSharedImageVersionSource:
+						state.SharedImageVersionId = source.ImageVersionId
+					}
+
+					if profile := props.VMProfile; profile != nil {
+						state.VMSize = pointer.From(profile.VMSize)
+						state.OsDiskSizeGB = pointer.From(profile.OsDiskSizeGB)
+						if vnet := profile.VnetConfig; vnet != nil {
+							state.SubnetId = pointer.From(vnet.SubnetId)
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ImageBuilderTemplateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.ImageBuilderTemplatesClient
+
+			id, err := virtualmachineimagetemplate.ParseImageTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ImageBuilderTemplateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := virtualmachineimagetemplate.ImageTemplateUpdateParameters{
+				Properties: &virtualmachineimagetemplate.ImageTemplateUpdateParametersProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandUserAssignedMapFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				parameters.Identity = expandedIdentity
+			}
+
+			if metadata.ResourceData.HasChange("shared_image_distributor") {
+				parameters.Properties.Distribute = pointer.To(expandImageBuilderTemplateSharedImageDistributors(model.SharedImageDistributor))
+			}
+
+			if metadata.ResourceData.HasChanges("vm_size", "os_disk_size_gb", "subnet_id") {
+				parameters.Properties.VMProfile = expandImageBuilderTemplateVMProfile(model)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ImageBuilderTemplateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.ImageBuilderTemplatesClient
+
+			id, err := virtualmachineimagetemplate.ParseImageTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func validateImageBuilderTemplateCustomizer(input ImageBuilderTemplateCustomizer) error {
+	scriptFieldsSet := len(input.Inline) > 0 || input.ScriptUri != ""
+	fileFieldsSet := input.SourceUri != "" || input.Destination != ""
+	restartFieldsSet := input.RestartCommand != "" || input.RestartCheckCommand != "" || input.RestartTimeout != ""
+	updateFieldsSet := input.SearchCriteria != "" || len(input.Filters) > 0 || input.UpdateLimit != 0
+	powerShellFieldsSet := input.RunElevated || input.RunAsSystem || len(input.ValidExitCodes) > 0
+
+	switch input.Type {
+	case imageBuilderCustomizerTypeShell, imageBuilderCustomizerTypePowerShell:
+		if len(input.Inline) > 0 == (input.ScriptUri != "") {
+			return fmt.Errorf("exactly one of `inline` or `script_uri` must be specified when `type` is `%s`", input.Type)
+		}
+		if fileFieldsSet || restartFieldsSet || updateFieldsSet {
+			return fmt.Errorf("only `inline`, `script_uri` and `sha256_checksum` can be specified when `type` is `%s`", input.Type)
+		}
+		if input.Type == imageBuilderCustomizerTypeShell && powerShellFieldsSet {
+			return fmt.Errorf("`run_elevated`, `run_as_system` and `valid_exit_codes` can only be specified when `type` is `%s`", imageBuilderCustomizerTypePowerShell)
+		}
+		if input.RunAsSystem && !input.RunElevated {
+			return fmt.Errorf("`run_elevated` must be `true` when `run_as_system` is `true`")
+		}
+	case imageBuilderCustomizerTypeFile:
+		if input.SourceUri == "" || input.Destination == "" {
+			return fmt.Errorf("`source_uri` and `destination` must be specified when `type` is `%s`", input.Type)
+		}
+		if scriptFieldsSet || restartFieldsSet || updateFieldsSet || powerShellFieldsSet {
+			return fmt.Errorf("only `source_uri`, `destination` and `sha256_checksum` can be specified when `type` is `%s`", input.Type)
+		}
+	case imageBuilderCustomizerTypeWindowsRestart:
+		if scriptFieldsSet || fileFieldsSet || updateFieldsSet || powerShellFieldsSet || input.Sha256Checksum != "" {
+			return fmt.Errorf("only `restart_command`, `restart_check_command` and `restart_timeout` can be specified when `type` is `%s`", input.Type)
+		}
+	case imageBuilderCustomizerTypeWindowsUpdate:
+		if scriptFieldsSet || fileFieldsSet || restartFieldsSet || powerShellFieldsSet || input.Sha256Checksum != "" {
+			return fmt.Errorf("only `search_criteria`, `filters` and `update_limit` can be specified when `type` is `%s`", input.Type)
+		}
+	}
+
+	return nil
+}
+
+func expandImageBuilderTemplateSource(input ImageBuilderTemplateModel) virtualmachineimagetemplate.ImageTemplateSource {
+	if input.ManagedImageSourceId != "" {
+		return virtualmachineimagetemplate.ImageTemplateManagedImageSource{
+			ImageId: input.ManagedImageSourceId,
+		}
+	}
+
+	if input.SharedImageVersionId != "" {
+		return virtualmachineimagetemplate.ImageTemplateSharedImageVersionSource{
+			ImageVersionId: input.SharedImageVersionId,
+		}
+	}
+
+	if len(input.PlatformImageSource) == 0 {
+		return nil
+	}
+
+	source := input.PlatformImageSource[0]
+	return virtualmachineimagetemplate.ImageTemplatePlatformImageSource{
+		Publisher: pointer.To(source.Publisher),
+		Offer:     pointer.To(source.Offer),
+		Sku:       pointer.To(source.Sku),
+		Version:   pointer.To(source.Version),
+	}
+}
+
+func expandImageBuilderTemplateVMProfile(input ImageBuilderTemplateModel) *virtualmachineimagetemplate.ImageTemplateVMProfile {
+	result := &virtualmachineimagetemplate.ImageTemplateVMProfile{}
+
+	if input.VMSize != "" {
+		result.VMSize = pointer.To(input.VMSize)
+	}
+
+	if input.OsDiskSizeGB != 0 {
+		result.OsDiskSizeGB = pointer.To(input.OsDiskSizeGB)
+	}
+
+	if input.SubnetId != "" {
+		result.VnetConfig = &virtualmachineimagetemplate.VirtualNetworkConfig{
+			SubnetId: pointer.To(input.SubnetId),
+		}
+	}
+
+	return result
+}
+
+func expandImageBuilderTemplateCustomizers(input []ImageBuilderTemplateCustomizer) (*[]virtualmachineimagetemplate.ImageTemplateCustomizer, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	result := make([]virtualmachineimagetemplate.ImageTemplateCustomizer, 0)
+	for _, v := range input {
+		var name *string
+		if v.Name != "" {
+			name = pointer.To(v.Name)
+		}
+
+		switch v.Type {
+		case imageBuilderCustomizerTypeFile:
+			customizer := virtualmachineimagetemplate.ImageTemplateFileCustomizer{
+				Name:        name,
+				SourceUri:   pointer.To(v.SourceUri),
+				Destination: pointer.To(v.Destination),
+			}
+			if v.Sha256Checksum != "" {
+				customizer.Sha256Checksum = pointer.To(v.Sha256Checksum)
+			}
+			result = append(result, customizer)
+
+		case imageBuilderCustomizerTypePowerShell:
+			customizer := virtualmachineimagetemplate.ImageTemplatePowerShellCustomizer{
+				Name:        name,
+				RunElevated: pointer.To(v.RunElevated),
+				RunAsSystem: pointer.To(v.RunAsSystem),
+			}
+			if len(v.Inline) > 0 {
+				customizer.Inline = pointer.To(v.Inline)
+			}
+			if v.ScriptUri != "" {
+				customizer.ScriptUri = pointer.To(v.ScriptUri)
+			}
+			if v.Sha256Checksum != "" {
+				customizer.Sha256Checksum = pointer.To(v.Sha256Checksum)
+			}
+			if len(v.ValidExitCodes) > 0 {
+				customizer.ValidExitCodes = pointer.To(v.ValidExitCodes)
+			}
+			result = append(result, customizer)
+
+		case imageBuilderCustomizerTypeShell:
+			customizer := virtualmachineimagetemplate.ImageTemplateShellCustomizer{
+				Name: name,
+			}
+			if len(v.Inline) > 0 {
+				customizer.Inline = pointer.To(v.Inline)
+			}
+			if v.ScriptUri != "" {
+				customizer.ScriptUri = pointer.To(v.ScriptUri)
+			}
+			if v.Sha256Checksum != "" {
+				customizer.Sha256Checksum = pointer.To(v.Sha256Checksum)
+			}
+			result = append(result, customizer)
+
+		case imageBuilderCustomizerTypeWindowsRestart:
+			customizer := virtualmachineimagetemplate.ImageTemplateRestartCustomizer{
+				Name: name,
+			}
+			if v.RestartCommand != "" {
+				customizer.RestartCommand = pointer.To(v.RestartCommand)
+			}
+			if v.RestartCheckCommand != "" {
+				customizer.RestartCheckCommand = pointer.To(v.RestartCheckCommand)
+			}
+			if v.RestartTimeout != "" {
+				customizer.RestartTimeout = pointer.To(v.RestartTimeout)
+			}
+			result = append(result, customizer)
+
+		case imageBuilderCustomizerTypeWindowsUpdate:
+			customizer := virtualmachineimagetemplate.ImageTemplateWindowsUpdateCustomizer{
+				Name: name,
+			}
+			if v.SearchCriteria != "" {
+				customizer.SearchCriteria = pointer.To(v.SearchCriteria)
+			}
+			if len(v.Filters) > 0 {
+				customizer.Filters = pointer.To(v.Filters)
+			}
+			if v.UpdateLimit != 0 {
+				customizer.UpdateLimit = pointer.To(v.UpdateLimit)
+			}
+			result = append(result, customizer)
+
+		default:
+			return nil, fmt.Errorf("unsupported customizer type %q", v.Type)
+		}
+	}
+
+	return &result, nil
+}
+
+func flattenImageBuilderTemplateCustomizers(input *[]virtualmachineimagetemplate.ImageTemplateCustomizer) []ImageBuilderTemplateCustomizer {
+	result := make([]ImageBuilderTemplateCustomizer, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, item := range *input {
+		switch v := item.(type) {
+		case virtualmachineimagetemplate.ImageTemplateFileCustomizer:
+			result = append(result, ImageBuilderTemplateCustomizer{
+				Type:           imageBuilderCustomizerTypeFile,
+				Name:           pointer.From(v.Name),
+				SourceUri:      pointer.From(v.SourceUri),
+				Destination:    pointer.From(v.Destination),
+				Sha256Checksum: pointer.From(v.Sha256Checksum),
+			})
+		case virtualmachineimagetemplate.ImageTemplatePowerShellCustomizer:
+			result = append(result, ImageBuilderTemplateCustomizer{
+				Type:           imageBuilderCustomizerTypePowerShell,
+				Name:           pointer.From(v.Name),
+				Inline:         pointer.From(v.Inline),
+				ScriptUri:      pointer.From(v.ScriptUri),
+				Sha256Checksum: pointer.From(v.Sha256Checksum),
+				RunElevated:    pointer.From(v.RunElevated),
+				RunAsSystem:    pointer.From(v.RunAsSystem),
+				ValidExitCodes: pointer.From(v.ValidExitCodes),
+			})
+		case virtualmachineimagetemplate.ImageTemplateShellCustomizer:
+			result = append(result, ImageBuilderTemplateCustomizer{
+				Type:           imageBuilderCustomizerTypeShell,
+				Name:           pointer.From(v.Name),
+				Inline:         pointer.From(v.Inline),
+				ScriptUri:      pointer.From(v.ScriptUri),
+				Sha256Checksum: pointer.From(v.Sha256Checksum),
+			})
+		case virtualmachineimagetemplate.ImageTemplateRestartCustomizer:
+			result = append(result, ImageBuilderTemplateCustomizer{
+				Type:                imageBuilderCustomizerTypeWindowsRestart,
+				Name:                pointer.From(v.Name),
+				RestartCommand:      pointer.From(v.RestartCommand),
+				RestartCheckCommand: pointer.From(v.RestartCheckCommand),
+				RestartTimeout:      pointer.From(v.RestartTimeout),
+			})
+		case virtualmachineimagetemplate.ImageTemplateWindowsUpdateCustomizer:
+			result = append(result, ImageBuilderTemplateCustomizer{
+				Type:           imageBuilderCustomizerTypeWindowsUpdate,
+				Name:           pointer.From(v.Name),
+				SearchCriteria: pointer.From(v.SearchCriteria),
+				Filters:        pointer.From(v.Filters),
+				UpdateLimit:    pointer.From(v.UpdateLimit),
+			})
+		}
+	}
+
+	return result
+}
+
+func expandImageBuilderTemplateSharedImageDistributors(input []ImageBuilderTemplateSharedImage) []virtualmachineimagetemplate.ImageTemplateDistributor {
+	result := make([]virtualmachineimagetemplate.ImageTemplateDistributor, 0)
+	for _, v := range input {
+		regions := make([]string, 0)
+		for _, region := range v.ReplicationRegions {
+			regions = append(regions, location.Normalize(region))
+		}
+
+		distributor := virtualmachineimagetemplate.ImageTemplateSharedImageDistributor{
+			GalleryImageId:     v.SharedImageId,
+			RunOutputName:      v.RunOutputName,
+			ReplicationRegions: pointer.To(regions),
+			StorageAccountType: pointer.ToEnum[virtualmachineimagetemplate.SharedImageStorageAccountType](v.StorageAccountType),
+			ExcludeFromLatest:  pointer.To(v.ExcludeFromLatest),
+		}
+
+		if len(v.ArtifactTags) > 0 {
+			distributor.ArtifactTags = pointer.To(v.ArtifactTags)
+		}
+
+		result = append(result, distributor)
+	}
+
+	return result
+}
+
+func flattenImageBuilderTemplateSharedImageDistributors(input []virtualmachineimagetemplate.ImageTemplateDistributor) []ImageBuilderTemplateSharedImage {
+	result := make([]ImageBuilderTemplateSharedImage, 0)
+	for _, item := range input {
+		v, ok := item.(virtualmachineimagetemplate.ImageTemplateSharedImageDistributor)
+		if !ok {
+			continue
+		}
+
+		regions := make([]string, 0)
+		for _, region := range pointer.From(v.ReplicationRegions) {
+			regions = append(regions, location.Normalize(region))
+		}
+
+		result = append(result, ImageBuilderTemplateSharedImage{
+			SharedImageId:      v.GalleryImageId,
+			RunOutputName:      v.RunOutputName,
+			ReplicationRegions: regions,
+			StorageAccountType: pointer.FromEnum(v.StorageAccountType),
+			ExcludeFromLatest:  pointer.From(v.ExcludeFromLatest),
+			ArtifactTags:       pointer.From(v.ArtifactTags),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/imagebuilder/2024-02-01/virtualmachineimagetemplate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ImageBuilderTemplateRunOutputDataSource struct{}
+
+var _ sdk.DataSource = ImageBuilderTemplateRunOutputDataSource{}
+
+type ImageBuilderTemplateRunOutputDataSourceModel struct {
+	Name                   string `tfschema:"name"`
+	ImageBuilderTemplateId string `tfschema:"image_builder_template_id"`
+	ArtifactId             string `tfschema:"artifact_id"`
+	ArtifactUri            string `tfschema:"artifact_uri"`
+	ProvisioningState      string `tfschema:"provisioning_state"`
+}
+
+func (d ImageBuilderTemplateRunOutputDataSource) ResourceType() string {
+	return "azurerm_image_builder_template_run_output"
+}
+
+func (d ImageBuilderTemplateRunOutputDataSource) ModelObject() interface{} {
+	return &ImageBuilderTemplateRunOutputDataSourceModel{}
+}
+
+func (d ImageBuilderTemplateRunOutputDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"image_builder_template_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: virtualmachineimagetemplate.ValidateImageTemplateID,
+		},
+	}
+}
+
+func (d ImageBuilderTemplateRunOutputDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"artifact_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"artifact_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"provisioning_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (d ImageBuilderTemplateRunOutputDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.ImageBuilderTemplatesClient
+
+			var model ImageBuilderTemplateRunOutputDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			templateId, err := virtualmachineimagetemplate.ParseImageTemplateID(model.ImageBuilderTemplateId)
+			if err != nil {
+				return err
+			}
+
+			id := virtualmachineimagetemplate.NewRunOutputID(templateId.SubscriptionId, templateId.ResourceGroupName, templateId.ImageTemplateName, model.Name)
+
+			resp, err := client.GetRunOutput(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ImageBuilderTemplateRunOutputDataSourceModel{
+				Name:                   id.RunOutputName,
+				ImageBuilderTemplateId: templateId.ID(),
+			}
+
+			if respModel := resp.Model; respModel != nil {
+				if props := respModel.Properties; props != nil {
+					state.ArtifactId = pointer.From(props.ArtifactId)
+					state.ArtifactUri = pointer.From(props.ArtifactUri)
+					state.ProvisioningState = pointer.FromEnum(props.ProvisioningState)
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ImageBuilderTemplateRunOutputDataSource struct{}
+
+func TestAccDataSourceImageBuilderTemplateRunOutput_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_image_builder_template_run_output", "test")
+	r := ImageBuilderTemplateRunOutputDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("artifact_id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
+			),
+		},
+	})
+}
+
+func (ImageBuilderTemplateRunOutputDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_image_builder_template_run_output" "test" {
+  name                      = "acctest-output"
+  image_builder_template_id = azurerm_image_builder_template.test.id
+}
+`, ImageBuilderTemplateResource{}.runOnCreate(data))
+}
@@ -88,6 +88,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		ImageBuilderTemplateRunOutputDataSource{},
 		ManagedDisksDataSource{},
 		OrchestratedVirtualMachineScaleSetDataSource{},
 	}
@@ -104,12 +105,15 @@ func (r Registration) Resources() []sdk.Resource {
 		VirtualMachineRestorePointResource{},
 		VirtualMachineGalleryApplicationAssignmentResource{},
 		VirtualMachineScaleSetStandbyPoolResource{},
+		ImageBuilderTemplateResource{},
+		VirtualMachineScaleSetInstanceProtectionResource{},
 	}
 }
 
 func (r Registration) Actions() []func() action.Action {
 	return []func() action.Action{
 		newVirtualMachinePowerAction,
+		newVirtualMachineScaleSetInstanceAction,
 	}
 }
 
@@ -126,5 +130,7 @@ func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
-	return []sdk.FrameworkListWrappedResource{}
+	return []sdk.FrameworkListWrappedResource{
+		VirtualMachineScaleSetInstanceProtectionListResource{},
+	}
 }
@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetvms"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type VirtualMachineScaleSetInstanceAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &VirtualMachineScaleSetInstanceAction{}
+
+func newVirtualMachineScaleSetInstanceAction() action.Action {
+	return &VirtualMachineScaleSetInstanceAction{}
+}
+
+type VirtualMachineScaleSetInstanceActionModel struct {
+	VirtualMachineScaleSetId types.String `tfsdk:"virtual_machine_scale_set_id"`
+	InstanceId               types.String `tfsdk:"instance_id"`
+	Action                   types.String `tfsdk:"instance_action"`
+	Timeout                  types.String `tfsdk:"timeout"`
+}
+
+func (v *VirtualMachineScaleSetInstanceAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"virtual_machine_scale_set_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the virtual machine scale set containing the instance.",
+				MarkdownDescription: "The ID of the virtual machine scale set containing the instance.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: virtualmachinescalesetvms.ValidateVirtualMachineScaleSetID,
+					},
+				},
+			},
+
+			"instance_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The instance ID of the virtual machine within the scale set on which to perform the action.",
+				MarkdownDescription: "The instance ID of the virtual machine within the scale set on which to perform the action.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"instance_action": schema.StringAttribute{
+				Required:            true,
+				Description:         "The action to take on this scale set instance. Possible values include `reimage`, `redeploy`, and `restart`.",
+				MarkdownDescription: "The action to take on this scale set instance. Possible values include `reimage`, `redeploy`, and `restart`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"reimage",
+						"redeploy",
+						"restart",
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (v *VirtualMachineScaleSetInstanceAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_virtual_machine_scale_set_instance"
+}
+
+func (v *VirtualMachineScaleSetInstanceAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := v.Client.Compute.VirtualMachineScaleSetVMsClient
+
+	model := VirtualMachineScaleSetInstanceActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	scaleSetId, err := virtualmachinescalesetvms.ParseVirtualMachineScaleSetID(model.VirtualMachineScaleSetId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	id := virtualmachinescalesetvms.NewVirtualMachineScaleSetVirtualMachineID(scaleSetId.SubscriptionId, scaleSetId.ResourceGroupName, scaleSetId.VirtualMachineScaleSetName, model.InstanceId.ValueString())
+
+	instanceAction := model.Action.ValueString()
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking %s on instance %s of %s", instanceAction, id.InstanceId, id.VirtualMachineScaleSetName),
+	})
+
+	switch instanceAction {
+	case "reimage":
+		if err := client.ReimageThenPoll(ctx, id, virtualmachinescalesetvms.VirtualMachineScaleSetVMReimageParameters{}); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("reimaging %s: %+v", id, err))
+			return
+		}
+
+	case "redeploy":
+		if err := client.RedeployThenPoll(ctx, id); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("redeploying %s: %+v", id, err))
+			return
+		}
+
+	case "restart":
+		if err := client.RestartThenPoll(ctx, id); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("restarting %s: %+v", id, err))
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("action %s on instance %s of %s completed", instanceAction, id.InstanceId, id.VirtualMachineScaleSetName),
+	})
+}
+
+func (v *VirtualMachineScaleSetInstanceAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	v.Defaults(ctx, request, response)
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type VirtualMachineScaleSetInstanceAction struct{}
+
+func TestAccVirtualMachineScaleSetInstanceAction_restart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_instance", "test")
+	a := VirtualMachineScaleSetInstanceAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.instanceAction(data, "restart"),
+			},
+		},
+	})
+}
+
+func TestAccVirtualMachineScaleSetInstanceAction_redeploy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_instance", "test")
+	a := VirtualMachineScaleSetInstanceAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.instanceAction(data, "redeploy"),
+			},
+		},
+	})
+}
+
+func TestAccVirtualMachineScaleSetInstanceAction_reimage(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_instance", "test")
+	a := VirtualMachineScaleSetInstanceAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.instanceAction(data, "reimage"),
+			},
+		},
+	})
+}
+
+func (a *VirtualMachineScaleSetInstanceAction) instanceAction(data acceptance.TestData, instanceAction string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = data.azurerm_virtual_machine_scale_set.test.instances[0].instance_id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_virtual_machine_scale_set_instance.test]
+    }
+  }
+}
+
+action "azurerm_virtual_machine_scale_set_instance" "test" {
+  config {
+    virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+    instance_id                  = data.azurerm_virtual_machine_scale_set.test.instances[0].instance_id
+    instance_action              = "%s"
+  }
+}
+`, VirtualMachineScaleSetInstanceProtectionResource{}.template(data), instanceAction)
+}
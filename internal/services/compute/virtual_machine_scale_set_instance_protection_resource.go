@@ -0,0 +1,287 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetvms"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_machine_scale_set_instance_protection -properties "instance_id" -service-package-name compute -compare-values "subscription_id:virtual_machine_scale_set_id,resource_group_name:virtual_machine_scale_set_id,virtual_machine_scale_set_name:virtual_machine_scale_set_id"
+
+type VirtualMachineScaleSetInstanceProtectionResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate   = VirtualMachineScaleSetInstanceProtectionResource{}
+	_ sdk.ResourceWithIdentity = VirtualMachineScaleSetInstanceProtectionResource{}
+)
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) Identity() resourceids.ResourceId {
+	return &virtualmachinescalesetvms.VirtualMachineScaleSetVirtualMachineId{}
+}
+
+type VirtualMachineScaleSetInstanceProtectionModel struct {
+	VirtualMachineScaleSetId          string `tfschema:"virtual_machine_scale_set_id"`
+	InstanceId                        string `tfschema:"instance_id"`
+	ProtectFromScaleInEnabled         bool   `tfschema:"protect_from_scale_in_enabled"`
+	ProtectFromScaleSetActionsEnabled bool   `tfschema:"protect_from_scale_set_actions_enabled"`
+	VirtualMachineId                  string `tfschema:"virtual_machine_id"`
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_machine_scale_set_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: virtualmachinescalesetvms.ValidateVirtualMachineScaleSetID,
+		},
+
+		"instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"protect_from_scale_in_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"protect_from_scale_set_actions_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_machine_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) ResourceType() string {
+	return "azurerm_virtual_machine_scale_set_instance_protection"
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) ModelObject() interface{} {
+	return &VirtualMachineScaleSetInstanceProtectionModel{}
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return virtualmachinescalesetvms.ValidateVirtualMachineScaleSetVirtualMachineID
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineScaleSetVMsClient
+
+			var config VirtualMachineScaleSetInstanceProtectionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scaleSetId, err := virtualmachinescalesetvms.ParseVirtualMachineScaleSetID(config.VirtualMachineScaleSetId)
+			if err != nil {
+				return err
+			}
+
+			id := virtualmachinescalesetvms.NewVirtualMachineScaleSetVirtualMachineID(scaleSetId.SubscriptionId, scaleSetId.ResourceGroupName, scaleSetId.VirtualMachineScaleSetName, config.InstanceId)
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, id, virtualmachinescalesetvms.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id)
+			}
+
+			// the protection policy is a property of the instance itself, so an instance with either flag
+			// already enabled is treated as being managed elsewhere
+			if props := existing.Model.Properties; props != nil && props.ProtectionPolicy != nil {
+				if pointer.From(props.ProtectionPolicy.ProtectFromScaleIn) || pointer.From(props.ProtectionPolicy.ProtectFromScaleSetActions) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			policy := virtualmachinescalesetvms.VirtualMachineScaleSetVMProtectionPolicy{
+				ProtectFromScaleIn:         pointer.To(config.ProtectFromScaleInEnabled),
+				ProtectFromScaleSetActions: pointer.To(config.ProtectFromScaleSetActionsEnabled),
+			}
+
+			if err := r.setProtectionPolicy(ctx, client, id, *existing.Model, policy); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineScaleSetVMsClient
+
+			id, err := virtualmachinescalesetvms.ParseVirtualMachineScaleSetVirtualMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, virtualmachinescalesetvms.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			return r.flatten(metadata, id, resp.Model)
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineScaleSetVMsClient
+
+			id, err := virtualmachinescalesetvms.ParseVirtualMachineScaleSetVirtualMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config VirtualMachineScaleSetInstanceProtectionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id, virtualmachinescalesetvms.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			policy := virtualmachinescalesetvms.VirtualMachineScaleSetVMProtectionPolicy{
+				ProtectFromScaleIn:         pointer.To(config.ProtectFromScaleInEnabled),
+				ProtectFromScaleSetActions: pointer.To(config.ProtectFromScaleSetActionsEnabled),
+			}
+
+			return r.setProtectionPolicy(ctx, client, *id, *existing.Model, policy)
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VirtualMachineScaleSetVMsClient
+
+			id, err := virtualmachinescalesetvms.ParseVirtualMachineScaleSetVirtualMachineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id, virtualmachinescalesetvms.DefaultGetOperationOptions())
+			if err != nil {
+				// the instance may have been removed from the scale set, in which case there is nothing to reset
+				if response.WasNotFound(existing.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			policy := virtualmachinescalesetvms.VirtualMachineScaleSetVMProtectionPolicy{
+				ProtectFromScaleIn:         pointer.To(false),
+				ProtectFromScaleSetActions: pointer.To(false),
+			}
+
+			return r.setProtectionPolicy(ctx, client, *id, *existing.Model, policy)
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) setProtectionPolicy(ctx context.Context, client *virtualmachinescalesetvms.VirtualMachineScaleSetVMsClient, id virtualmachinescalesetvms.VirtualMachineScaleSetVirtualMachineId, model virtualmachinescalesetvms.VirtualMachineScaleSetVM, policy virtualmachinescalesetvms.VirtualMachineScaleSetVMProtectionPolicy) error {
+	if model.Properties == nil {
+		model.Properties = &virtualmachinescalesetvms.VirtualMachineScaleSetVMProperties{}
+	}
+
+	// the instance view and extensions are read-only and are rejected by the API if sent back
+	model.Properties.InstanceView = nil
+	model.Resources = nil
+	model.Properties.ProtectionPolicy = &policy
+
+	if err := client.UpdateThenPoll(ctx, id, model, virtualmachinescalesetvms.DefaultUpdateOperationOptions()); err != nil {
+		return fmt.Errorf("updating the protection policy for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) flatten(metadata sdk.ResourceMetaData, id *virtualmachinescalesetvms.VirtualMachineScaleSetVirtualMachineId, model *virtualmachinescalesetvms.VirtualMachineScaleSetVM) error {
+	state := VirtualMachineScaleSetInstanceProtectionModel{
+		VirtualMachineScaleSetId: virtualmachinescalesetvms.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroupName, id.VirtualMachineScaleSetName).ID(),
+		InstanceId:               id.InstanceId,
+	}
+
+	if model != nil {
+		if props := model.Properties; props != nil {
+			state.VirtualMachineId = pointer.From(props.VMId)
+
+			if policy := props.ProtectionPolicy; policy != nil {
+				state.ProtectFromScaleInEnabled = pointer.From(policy.ProtectFromScaleIn)
+				state.ProtectFromScaleSetActionsEnabled = pointer.From(policy.ProtectFromScaleSetActions)
+			}
+		}
+	}
+
+	if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+		return err
+	}
+
+	return metadata.Encode(&state)
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccVirtualMachineScaleSetInstanceProtection_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_instance_protection", "test")
+	r := VirtualMachineScaleSetInstanceProtectionResource{}
+
+	checkedFields := map[string]struct{}{
+		"instance_id":                    {},
+		"resource_group_name":            {},
+		"subscription_id":                {},
+		"virtual_machine_scale_set_name": {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_virtual_machine_scale_set_instance_protection.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_virtual_machine_scale_set_instance_protection.test", tfjsonpath.New("instance_id"), tfjsonpath.New("instance_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_virtual_machine_scale_set_instance_protection.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("virtual_machine_scale_set_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_virtual_machine_scale_set_instance_protection.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("virtual_machine_scale_set_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_virtual_machine_scale_set_instance_protection.test", tfjsonpath.New("virtual_machine_scale_set_name"), tfjsonpath.New("virtual_machine_scale_set_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
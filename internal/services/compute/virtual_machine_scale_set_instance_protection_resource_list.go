@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetvms"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VirtualMachineScaleSetInstanceProtectionListResource struct{}
+
+var _ sdk.FrameworkListWrappedResource = new(VirtualMachineScaleSetInstanceProtectionListResource)
+
+func (VirtualMachineScaleSetInstanceProtectionListResource) ResourceFunc() *pluginsdk.Resource {
+	return sdk.WrappedResource(VirtualMachineScaleSetInstanceProtectionResource{})
+}
+
+func (VirtualMachineScaleSetInstanceProtectionListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = VirtualMachineScaleSetInstanceProtectionResource{}.ResourceType()
+}
+
+type VirtualMachineScaleSetInstanceProtectionListModel struct {
+	VirtualMachineScaleSetId types.String `tfsdk:"virtual_machine_scale_set_id"`
+}
+
+func (VirtualMachineScaleSetInstanceProtectionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"virtual_machine_scale_set_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: virtualmachinescalesetvms.ValidateVirtualMachineScaleSetID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (VirtualMachineScaleSetInstanceProtectionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Compute.VirtualMachineScaleSetVMsClient
+
+	var data VirtualMachineScaleSetInstanceProtectionListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	scaleSetId, err := virtualmachinescalesetvms.ParseVirtualMachineScaleSetID(data.VirtualMachineScaleSetId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "parsing `virtual_machine_scale_set_id`", err)
+		return
+	}
+
+	r := VirtualMachineScaleSetInstanceProtectionResource{}
+
+	resp, err := client.ListComplete(ctx, *scaleSetId, virtualmachinescalesetvms.DefaultListOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.ResourceType()), err)
+		return
+	}
+
+	results := resp.Items
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, instance := range results {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(instance.Name)
+
+			id, err := virtualmachinescalesetvms.ParseVirtualMachineScaleSetVirtualMachineIDInsensitively(pointer.From(instance.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Virtual Machine Scale Set Instance ID", err)
+				return
+			}
+
+			rmd := sdk.NewResourceMetaData(metadata.Client, r)
+			rmd.SetID(id)
+
+			if err := r.flatten(rmd, id, &instance); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", r.ResourceType()), err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rmd.ResourceData, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
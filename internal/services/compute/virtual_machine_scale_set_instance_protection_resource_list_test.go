@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccVirtualMachineScaleSetInstanceProtection_list_basic(t *testing.T) {
+	r := VirtualMachineScaleSetInstanceProtectionResource{}
+	listResourceAddress := "azurerm_virtual_machine_scale_set_instance_protection.list"
+
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_instance_protection", "test")
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.template(data),
+			},
+			{
+				Query:  true,
+				Config: r.basicQueryByVirtualMachineScaleSetId(data),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength(listResourceAddress, 2),
+				},
+			},
+		},
+	})
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) basicQueryByVirtualMachineScaleSetId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_virtual_machine_scale_set_instance_protection" "list" {
+  provider = azurerm
+  config {
+    virtual_machine_scale_set_id = "/subscriptions/%[1]s/resourceGroups/acctestRG-vmss-%[2]d/providers/Microsoft.Compute/virtualMachineScaleSets/acctestvmss-%[2]d"
+  }
+}
+`, data.Subscriptions.Primary, data.RandomInteger)
+}
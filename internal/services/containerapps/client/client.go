@@ -8,8 +8,11 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/certificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappsauthconfigs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappsrevisions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappssessionpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/daprcomponents"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/javacomponents"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/jobs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironmentsstorages"
@@ -17,16 +20,25 @@ import (
 )
 
 type Client struct {
+	AuthConfigsClient          *containerappsauthconfigs.ContainerAppsAuthConfigsClient
 	CertificatesClient         *certificates.CertificatesClient
 	ContainerAppClient         *containerapps.ContainerAppsClient
 	ContainerAppRevisionClient *containerappsrevisions.ContainerAppsRevisionsClient
 	DaprComponentsClient       *daprcomponents.DaprComponentsClient
+	JavaComponentsClient       *javacomponents.JavaComponentsClient
 	ManagedEnvironmentClient   *managedenvironments.ManagedEnvironmentsClient
+	SessionPoolsClient         *containerappssessionpools.ContainerAppsSessionPoolsClient
 	StorageClient              *managedenvironmentsstorages.ManagedEnvironmentsStoragesClient
 	JobClient                  *jobs.JobsClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	authConfigsClient, err := containerappsauthconfigs.NewContainerAppsAuthConfigsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Auth Configs client : %+v", err)
+	}
+	o.Configure(authConfigsClient.Client, o.Authorizers.ResourceManager)
+
 	certificatesClient, err := certificates.NewCertificatesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Certificates client : %+v", err)
@@ -63,6 +75,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(daprComponentClient.Client, o.Authorizers.ResourceManager)
 
+	javaComponentsClient, err := javacomponents.NewJavaComponentsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Java Components client : %+v", err)
+	}
+	o.Configure(javaComponentsClient.Client, o.Authorizers.ResourceManager)
+
+	sessionPoolsClient, err := containerappssessionpools.NewContainerAppsSessionPoolsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Session Pools client : %+v", err)
+	}
+	o.Configure(sessionPoolsClient.Client, o.Authorizers.ResourceManager)
+
 	jobsClient, err := jobs.NewJobsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Jobs client : %+v", err)
@@ -70,11 +94,14 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(jobsClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
+		AuthConfigsClient:          authConfigsClient,
 		CertificatesClient:         certificatesClient,
 		ContainerAppClient:         containerAppsClient,
 		ContainerAppRevisionClient: containerAppsRevisionsClient,
 		DaprComponentsClient:       daprComponentClient,
+		JavaComponentsClient:       javaComponentsClient,
 		ManagedEnvironmentClient:   managedEnvironmentClient,
+		SessionPoolsClient:         sessionPoolsClient,
 		StorageClient:              managedEnvironmentStoragesClient,
 		JobClient:                  jobsClient,
 	}, nil
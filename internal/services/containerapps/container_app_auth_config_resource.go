@@ -0,0 +1,416 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappsauthconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	appServiceHelpers "github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// Container Apps only support a single Auth Config, which must be named `current`.
+const containerAppAuthConfigName = "current"
+
+type ContainerAppAuthConfigResource struct{}
+
+type ContainerAppAuthConfigModel struct {
+	ContainerAppId                     string                                   `tfschema:"container_app_id"`
+	Enabled                            bool                                     `tfschema:"enabled"`
+	RuntimeVersion                     string                                   `tfschema:"runtime_version"`
+	UnauthenticatedAction              string                                   `tfschema:"unauthenticated_action"`
+	DefaultProvider                    string                                   `tfschema:"default_provider"`
+	ExcludedPaths                      []string                                 `tfschema:"excluded_paths"`
+	RequireHTTPS                       bool                                     `tfschema:"require_https"`
+	HttpRouteAPIPrefix                 string                                   `tfschema:"http_route_api_prefix"`
+	ForwardProxyConvention             string                                   `tfschema:"forward_proxy_convention"`
+	ForwardProxyCustomHostHeaderName   string                                   `tfschema:"forward_proxy_custom_host_header_name"`
+	ForwardProxyCustomSchemeHeaderName string                                   `tfschema:"forward_proxy_custom_scheme_header_name"`
+	EncryptionSecretName               string                                   `tfschema:"encryption_secret_name"`
+	SigningSecretName                  string                                   `tfschema:"signing_secret_name"`
+	ActiveDirectory                    []appServiceHelpers.AadAuthV2Settings    `tfschema:"active_directory"`
+	Apple                              []appServiceHelpers.AppleAuthV2Settings  `tfschema:"apple"`
+	CustomOIDC                         []helpers.AuthConfigCustomOIDC           `tfschema:"custom_oidc"`
+	GitHub                             []appServiceHelpers.GithubAuthV2Settings `tfschema:"github"`
+	Google                             []appServiceHelpers.GoogleAuthV2Settings `tfschema:"google"`
+	Login                              []helpers.AuthConfigLogin                `tfschema:"login"`
+	TokenStore                         []helpers.AuthConfigTokenStore           `tfschema:"token_store"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppAuthConfigResource{}
+
+func (r ContainerAppAuthConfigResource) ModelObject() interface{} {
+	return &ContainerAppAuthConfigModel{}
+}
+
+func (r ContainerAppAuthConfigResource) ResourceType() string {
+	return "azurerm_container_app_auth_config"
+}
+
+func (r ContainerAppAuthConfigResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerappsauthconfigs.ValidateAuthConfigID
+}
+
+func (r ContainerAppAuthConfigResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerappsauthconfigs.ValidateContainerAppID,
+			Description:  "The ID of the Container App this Authentication Config applies to.",
+		},
+
+		"enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Should the Authentication and Authorisation feature be enabled for the Container App? Defaults to `true`.",
+		},
+
+		"runtime_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The Runtime Version of the Authentication and Authorisation feature.",
+		},
+
+		"unauthenticated_action": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(containerappsauthconfigs.UnauthenticatedClientActionV2RedirectToLoginPage),
+			ValidateFunc: validation.StringInSlice(containerappsauthconfigs.PossibleValuesForUnauthenticatedClientActionV2(), false),
+			Description:  "The action to take for requests made without authentication.",
+		},
+
+		"default_provider": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The Default Authentication Provider to use when more than one Authentication Provider is configured and the `unauthenticated_action` is set to `RedirectToLoginPage`.",
+		},
+
+		"excluded_paths": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			Description: "The paths which should be excluded from the `unauthenticated_action`.",
+		},
+
+		"require_https": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Should HTTPS be required on connections? Defaults to `true`.",
+		},
+
+		"http_route_api_prefix": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "/.auth",
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The prefix that should precede all the authentication and authorisation paths. Defaults to `/.auth`.",
+		},
+
+		"forward_proxy_convention": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(containerappsauthconfigs.ForwardProxyConventionNoProxy),
+			ValidateFunc: validation.StringInSlice(containerappsauthconfigs.PossibleValuesForForwardProxyConvention(), false),
+			Description:  "The convention used to determine the URL of the request made. Defaults to `NoProxy`.",
+		},
+
+		"forward_proxy_custom_host_header_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The name of the header containing the host of the request.",
+		},
+
+		"forward_proxy_custom_scheme_header_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The name of the header containing the scheme of the request.",
+		},
+
+		"encryption_secret_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.SecretName,
+			Description:  "The name of the Container App secret containing the key used to encrypt the authentication cookies.",
+		},
+
+		"signing_secret_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.SecretName,
+			Description:  "The name of the Container App secret containing the key used to sign the authentication cookies.",
+		},
+
+		"active_directory": helpers.AuthConfigActiveDirectorySchema(),
+
+		"apple": helpers.AuthConfigAppleSchema(),
+
+		"custom_oidc": helpers.AuthConfigCustomOIDCSchema(),
+
+		"github": helpers.AuthConfigGitHubSchema(),
+
+		"google": helpers.AuthConfigGoogleSchema(),
+
+		"login": helpers.AuthConfigLoginSchema(),
+
+		"token_store": helpers.AuthConfigTokenStoreSchema(),
+	}
+}
+
+func (r ContainerAppAuthConfigResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerAppAuthConfigResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.AuthConfigsClient
+
+			var model ContainerAppAuthConfigModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			containerAppId, err := containerappsauthconfigs.ParseContainerAppID(model.ContainerAppId)
+			if err != nil {
+				return err
+			}
+
+			id := containerappsauthconfigs.NewAuthConfigID(containerAppId.SubscriptionId, containerAppId.ResourceGroupName, containerAppId.ContainerAppName, containerAppAuthConfigName)
+
+			locks.ByID(containerAppId.ID())
+			defer locks.UnlockByID(containerAppId.ID())
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			// the API returns a default, disabled, Auth Config for Container Apps which haven't been configured
+			if existing.Model != nil && existing.Model.Properties != nil && existing.Model.Properties.Platform != nil && pointer.From(existing.Model.Properties.Platform.Enabled) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := containerappsauthconfigs.AuthConfig{
+				Properties: expandContainerAppAuthConfigProperties(model),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppAuthConfigResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.AuthConfigsClient
+
+			id, err := containerappsauthconfigs.ParseAuthConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppAuthConfigModel{
+				ContainerAppId: containerappsauthconfigs.NewContainerAppID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if platform := props.Platform; platform != nil {
+						state.Enabled = pointer.From(platform.Enabled)
+						state.RuntimeVersion = pointer.From(platform.RuntimeVersion)
+					}
+
+					if global := props.GlobalValidation; global != nil {
+						state.UnauthenticatedAction = pointer.FromEnum(global.UnauthenticatedClientAction)
+						state.DefaultProvider = pointer.From(global.RedirectToProvider)
+						state.ExcludedPaths = pointer.From(global.ExcludedPaths)
+					}
+
+					if httpSettings := props.HTTPSettings; httpSettings != nil {
+						state.RequireHTTPS = pointer.From(httpSettings.RequireHTTPS)
+						if routes := httpSettings.Routes; routes != nil {
+							state.HttpRouteAPIPrefix = pointer.From(routes.ApiPrefix)
+						}
+						if proxy := httpSettings.ForwardProxy; proxy != nil {
+							state.ForwardProxyConvention = pointer.FromEnum(proxy.Convention)
+							state.ForwardProxyCustomHostHeaderName = pointer.From(proxy.CustomHostHeaderName)
+							state.ForwardProxyCustomSchemeHeaderName = pointer.From(proxy.CustomProtoHeaderName)
+						}
+					}
+
+					if encryption := props.EncryptionSettings; encryption != nil {
+						state.EncryptionSecretName = pointer.From(encryption.ContainerAppAuthEncryptionSecretName)
+						state.SigningSecretName = pointer.From(encryption.ContainerAppAuthSigningSecretName)
+					}
+
+					if providers := props.IdentityProviders; providers != nil {
+						state.ActiveDirectory = helpers.FlattenAuthConfigActiveDirectory(providers.AzureActiveDirectory)
+						state.Apple = helpers.FlattenAuthConfigApple(providers.Apple)
+						state.CustomOIDC = helpers.FlattenAuthConfigCustomOIDC(providers.CustomOpenIdConnectProviders)
+						state.GitHub = helpers.FlattenAuthConfigGitHub(providers.GitHub)
+						state.Google = helpers.FlattenAuthConfigGoogle(providers.Google)
+					}
+
+					state.Login, state.TokenStore = helpers.FlattenAuthConfigLogin(props.Login)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppAuthConfigResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.AuthConfigsClient
+
+			id, err := containerappsauthconfigs.ParseAuthConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppAuthConfigModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			containerAppId := containerappsauthconfigs.NewContainerAppID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName)
+
+			locks.ByID(containerAppId.ID())
+			defer locks.UnlockByID(containerAppId.ID())
+
+			// the Auth Config is sent in full since omitted providers must be explicitly disabled
+			payload := containerappsauthconfigs.AuthConfig{
+				Properties: expandContainerAppAuthConfigProperties(model),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppAuthConfigResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.AuthConfigsClient
+
+			id, err := containerappsauthconfigs.ParseAuthConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			containerAppId := containerappsauthconfigs.NewContainerAppID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName)
+
+			locks.ByID(containerAppId.ID())
+			defer locks.UnlockByID(containerAppId.ID())
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppAuthConfigProperties(input ContainerAppAuthConfigModel) *containerappsauthconfigs.AuthConfigProperties {
+	result := &containerappsauthconfigs.AuthConfigProperties{
+		Platform: &containerappsauthconfigs.AuthPlatform{
+			Enabled: pointer.To(input.Enabled),
+		},
+		GlobalValidation: &containerappsauthconfigs.GlobalValidation{
+			UnauthenticatedClientAction: pointer.ToEnum[containerappsauthconfigs.UnauthenticatedClientActionV2](input.UnauthenticatedAction),
+			ExcludedPaths:               pointer.To(input.ExcludedPaths),
+		},
+		HTTPSettings: &containerappsauthconfigs.HTTPSettings{
+			RequireHTTPS: pointer.To(input.RequireHTTPS),
+			Routes: &containerappsauthconfigs.HTTPSettingsRoutes{
+				ApiPrefix: pointer.To(input.HttpRouteAPIPrefix),
+			},
+			ForwardProxy: &containerappsauthconfigs.ForwardProxy{
+				Convention: pointer.ToEnum[containerappsauthconfigs.ForwardProxyConvention](input.ForwardProxyConvention),
+			},
+		},
+		IdentityProviders: &containerappsauthconfigs.IdentityProviders{
+			AzureActiveDirectory:         helpers.ExpandAuthConfigActiveDirectory(input.ActiveDirectory),
+			Apple:                        helpers.ExpandAuthConfigApple(input.Apple),
+			CustomOpenIdConnectProviders: helpers.ExpandAuthConfigCustomOIDC(input.CustomOIDC),
+			GitHub:                       helpers.ExpandAuthConfigGitHub(input.GitHub),
+			Google:                       helpers.ExpandAuthConfigGoogle(input.Google),
+		},
+		Login: helpers.ExpandAuthConfigLogin(input.Login, input.TokenStore),
+	}
+
+	if input.RuntimeVersion != "" {
+		result.Platform.RuntimeVersion = pointer.To(input.RuntimeVersion)
+	}
+
+	if input.DefaultProvider != "" {
+		result.GlobalValidation.RedirectToProvider = pointer.To(input.DefaultProvider)
+	}
+
+	if input.ForwardProxyCustomHostHeaderName != "" {
+		result.HTTPSettings.ForwardProxy.CustomHostHeaderName = pointer.To(input.ForwardProxyCustomHostHeaderName)
+	}
+
+	if input.ForwardProxyCustomSchemeHeaderName != "" {
+		result.HTTPSettings.ForwardProxy.CustomProtoHeaderName = pointer.To(input.ForwardProxyCustomSchemeHeaderName)
+	}
+
+	if input.EncryptionSecretName != "" || input.SigningSecretName != "" {
+		result.EncryptionSettings = &containerappsauthconfigs.EncryptionSettings{}
+		if input.EncryptionSecretName != "" {
+			result.EncryptionSettings.ContainerAppAuthEncryptionSecretName = pointer.To(input.EncryptionSecretName)
+		}
+		if input.SigningSecretName != "" {
+			result.EncryptionSettings.ContainerAppAuthSigningSecretName = pointer.To(input.SigningSecretName)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,288 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappsauthconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppAuthConfigResource struct{}
+
+func TestAccContainerAppAuthConfig_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_auth_config", "test")
+	r := ContainerAppAuthConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppAuthConfig_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_auth_config", "test")
+	r := ContainerAppAuthConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppAuthConfig_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_auth_config", "test")
+	r := ContainerAppAuthConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppAuthConfig_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_auth_config", "test")
+	r := ContainerAppAuthConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerAppAuthConfigResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := containerappsauthconfigs.ParseAuthConfigID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.AuthConfigsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.Platform == nil {
+		return pointer.To(false), nil
+	}
+
+	return resp.Model.Properties.Platform.Enabled, nil
+}
+
+func (r ContainerAppAuthConfigResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_auth_config" "test" {
+  container_app_id = azurerm_container_app.test.id
+
+  github {
+    client_id                  = "acctest-github-client"
+    client_secret_setting_name = "github-secret"
+  }
+}
+`, r.template(data))
+}
+
+func (r ContainerAppAuthConfigResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_auth_config" "import" {
+  container_app_id = azurerm_container_app_auth_config.test.container_app_id
+
+  github {
+    client_id                  = "acctest-github-client"
+    client_secret_setting_name = "github-secret"
+  }
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppAuthConfigResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_container_app_auth_config" "test" {
+  container_app_id       = azurerm_container_app.test.id
+  unauthenticated_action = "Return401"
+  default_provider       = "azureactivedirectory"
+  excluded_paths         = ["/health", "/public"]
+  require_https          = true
+  http_route_api_prefix  = "/.easyauth"
+
+  forward_proxy_convention                = "Custom"
+  forward_proxy_custom_host_header_name   = "X-Original-Host"
+  forward_proxy_custom_scheme_header_name = "X-Original-Proto"
+
+  encryption_secret_name = "encryption-secret"
+  signing_secret_name    = "signing-secret"
+
+  active_directory {
+    client_id                  = "00000000-0000-0000-0000-000000000001"
+    client_secret_setting_name = "aad-secret"
+    tenant_auth_endpoint       = "https://login.microsoftonline.com/${data.azurerm_client_config.current.tenant_id}/v2.0"
+    allowed_audiences          = ["api://acctest-%[2]d"]
+  }
+
+  apple {
+    client_id                  = "acctest.apple.client"
+    client_secret_setting_name = "apple-secret"
+  }
+
+  github {
+    client_id                  = "acctest-github-client"
+    client_secret_setting_name = "github-secret"
+    login_scopes               = ["user:email"]
+  }
+
+  google {
+    client_id                  = "acctest-google-client"
+    client_secret_setting_name = "google-secret"
+    login_scopes               = ["openid", "profile"]
+  }
+
+  custom_oidc {
+    name                          = "acctestoidc"
+    client_id                     = "acctest-oidc-client"
+    client_secret_name            = "oidc-secret"
+    openid_configuration_endpoint = "https://oidc.example.com/.well-known/openid-configuration"
+    scopes                        = ["openid", "email"]
+  }
+
+  login {
+    logout_endpoint                   = "/.easyauth/logout"
+    preserve_url_fragments_for_logins = true
+    allowed_external_redirect_urls    = ["https://example.com"]
+    cookie_expiration_convention      = "IdentityProviderDerived"
+    cookie_expiration_time            = "12:00:00"
+    validate_nonce                    = true
+    nonce_expiration_time             = "00:10:00"
+  }
+
+  token_store {
+    sas_url_secret_name     = "token-store-sas"
+    refresh_extension_hours = 48
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppAuthConfigResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Single"
+
+  secret {
+    name  = "aad-secret"
+    value = "VGhpcyBJcyBOb3QgQSBHb29kIFBhc3N3b3JkCg=="
+  }
+
+  secret {
+    name  = "apple-secret"
+    value = "VGhpcyBJcyBOb3QgQSBHb29kIFBhc3N3b3JkCg=="
+  }
+
+  secret {
+    name  = "encryption-secret"
+    value = "VGhpcyBJcyBOb3QgQSBHb29kIFBhc3N3b3JkCg=="
+  }
+
+  secret {
+    name  = "github-secret"
+    value = "VGhpcyBJcyBOb3QgQSBHb29kIFBhc3N3b3JkCg=="
+  }
+
+  secret {
+    name  = "google-secret"
+    value = "VGhpcyBJcyBOb3QgQSBHb29kIFBhc3N3b3JkCg=="
+  }
+
+  secret {
+    name  = "oidc-secret"
+    value = "VGhpcyBJcyBOb3QgQSBHb29kIFBhc3N3b3JkCg=="
+  }
+
+  secret {
+    name  = "signing-secret"
+    value = "VGhpcyBJcyBOb3QgQSBHb29kIFBhc3N3b3JkCg=="
+  }
+
+  secret {
+    name  = "token-store-sas"
+    value = "https://example.blob.core.windows.net/tokens?sv=2022-11-02"
+  }
+
+  ingress {
+    external_enabled = true
+    target_port      = 5000
+
+    traffic_weight {
+      latest_revision = true
+      percentage      = 100
+    }
+  }
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+  }
+}
+`, ContainerAppResource{}.template(data), data.RandomInteger)
+}
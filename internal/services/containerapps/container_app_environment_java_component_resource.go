@@ -0,0 +1,379 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/javacomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppEnvironmentJavaComponentResource struct{}
+
+type ContainerAppEnvironmentJavaComponentModel struct {
+	Name                 string                     `tfschema:"name"`
+	ManagedEnvironmentId string                     `tfschema:"container_app_environment_id"`
+	ComponentType        string                     `tfschema:"component_type"`
+	Configurations       map[string]string          `tfschema:"configurations"`
+	MinReplicas          int64                      `tfschema:"min_replicas"`
+	MaxReplicas          int64                      `tfschema:"max_replicas"`
+	ServiceBinds         []JavaComponentServiceBind `tfschema:"service_bind"`
+
+	IngressFqdn string `tfschema:"ingress_fqdn"`
+}
+
+type JavaComponentServiceBind struct {
+	Name      string `tfschema:"name"`
+	ServiceId string `tfschema:"service_id"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentJavaComponentResource{}
+
+func (r ContainerAppEnvironmentJavaComponentResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentJavaComponentModel{}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) ResourceType() string {
+	return "azurerm_container_app_environment_java_component"
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return javacomponents.ValidateJavaComponentID
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerAppName,
+			Description:  "The name for this Java Component.",
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: javacomponents.ValidateManagedEnvironmentID,
+			Description:  "The Container App Managed Environment ID to configure this Java Component on.",
+		},
+
+		"component_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(javacomponents.PossibleValuesForJavaComponentType(), false),
+			Description:  "The Java Component Type.",
+		},
+
+		"configurations": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+			Description: "A mapping of configuration property names to values for this Java Component.",
+		},
+
+		"min_replicas": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 30),
+			Description:  "The minimum number of replicas for this Java Component.",
+		},
+
+		"max_replicas": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 30),
+			Description:  "The maximum number of replicas for this Java Component.",
+		},
+
+		"service_bind": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The name of the service bind.",
+					},
+
+					"service_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: javacomponents.ValidateJavaComponentID,
+						Description:  "The ID of the Java Component to bind to.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"ingress_fqdn": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The FQDN of the ingress for this Java Component, if supported by the `component_type`.",
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ContainerAppEnvironmentJavaComponentModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			managedEnvironmentId, err := javacomponents.ParseManagedEnvironmentID(model.ManagedEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := javacomponents.NewJavaComponentID(subscriptionId, managedEnvironmentId.ResourceGroupName, managedEnvironmentId.ManagedEnvironmentName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := javacomponents.JavaComponent{
+				Properties: expandJavaComponentProperties(model),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppEnvironmentJavaComponentModel{
+				Name:                 id.JavaComponentName,
+				ManagedEnvironmentId: javacomponents.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties.JavaComponentProperties()
+				state.ComponentType = string(props.ComponentType)
+				state.Configurations = flattenJavaComponentConfigurations(props.Configurations)
+				state.ServiceBinds = flattenJavaComponentServiceBinds(props.ServiceBinds)
+
+				if scale := props.Scale; scale != nil {
+					state.MinReplicas = pointer.From(scale.MinReplicas)
+					state.MaxReplicas = pointer.From(scale.MaxReplicas)
+				}
+
+				switch v := model.Properties.(type) {
+				case javacomponents.SpringBootAdminComponent:
+					if v.Ingress != nil {
+						state.IngressFqdn = pointer.From(v.Ingress.Fqdn)
+					}
+				case javacomponents.SpringCloudEurekaComponent:
+					if v.Ingress != nil {
+						state.IngressFqdn = pointer.From(v.Ingress.Fqdn)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentJavaComponentModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			// the component type determines the shape of the payload, so the full set of properties is sent on update
+			payload := javacomponents.JavaComponent{
+				Properties: expandJavaComponentProperties(model),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandJavaComponentProperties(input ContainerAppEnvironmentJavaComponentModel) javacomponents.JavaComponentProperties {
+	componentType := javacomponents.JavaComponentType(input.ComponentType)
+	configurations := expandJavaComponentConfigurations(input.Configurations)
+	serviceBinds := expandJavaComponentServiceBinds(input.ServiceBinds)
+	scale := &javacomponents.JavaComponentPropertiesScale{
+		MinReplicas: pointer.To(input.MinReplicas),
+		MaxReplicas: pointer.To(input.MaxReplicas),
+	}
+
+	switch componentType {
+	case javacomponents.JavaComponentTypeSpringBootAdmin:
+		return javacomponents.SpringBootAdminComponent{
+			ComponentType:  componentType,
+			Configurations: configurations,
+			Scale:          scale,
+			ServiceBinds:   serviceBinds,
+		}
+	case javacomponents.JavaComponentTypeSpringCloudConfig:
+		return javacomponents.SpringCloudConfigComponent{
+			ComponentType:  componentType,
+			Configurations: configurations,
+			Scale:          scale,
+			ServiceBinds:   serviceBinds,
+		}
+	case javacomponents.JavaComponentTypeSpringCloudEureka:
+		return javacomponents.SpringCloudEurekaComponent{
+			ComponentType:  componentType,
+			Configurations: configurations,
+			Scale:          scale,
+			ServiceBinds:   serviceBinds,
+		}
+	}
+
+	return javacomponents.BaseJavaComponentPropertiesImpl{
+		ComponentType:  componentType,
+		Configurations: configurations,
+		Scale:          scale,
+		ServiceBinds:   serviceBinds,
+	}
+}
+
+func expandJavaComponentConfigurations(input map[string]string) *[]javacomponents.JavaComponentConfigurationProperty {
+	result := make([]javacomponents.JavaComponentConfigurationProperty, 0)
+	for k, v := range input {
+		result = append(result, javacomponents.JavaComponentConfigurationProperty{
+			PropertyName: pointer.To(k),
+			Value:        pointer.To(v),
+		})
+	}
+
+	return &result
+}
+
+func flattenJavaComponentConfigurations(input *[]javacomponents.JavaComponentConfigurationProperty) map[string]string {
+	result := make(map[string]string)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		if v.PropertyName == nil {
+			continue
+		}
+		result[*v.PropertyName] = pointer.From(v.Value)
+	}
+
+	return result
+}
+
+func expandJavaComponentServiceBinds(input []JavaComponentServiceBind) *[]javacomponents.JavaComponentServiceBind {
+	result := make([]javacomponents.JavaComponentServiceBind, 0)
+	for _, v := range input {
+		result = append(result, javacomponents.JavaComponentServiceBind{
+			Name:      pointer.To(v.Name),
+			ServiceId: pointer.To(v.ServiceId),
+		})
+	}
+
+	return &result
+}
+
+func flattenJavaComponentServiceBinds(input *[]javacomponents.JavaComponentServiceBind) []JavaComponentServiceBind {
+	result := make([]JavaComponentServiceBind, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, JavaComponentServiceBind{
+			Name:      pointer.From(v.Name),
+			ServiceId: pointer.From(v.ServiceId),
+		})
+	}
+
+	return result
+}
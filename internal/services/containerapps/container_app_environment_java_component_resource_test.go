@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/javacomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppEnvironmentJavaComponentResource struct{}
+
+func TestAccContainerAppEnvironmentJavaComponent_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_java_component", "test")
+	r := ContainerAppEnvironmentJavaComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppEnvironmentJavaComponent_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_java_component", "test")
+	r := ContainerAppEnvironmentJavaComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppEnvironmentJavaComponent_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_java_component", "test")
+	r := ContainerAppEnvironmentJavaComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ingress_fqdn").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppEnvironmentJavaComponent_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_java_component", "test")
+	r := ContainerAppEnvironmentJavaComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.completeUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := javacomponents.ParseJavaComponentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.JavaComponentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_container_app_environment_java_component" "test" {
+  name                         = "config%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "SpringCloudConfig"
+}
+`, r.template(data), data.RandomIntOfLength(8))
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_environment_java_component" "import" {
+  name                         = azurerm_container_app_environment_java_component.test.name
+  container_app_environment_id = azurerm_container_app_environment_java_component.test.container_app_environment_id
+  component_type               = azurerm_container_app_environment_java_component.test.component_type
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_container_app_environment_java_component" "eureka" {
+  name                         = "eureka%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "SpringCloudEureka"
+}
+
+resource "azurerm_container_app_environment_java_component" "test" {
+  name                         = "admin%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "SpringBootAdmin"
+  min_replicas                 = 1
+  max_replicas                 = 1
+
+  service_bind {
+    name       = "eureka"
+    service_id = azurerm_container_app_environment_java_component.eureka.id
+  }
+}
+`, r.template(data), data.RandomIntOfLength(8))
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) completeUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_container_app_environment_java_component" "eureka" {
+  name                         = "eureka%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "SpringCloudEureka"
+}
+
+resource "azurerm_container_app_environment_java_component" "test" {
+  name                         = "admin%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "SpringBootAdmin"
+  min_replicas                 = 1
+  max_replicas                 = 2
+
+  configurations = {
+    "spring.boot.admin.ui.title" = "acctest"
+  }
+}
+`, r.template(data), data.RandomIntOfLength(8))
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-CAEnv-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestCAEnv-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_container_app_environment" "test" {
+  name                       = "acctest-CAEnv%[1]d"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+
+  workload_profile {
+    name                  = "Consumption"
+    workload_profile_type = "Consumption"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
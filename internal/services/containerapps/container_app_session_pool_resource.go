@@ -0,0 +1,436 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappssessionpools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppSessionPoolResource struct{}
+
+type ContainerAppSessionPoolModel struct {
+	Name                      string                                       `tfschema:"name"`
+	ResourceGroup             string                                       `tfschema:"resource_group_name"`
+	Location                  string                                       `tfschema:"location"`
+	ContainerAppEnvironmentId string                                       `tfschema:"container_app_environment_id"`
+	ContainerType             string                                       `tfschema:"container_type"`
+	PoolManagementType        string                                       `tfschema:"pool_management_type"`
+	MaxConcurrentSessions     int64                                        `tfschema:"max_concurrent_sessions"`
+	ReadySessionInstances     int64                                        `tfschema:"ready_session_instances"`
+	NetworkEgressEnabled      bool                                         `tfschema:"network_egress_enabled"`
+	Lifecycle                 []helpers.SessionPoolLifecycle               `tfschema:"session_lifecycle"`
+	CustomContainerTemplate   []helpers.SessionPoolCustomContainerTemplate `tfschema:"custom_container_template"`
+	ManagedIdentitySettings   []helpers.SessionPoolManagedIdentitySetting  `tfschema:"managed_identity_setting"`
+	Secrets                   []helpers.SessionPoolSecret                  `tfschema:"secret"`
+	Identity                  []identity.ModelSystemAssignedUserAssigned   `tfschema:"identity"`
+	Tags                      map[string]interface{}                       `tfschema:"tags"`
+
+	PoolManagementEndpoint string `tfschema:"pool_management_endpoint"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate        = ContainerAppSessionPoolResource{}
+	_ sdk.ResourceWithCustomizeDiff = ContainerAppSessionPoolResource{}
+)
+
+func (r ContainerAppSessionPoolResource) ModelObject() interface{} {
+	return &ContainerAppSessionPoolModel{}
+}
+
+func (r ContainerAppSessionPoolResource) ResourceType() string {
+	return "azurerm_container_app_session_pool"
+}
+
+func (r ContainerAppSessionPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerappssessionpools.ValidateSessionPoolID
+}
+
+func (r ContainerAppSessionPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerAppName,
+			Description:  "The name for this Container App Session Pool.",
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+			Description:  "The ID of the Container App Environment to host this Session Pool. Required when `container_type` is `CustomContainer`.",
+		},
+
+		"container_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(containerappssessionpools.PossibleValuesForContainerType(), false),
+			Description:  "The type of container used for the sessions in this Session Pool.",
+		},
+
+		"pool_management_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(containerappssessionpools.PoolManagementTypeDynamic),
+			ValidateFunc: validation.StringInSlice(containerappssessionpools.PossibleValuesForPoolManagementType(), false),
+			Description:  "The pool management type of this Session Pool.",
+		},
+
+		"max_concurrent_sessions": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of sessions that can run concurrently in this Session Pool.",
+		},
+
+		"ready_session_instances": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The number of sessions which are kept ready in this Session Pool.",
+		},
+
+		"network_egress_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Should outbound network access be enabled for the sessions in this Session Pool?",
+		},
+
+		"session_lifecycle": helpers.SessionPoolLifecycleSchema(),
+
+		"custom_container_template": helpers.SessionPoolCustomContainerTemplateSchema(),
+
+		"managed_identity_setting": helpers.SessionPoolManagedIdentitySettingSchema(),
+
+		"secret": helpers.SessionPoolSecretsSchema(),
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"pool_management_endpoint": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The endpoint used to manage the sessions in this Session Pool.",
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ContainerAppSessionPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id := containerappssessionpools.NewSessionPoolID(subscriptionId, model.ResourceGroup, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			template, err := helpers.ExpandSessionPoolCustomContainerTemplate(model.CustomContainerTemplate)
+			if err != nil {
+				return fmt.Errorf("expanding `custom_container_template` for %s: %+v", id, err)
+			}
+
+			sessionPool := containerappssessionpools.SessionPool{
+				Location: location.Normalize(model.Location),
+				Properties: &containerappssessionpools.SessionPoolProperties{
+					ContainerType:               pointer.ToEnum[containerappssessionpools.ContainerType](model.ContainerType),
+					CustomContainerTemplate:     template,
+					DynamicPoolConfiguration:    helpers.ExpandSessionPoolLifecycle(model.Lifecycle),
+					ManagedIdentitySettings:     helpers.ExpandSessionPoolManagedIdentitySettings(model.ManagedIdentitySettings),
+					PoolManagementType:          pointer.ToEnum[containerappssessionpools.PoolManagementType](model.PoolManagementType),
+					ScaleConfiguration:          expandSessionPoolScaleConfiguration(model),
+					Secrets:                     helpers.ExpandSessionPoolSecrets(model.Secrets),
+					SessionNetworkConfiguration: expandSessionPoolNetworkConfiguration(model.NetworkEgressEnabled),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			if model.ContainerAppEnvironmentId != "" {
+				sessionPool.Properties.EnvironmentId = pointer.To(model.ContainerAppEnvironmentId)
+			}
+
+			ident, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+			sessionPool.Identity = ident
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, sessionPool); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+
+			id, err := containerappssessionpools.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// The API does not return the secret values, so these are retained from the config / state
+			var config ContainerAppSessionPoolModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			state := ContainerAppSessionPoolModel{
+				Name:          id.SessionPoolName,
+				ResourceGroup: id.ResourceGroupName,
+				Secrets:       config.Secrets,
+			}
+
+			if model := existing.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if model.Identity != nil {
+					ident, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To((identity.SystemAndUserAssignedMap)(*model.Identity)))
+					if err != nil {
+						return err
+					}
+					state.Identity = pointer.From(ident)
+				}
+
+				if props := model.Properties; props != nil {
+					if props.EnvironmentId != nil {
+						envId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(*props.EnvironmentId)
+						if err != nil {
+							return err
+						}
+						state.ContainerAppEnvironmentId = envId.ID()
+					}
+
+					state.ContainerType = pointer.FromEnum(props.ContainerType)
+					state.PoolManagementType = pointer.FromEnum(props.PoolManagementType)
+					state.PoolManagementEndpoint = pointer.From(props.PoolManagementEndpoint)
+					state.Lifecycle = helpers.FlattenSessionPoolLifecycle(props.DynamicPoolConfiguration)
+					state.CustomContainerTemplate = helpers.FlattenSessionPoolCustomContainerTemplate(props.CustomContainerTemplate)
+					state.ManagedIdentitySettings = helpers.FlattenSessionPoolManagedIdentitySettings(props.ManagedIdentitySettings)
+
+					if scale := props.ScaleConfiguration; scale != nil {
+						state.MaxConcurrentSessions = pointer.From(scale.MaxConcurrentSessions)
+						state.ReadySessionInstances = pointer.From(scale.ReadySessionInstances)
+					}
+
+					if network := props.SessionNetworkConfiguration; network != nil {
+						state.NetworkEgressEnabled = pointer.From(network.Status) == containerappssessionpools.SessionNetworkStatusEgressEnabled
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+
+			id, err := containerappssessionpools.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContainerAppSessionPoolModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			payload := containerappssessionpools.SessionPoolUpdatableProperties{
+				Properties: &containerappssessionpools.SessionPoolUpdatablePropertiesProperties{},
+			}
+
+			if metadata.ResourceData.HasChanges("max_concurrent_sessions", "ready_session_instances") {
+				payload.Properties.ScaleConfiguration = expandSessionPoolScaleConfiguration(config)
+			}
+
+			if metadata.ResourceData.HasChange("session_lifecycle") {
+				payload.Properties.DynamicPoolConfiguration = helpers.ExpandSessionPoolLifecycle(config.Lifecycle)
+			}
+
+			if metadata.ResourceData.HasChange("network_egress_enabled") {
+				payload.Properties.SessionNetworkConfiguration = expandSessionPoolNetworkConfiguration(config.NetworkEgressEnabled)
+			}
+
+			if metadata.ResourceData.HasChanges("custom_container_template", "secret") {
+				template, err := helpers.ExpandSessionPoolCustomContainerTemplate(config.CustomContainerTemplate)
+				if err != nil {
+					return fmt.Errorf("expanding `custom_container_template` for %s: %+v", *id, err)
+				}
+				payload.Properties.CustomContainerTemplate = template
+				payload.Properties.Secrets = helpers.ExpandSessionPoolSecrets(config.Secrets)
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				ident, err := identity.ExpandLegacySystemAndUserAssignedMapFromModel(config.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = ident
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.Expand(config.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+
+			id, err := containerappssessionpools.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceDiff == nil {
+				return nil
+			}
+
+			var config ContainerAppSessionPoolModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return err
+			}
+
+			if config.ContainerType == string(containerappssessionpools.ContainerTypeCustomContainer) {
+				if len(config.CustomContainerTemplate) == 0 {
+					return fmt.Errorf("`custom_container_template` must be specified when `container_type` is `%s`", containerappssessionpools.ContainerTypeCustomContainer)
+				}
+				if config.ContainerAppEnvironmentId == "" && metadata.ResourceDiff.NewValueKnown("container_app_environment_id") {
+					return fmt.Errorf("`container_app_environment_id` must be specified when `container_type` is `%s`", containerappssessionpools.ContainerTypeCustomContainer)
+				}
+			} else if len(config.CustomContainerTemplate) > 0 {
+				return fmt.Errorf("`custom_container_template` can only be specified when `container_type` is `%s`", containerappssessionpools.ContainerTypeCustomContainer)
+			}
+
+			for _, l := range config.Lifecycle {
+				switch containerappssessionpools.LifecycleType(l.Type) {
+				case containerappssessionpools.LifecycleTypeTimed:
+					if l.MaxAlivePeriodInSeconds != 0 {
+						return fmt.Errorf("`session_lifecycle.0.max_alive_period_in_seconds` cannot be specified when `session_lifecycle.0.type` is `%s`", l.Type)
+					}
+				case containerappssessionpools.LifecycleTypeOnContainerExit:
+					if l.CooldownPeriodInSeconds != 0 {
+						return fmt.Errorf("`session_lifecycle.0.cooldown_period_in_seconds` cannot be specified when `session_lifecycle.0.type` is `%s`", l.Type)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSessionPoolScaleConfiguration(input ContainerAppSessionPoolModel) *containerappssessionpools.ScaleConfiguration {
+	result := &containerappssessionpools.ScaleConfiguration{
+		MaxConcurrentSessions: pointer.To(input.MaxConcurrentSessions),
+	}
+
+	if input.ReadySessionInstances != 0 {
+		result.ReadySessionInstances = pointer.To(input.ReadySessionInstances)
+	}
+
+	return result
+}
+
+func expandSessionPoolNetworkConfiguration(egressEnabled bool) *containerappssessionpools.SessionNetworkConfiguration {
+	status := containerappssessionpools.SessionNetworkStatusEgressDisabled
+	if egressEnabled {
+		status = containerappssessionpools.SessionNetworkStatusEgressEnabled
+	}
+
+	return &containerappssessionpools.SessionNetworkConfiguration{
+		Status: pointer.To(status),
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappssessionpools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppSessionPoolResource struct{}
+
+func TestAccContainerAppSessionPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pool_management_endpoint").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppSessionPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppSessionPool_customContainer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("secret"),
+	})
+}
+
+func TestAccContainerAppSessionPool_customContainerUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("secret"),
+		{
+			Config: r.customContainerUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("secret"),
+		{
+			Config: r.customContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("secret"),
+	})
+}
+
+func (r ContainerAppSessionPoolResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := containerappssessionpools.ParseSessionPoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.SessionPoolsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerAppSessionPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-CASP-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_container_app_session_pool" "test" {
+  name                    = "acctest-sp%[1]d"
+  resource_group_name     = azurerm_resource_group.test.name
+  location                = azurerm_resource_group.test.location
+  container_type          = "PythonLTS"
+  max_concurrent_sessions = 5
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ContainerAppSessionPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_session_pool" "import" {
+  name                    = azurerm_container_app_session_pool.test.name
+  resource_group_name     = azurerm_container_app_session_pool.test.resource_group_name
+  location                = azurerm_container_app_session_pool.test.location
+  container_type          = azurerm_container_app_session_pool.test.container_type
+  max_concurrent_sessions = azurerm_container_app_session_pool.test.max_concurrent_sessions
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppSessionPoolResource) customContainer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_container_app_session_pool" "test" {
+  name                         = "acctest-sp%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  container_type               = "CustomContainer"
+  max_concurrent_sessions      = 5
+  ready_session_instances      = 1
+
+  session_lifecycle {
+    type                       = "Timed"
+    cooldown_period_in_seconds = 300
+  }
+
+  secret {
+    name  = "api-key"
+    value = "secret-value"
+  }
+
+  custom_container_template {
+    ingress_target_port = 80
+
+    container {
+      name   = "session"
+      image  = "mcr.microsoft.com/k8se/quickstart:latest"
+      cpu    = 0.25
+      memory = "0.5Gi"
+
+      env {
+        name        = "API_KEY"
+        secret_name = "api-key"
+      }
+    }
+  }
+
+  tags = {
+    Environment = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppSessionPoolResource) customContainerUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_container_app_session_pool" "test" {
+  name                         = "acctest-sp%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  container_type               = "CustomContainer"
+  max_concurrent_sessions      = 10
+  ready_session_instances      = 2
+  network_egress_enabled       = true
+
+  session_lifecycle {
+    type                        = "OnContainerExit"
+    max_alive_period_in_seconds = 3600
+  }
+
+  secret {
+    name  = "api-key"
+    value = "updated-secret-value"
+  }
+
+  custom_container_template {
+    ingress_target_port = 8080
+
+    container {
+      name    = "session"
+      image   = "mcr.microsoft.com/k8se/quickstart:latest"
+      cpu     = 0.5
+      memory  = "1Gi"
+      command = ["/bin/sh"]
+      args    = ["-c", "sleep 3600"]
+
+      env {
+        name        = "API_KEY"
+        secret_name = "api-key"
+      }
+
+      env {
+        name  = "MODE"
+        value = "sandbox"
+      }
+    }
+  }
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  managed_identity_setting {
+    identity  = azurerm_user_assigned_identity.test.id
+    lifecycle = "Main"
+  }
+
+  tags = {
+    Environment = "Test"
+    Updated     = "true"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppSessionPoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-CASP-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestCASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_container_app_environment" "test" {
+  name                       = "acctest-CAEnv%[1]d"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+
+  workload_profile {
+    name                  = "Consumption"
+    workload_profile_type = "Consumption"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,618 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappsauthconfigs"
+	appServiceHelpers "github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// The identity provider blocks for Container Apps share their shape with the App Service `auth_settings_v2` blocks,
+// so the schemas and models are reused from there, with the cross-field references updated to match this schema.
+
+var authConfigIdentityProviders = []string{
+	"active_directory",
+	"apple",
+	"custom_oidc",
+	"github",
+	"google",
+}
+
+func authConfigIdentityProviderSchema(input *pluginsdk.Schema) *pluginsdk.Schema {
+	input.AtLeastOneOf = authConfigIdentityProviders
+	rewriteAuthV2ConflictsWith(input)
+
+	return input
+}
+
+func rewriteAuthV2ConflictsWith(input *pluginsdk.Schema) {
+	resource, ok := input.Elem.(*pluginsdk.Resource)
+	if !ok {
+		return
+	}
+
+	for _, v := range resource.Schema {
+		for i, path := range v.ConflictsWith {
+			path = strings.TrimPrefix(path, "auth_settings_v2.0.")
+			v.ConflictsWith[i] = strings.Replace(path, "_v2.0.", ".0.", 1)
+		}
+		rewriteAuthV2ConflictsWith(v)
+	}
+}
+
+func AuthConfigActiveDirectorySchema() *pluginsdk.Schema {
+	return authConfigIdentityProviderSchema(appServiceHelpers.AadAuthV2SettingsSchema())
+}
+
+func AuthConfigAppleSchema() *pluginsdk.Schema {
+	return authConfigIdentityProviderSchema(appServiceHelpers.AppleAuthV2SettingsSchema())
+}
+
+func AuthConfigGitHubSchema() *pluginsdk.Schema {
+	return authConfigIdentityProviderSchema(appServiceHelpers.GithubAuthV2SettingsSchema())
+}
+
+func AuthConfigGoogleSchema() *pluginsdk.Schema {
+	return authConfigIdentityProviderSchema(appServiceHelpers.GoogleAuthV2SettingsSchema())
+}
+
+// AuthConfigCustomOIDC differs from the App Service equivalent since Container App secret names cannot be derived
+// from the provider name, so the secret holding the client secret must be specified.
+type AuthConfigCustomOIDC struct {
+	Name                        string   `tfschema:"name"`
+	ClientId                    string   `tfschema:"client_id"`
+	ClientSecretName            string   `tfschema:"client_secret_name"`
+	OpenIDConfigurationEndpoint string   `tfschema:"openid_configuration_endpoint"`
+	NameClaimType               string   `tfschema:"name_claim_type"`
+	Scopes                      []string `tfschema:"scopes"`
+}
+
+func AuthConfigCustomOIDCSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeList,
+		Optional:     true,
+		AtLeastOneOf: authConfigIdentityProviders,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Custom OIDC Authentication Provider.",
+				},
+
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the Client to use to authenticate with this Custom OIDC.",
+				},
+
+				"client_secret_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.SecretName,
+					Description:  "The name of the Container App secret that contains the client secret for this Custom OIDC Client.",
+				},
+
+				"openid_configuration_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+					Description:  "The endpoint that contains all the configuration endpoints for this Custom OIDC provider.",
+				},
+
+				"name_claim_type": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the claim that contains the users name.",
+				},
+
+				"scopes": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The list of the scopes that should be requested while authenticating.",
+				},
+			},
+		},
+	}
+}
+
+type AuthConfigLogin struct {
+	LogoutEndpoint                string   `tfschema:"logout_endpoint"`
+	PreserveURLFragmentsForLogins bool     `tfschema:"preserve_url_fragments_for_logins"`
+	AllowedExternalRedirectURLs   []string `tfschema:"allowed_external_redirect_urls"`
+	CookieExpirationConvention    string   `tfschema:"cookie_expiration_convention"`
+	CookieExpirationTime          string   `tfschema:"cookie_expiration_time"`
+	ValidateNonce                 bool     `tfschema:"validate_nonce"`
+	NonceExpirationTime           string   `tfschema:"nonce_expiration_time"`
+}
+
+func AuthConfigLoginSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"logout_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The endpoint to which logout requests should be made.",
+				},
+
+				"preserve_url_fragments_for_logins": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the fragments from the request be preserved after the login request is made. Defaults to `false`.",
+				},
+
+				"allowed_external_redirect_urls": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "External URLs that can be redirected to as part of logging in or logging out of the app.",
+				},
+
+				"cookie_expiration_convention": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(containerappsauthconfigs.CookieExpirationConventionFixedTime),
+					ValidateFunc: validation.StringInSlice(containerappsauthconfigs.PossibleValuesForCookieExpirationConvention(), false),
+					Description:  "The method by which cookies expire. Defaults to `FixedTime`.",
+				},
+
+				"cookie_expiration_time": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "08:00:00",
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The time after the request is made when the session cookie should expire. Defaults to `08:00:00`.",
+				},
+
+				"validate_nonce": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Should the nonce be validated while completing the login flow. Defaults to `true`.",
+				},
+
+				"nonce_expiration_time": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "00:05:00",
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The time after the request is made when the nonce should expire. Defaults to `00:05:00`.",
+				},
+			},
+		},
+	}
+}
+
+type AuthConfigTokenStore struct {
+	SasURLSecretName      string  `tfschema:"sas_url_secret_name"`
+	RefreshExtensionHours float64 `tfschema:"refresh_extension_hours"`
+}
+
+func AuthConfigTokenStoreSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"sas_url_secret_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.SecretName,
+					Description:  "The name of the Container App secret containing the SAS URL of the Blob Storage Container used to store the tokens.",
+				},
+
+				"refresh_extension_hours": {
+					Type:         pluginsdk.TypeFloat,
+					Optional:     true,
+					Default:      72,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description:  "The number of hours after session token expiration that a session token can be used to call the token refresh API. Defaults to `72` hours.",
+				},
+			},
+		},
+	}
+}
+
+func ExpandAuthConfigActiveDirectory(input []appServiceHelpers.AadAuthV2Settings) *containerappsauthconfigs.AzureActiveDirectory {
+	if len(input) == 0 {
+		return &containerappsauthconfigs.AzureActiveDirectory{
+			Enabled: pointer.To(false),
+		}
+	}
+
+	aad := input[0]
+	result := &containerappsauthconfigs.AzureActiveDirectory{
+		Enabled: pointer.To(true),
+		Registration: &containerappsauthconfigs.AzureActiveDirectoryRegistration{
+			OpenIdIssuer: pointer.To(aad.TenantAuthURI),
+			ClientId:     pointer.To(aad.ClientId),
+		},
+		Login: &containerappsauthconfigs.AzureActiveDirectoryLogin{
+			DisableWWWAuthenticate: pointer.To(aad.DisableWWWAuth),
+		},
+		Validation: &containerappsauthconfigs.AzureActiveDirectoryValidation{},
+	}
+
+	if aad.ClientSecretSettingName != "" {
+		result.Registration.ClientSecretSettingName = pointer.To(aad.ClientSecretSettingName)
+	}
+
+	if aad.ClientSecretCertificateThumbprint != "" {
+		result.Registration.ClientSecretCertificateThumbprint = pointer.To(aad.ClientSecretCertificateThumbprint)
+	}
+
+	if len(aad.LoginParameters) > 0 {
+		params := make([]string, 0)
+		for k, v := range aad.LoginParameters {
+			params = append(params, fmt.Sprintf("%s=%s", k, v))
+		}
+		result.Login.LoginParameters = &params
+	}
+
+	if len(aad.JWTAllowedGroups) > 0 || len(aad.JWTAllowedClientApps) > 0 {
+		result.Validation.JwtClaimChecks = &containerappsauthconfigs.JwtClaimChecks{}
+		if len(aad.JWTAllowedGroups) > 0 {
+			result.Validation.JwtClaimChecks.AllowedGroups = pointer.To(aad.JWTAllowedGroups)
+		}
+		if len(aad.JWTAllowedClientApps) > 0 {
+			result.Validation.JwtClaimChecks.AllowedClientApplications = pointer.To(aad.JWTAllowedClientApps)
+		}
+	}
+
+	if len(aad.AllowedGroups) > 0 || len(aad.AllowedIdentities) > 0 || len(aad.AllowedApplications) > 0 {
+		result.Validation.DefaultAuthorizationPolicy = &containerappsauthconfigs.DefaultAuthorizationPolicy{}
+		if len(aad.AllowedApplications) > 0 {
+			result.Validation.DefaultAuthorizationPolicy.AllowedApplications = pointer.To(aad.AllowedApplications)
+		}
+		if len(aad.AllowedGroups) > 0 || len(aad.AllowedIdentities) > 0 {
+			result.Validation.DefaultAuthorizationPolicy.AllowedPrincipals = &containerappsauthconfigs.AllowedPrincipals{}
+			if len(aad.AllowedGroups) > 0 {
+				result.Validation.DefaultAuthorizationPolicy.AllowedPrincipals.Groups = pointer.To(aad.AllowedGroups)
+			}
+			if len(aad.AllowedIdentities) > 0 {
+				result.Validation.DefaultAuthorizationPolicy.AllowedPrincipals.Identities = pointer.To(aad.AllowedIdentities)
+			}
+		}
+	}
+
+	if len(aad.AllowedAudiences) > 0 {
+		result.Validation.AllowedAudiences = pointer.To(aad.AllowedAudiences)
+	}
+
+	return result
+}
+
+func FlattenAuthConfigActiveDirectory(input *containerappsauthconfigs.AzureActiveDirectory) []appServiceHelpers.AadAuthV2Settings {
+	if input == nil || !pointer.From(input.Enabled) {
+		return []appServiceHelpers.AadAuthV2Settings{}
+	}
+
+	result := appServiceHelpers.AadAuthV2Settings{}
+
+	if reg := input.Registration; reg != nil {
+		result.TenantAuthURI = pointer.From(reg.OpenIdIssuer)
+		result.ClientId = pointer.From(reg.ClientId)
+		result.ClientSecretSettingName = pointer.From(reg.ClientSecretSettingName)
+		result.ClientSecretCertificateThumbprint = pointer.From(reg.ClientSecretCertificateThumbprint)
+	}
+
+	if login := input.Login; login != nil {
+		result.DisableWWWAuth = pointer.From(login.DisableWWWAuthenticate)
+		if login.LoginParameters != nil {
+			loginParams := make(map[string]string)
+			for _, v := range *login.LoginParameters {
+				parts := strings.SplitN(v, "=", 2)
+				if len(parts) == 2 && parts[0] != "" {
+					loginParams[parts[0]] = parts[1]
+				}
+			}
+			result.LoginParameters = loginParams
+		}
+	}
+
+	if v := input.Validation; v != nil {
+		result.AllowedAudiences = pointer.From(v.AllowedAudiences)
+		if jwt := v.JwtClaimChecks; jwt != nil {
+			result.JWTAllowedGroups = pointer.From(jwt.AllowedGroups)
+			result.JWTAllowedClientApps = pointer.From(jwt.AllowedClientApplications)
+		}
+		if policy := v.DefaultAuthorizationPolicy; policy != nil {
+			result.AllowedApplications = pointer.From(policy.AllowedApplications)
+			if principals := policy.AllowedPrincipals; principals != nil {
+				result.AllowedGroups = pointer.From(principals.Groups)
+				result.AllowedIdentities = pointer.From(principals.Identities)
+			}
+		}
+	}
+
+	return []appServiceHelpers.AadAuthV2Settings{result}
+}
+
+func ExpandAuthConfigApple(input []appServiceHelpers.AppleAuthV2Settings) *containerappsauthconfigs.Apple {
+	if len(input) == 0 {
+		return &containerappsauthconfigs.Apple{
+			Enabled: pointer.To(false),
+		}
+	}
+
+	apple := input[0]
+	return &containerappsauthconfigs.Apple{
+		Enabled: pointer.To(true),
+		Registration: &containerappsauthconfigs.AppleRegistration{
+			ClientId:                pointer.To(apple.ClientId),
+			ClientSecretSettingName: pointer.To(apple.ClientSecretSettingName),
+		},
+		Login: &containerappsauthconfigs.LoginScopes{
+			Scopes: pointer.To(apple.LoginScopes),
+		},
+	}
+}
+
+func FlattenAuthConfigApple(input *containerappsauthconfigs.Apple) []appServiceHelpers.AppleAuthV2Settings {
+	if input == nil || !pointer.From(input.Enabled) {
+		return []appServiceHelpers.AppleAuthV2Settings{}
+	}
+
+	result := appServiceHelpers.AppleAuthV2Settings{}
+	if reg := input.Registration; reg != nil {
+		result.ClientId = pointer.From(reg.ClientId)
+		result.ClientSecretSettingName = pointer.From(reg.ClientSecretSettingName)
+	}
+	if login := input.Login; login != nil {
+		result.LoginScopes = pointer.From(login.Scopes)
+	}
+
+	return []appServiceHelpers.AppleAuthV2Settings{result}
+}
+
+func ExpandAuthConfigGitHub(input []appServiceHelpers.GithubAuthV2Settings) *containerappsauthconfigs.GitHub {
+	if len(input) == 0 {
+		return &containerappsauthconfigs.GitHub{
+			Enabled: pointer.To(false),
+		}
+	}
+
+	github := input[0]
+	return &containerappsauthconfigs.GitHub{
+		Enabled: pointer.To(true),
+		Registration: &containerappsauthconfigs.ClientRegistration{
+			ClientId:                pointer.To(github.ClientId),
+			ClientSecretSettingName: pointer.To(github.ClientSecretSettingName),
+		},
+		Login: &containerappsauthconfigs.LoginScopes{
+			Scopes: pointer.To(github.LoginScopes),
+		},
+	}
+}
+
+func FlattenAuthConfigGitHub(input *containerappsauthconfigs.GitHub) []appServiceHelpers.GithubAuthV2Settings {
+	if input == nil || !pointer.From(input.Enabled) {
+		return []appServiceHelpers.GithubAuthV2Settings{}
+	}
+
+	result := appServiceHelpers.GithubAuthV2Settings{}
+	if reg := input.Registration; reg != nil {
+		result.ClientId = pointer.From(reg.ClientId)
+		result.ClientSecretSettingName = pointer.From(reg.ClientSecretSettingName)
+	}
+	if login := input.Login; login != nil {
+		result.LoginScopes = pointer.From(login.Scopes)
+	}
+
+	return []appServiceHelpers.GithubAuthV2Settings{result}
+}
+
+func ExpandAuthConfigGoogle(input []appServiceHelpers.GoogleAuthV2Settings) *containerappsauthconfigs.Google {
+	if len(input) == 0 {
+		return &containerappsauthconfigs.Google{
+			Enabled: pointer.To(false),
+		}
+	}
+
+	google := input[0]
+	result := &containerappsauthconfigs.Google{
+		Enabled: pointer.To(true),
+		Registration: &containerappsauthconfigs.ClientRegistration{
+			ClientId:                pointer.To(google.ClientId),
+			ClientSecretSettingName: pointer.To(google.ClientSecretSettingName),
+		},
+		Login: &containerappsauthconfigs.LoginScopes{
+			Scopes: pointer.To(google.LoginScopes),
+		},
+	}
+
+	if len(google.AllowedAudiences) > 0 {
+		result.Validation = &containerappsauthconfigs.AllowedAudiencesValidation{
+			AllowedAudiences: pointer.To(google.AllowedAudiences),
+		}
+	}
+
+	return result
+}
+
+func FlattenAuthConfigGoogle(input *containerappsauthconfigs.Google) []appServiceHelpers.GoogleAuthV2Settings {
+	if input == nil || !pointer.From(input.Enabled) {
+		return []appServiceHelpers.GoogleAuthV2Settings{}
+	}
+
+	result := appServiceHelpers.GoogleAuthV2Settings{}
+	if reg := input.Registration; reg != nil {
+		result.ClientId = pointer.From(reg.ClientId)
+		result.ClientSecretSettingName = pointer.From(reg.ClientSecretSettingName)
+	}
+	if login := input.Login; login != nil {
+		result.LoginScopes = pointer.From(login.Scopes)
+	}
+	if v := input.Validation; v != nil {
+		result.AllowedAudiences = pointer.From(v.AllowedAudiences)
+	}
+
+	return []appServiceHelpers.GoogleAuthV2Settings{result}
+}
+
+func ExpandAuthConfigCustomOIDC(input []AuthConfigCustomOIDC) *map[string]containerappsauthconfigs.CustomOpenIdConnectProvider {
+	result := make(map[string]containerappsauthconfigs.CustomOpenIdConnectProvider)
+	for _, v := range input {
+		provider := containerappsauthconfigs.CustomOpenIdConnectProvider{
+			Enabled: pointer.To(true),
+			Registration: &containerappsauthconfigs.OpenIdConnectRegistration{
+				ClientId: pointer.To(v.ClientId),
+				ClientCredential: &containerappsauthconfigs.OpenIdConnectClientCredential{
+					Method:                  pointer.To(containerappsauthconfigs.ClientCredentialMethodClientSecretPost),
+					ClientSecretSettingName: pointer.To(v.ClientSecretName),
+				},
+				OpenIdConnectConfiguration: &containerappsauthconfigs.OpenIdConnectConfig{
+					WellKnownOpenIdConfiguration: pointer.To(v.OpenIDConfigurationEndpoint),
+				},
+			},
+			Login: &containerappsauthconfigs.OpenIdConnectLogin{
+				Scopes: pointer.To(v.Scopes),
+			},
+		}
+
+		if v.NameClaimType != "" {
+			provider.Login.NameClaimType = pointer.To(v.NameClaimType)
+		}
+
+		result[v.Name] = provider
+	}
+
+	return &result
+}
+
+func FlattenAuthConfigCustomOIDC(input *map[string]containerappsauthconfigs.CustomOpenIdConnectProvider) []AuthConfigCustomOIDC {
+	if input == nil {
+		return []AuthConfigCustomOIDC{}
+	}
+
+	result := make([]AuthConfigCustomOIDC, 0)
+	for k, v := range *input {
+		if !pointer.From(v.Enabled) {
+			continue
+		}
+
+		provider := AuthConfigCustomOIDC{
+			Name: k,
+		}
+
+		if reg := v.Registration; reg != nil {
+			provider.ClientId = pointer.From(reg.ClientId)
+			if reg.ClientCredential != nil {
+				provider.ClientSecretName = pointer.From(reg.ClientCredential.ClientSecretSettingName)
+			}
+			if config := reg.OpenIdConnectConfiguration; config != nil {
+				provider.OpenIDConfigurationEndpoint = pointer.From(config.WellKnownOpenIdConfiguration)
+			}
+		}
+
+		if login := v.Login; login != nil {
+			provider.NameClaimType = pointer.From(login.NameClaimType)
+			provider.Scopes = pointer.From(login.Scopes)
+		}
+
+		result = append(result, provider)
+	}
+
+	return result
+}
+
+func ExpandAuthConfigLogin(input []AuthConfigLogin, tokenStore []AuthConfigTokenStore) *containerappsauthconfigs.Login {
+	result := &containerappsauthconfigs.Login{
+		TokenStore: &containerappsauthconfigs.TokenStore{
+			Enabled: pointer.To(false),
+		},
+	}
+
+	if len(input) > 0 {
+		login := input[0]
+		result.PreserveURLFragmentsForLogins = pointer.To(login.PreserveURLFragmentsForLogins)
+		result.AllowedExternalRedirectURLs = pointer.To(login.AllowedExternalRedirectURLs)
+		result.CookieExpiration = &containerappsauthconfigs.CookieExpiration{
+			Convention:       pointer.ToEnum[containerappsauthconfigs.CookieExpirationConvention](login.CookieExpirationConvention),
+			TimeToExpiration: pointer.To(login.CookieExpirationTime),
+		}
+		result.Nonce = &containerappsauthconfigs.Nonce{
+			ValidateNonce:           pointer.To(login.ValidateNonce),
+			NonceExpirationInterval: pointer.To(login.NonceExpirationTime),
+		}
+
+		if login.LogoutEndpoint != "" {
+			result.Routes = &containerappsauthconfigs.LoginRoutes{
+				LogoutEndpoint: pointer.To(login.LogoutEndpoint),
+			}
+		}
+	}
+
+	if len(tokenStore) > 0 {
+		result.TokenStore = &containerappsauthconfigs.TokenStore{
+			Enabled: pointer.To(true),
+			AzureBlobStorage: &containerappsauthconfigs.BlobStorageTokenStore{
+				SasURLSettingName: tokenStore[0].SasURLSecretName,
+			},
+			TokenRefreshExtensionHours: pointer.To(tokenStore[0].RefreshExtensionHours),
+		}
+	}
+
+	return result
+}
+
+func FlattenAuthConfigLogin(input *containerappsauthconfigs.Login) ([]AuthConfigLogin, []AuthConfigTokenStore) {
+	if input == nil {
+		return []AuthConfigLogin{}, []AuthConfigTokenStore{}
+	}
+
+	login := AuthConfigLogin{
+		PreserveURLFragmentsForLogins: pointer.From(input.PreserveURLFragmentsForLogins),
+		AllowedExternalRedirectURLs:   pointer.From(input.AllowedExternalRedirectURLs),
+	}
+
+	if routes := input.Routes; routes != nil {
+		login.LogoutEndpoint = pointer.From(routes.LogoutEndpoint)
+	}
+
+	if cookie := input.CookieExpiration; cookie != nil {
+		login.CookieExpirationConvention = pointer.FromEnum(cookie.Convention)
+		login.CookieExpirationTime = pointer.From(cookie.TimeToExpiration)
+	}
+
+	if nonce := input.Nonce; nonce != nil {
+		login.ValidateNonce = pointer.From(nonce.ValidateNonce)
+		login.NonceExpirationTime = pointer.From(nonce.NonceExpirationInterval)
+	}
+
+	tokenStore := make([]AuthConfigTokenStore, 0)
+	if ts := input.TokenStore; ts != nil && pointer.From(ts.Enabled) && ts.AzureBlobStorage != nil {
+		tokenStore = append(tokenStore, AuthConfigTokenStore{
+			SasURLSecretName:      ts.AzureBlobStorage.SasURLSettingName,
+			RefreshExtensionHours: pointer.From(ts.TokenRefreshExtensionHours),
+		})
+	}
+
+	return []AuthConfigLogin{login}, tokenStore
+}
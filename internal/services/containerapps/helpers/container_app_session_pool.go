@@ -0,0 +1,442 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerappssessionpools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SessionPoolCustomContainerTemplate struct {
+	Containers        []SessionPoolContainer `tfschema:"container"`
+	IngressTargetPort int64                  `tfschema:"ingress_target_port"`
+	Registry          []Registry             `tfschema:"registry"`
+}
+
+type SessionPoolContainer struct {
+	Name    string            `tfschema:"name"`
+	Image   string            `tfschema:"image"`
+	CPU     float64           `tfschema:"cpu"`
+	Memory  string            `tfschema:"memory"`
+	Env     []ContainerEnvVar `tfschema:"env"`
+	Args    []string          `tfschema:"args"`
+	Command []string          `tfschema:"command"`
+}
+
+type SessionPoolLifecycle struct {
+	Type                    string `tfschema:"type"`
+	CooldownPeriodInSeconds int64  `tfschema:"cooldown_period_in_seconds"`
+	MaxAlivePeriodInSeconds int64  `tfschema:"max_alive_period_in_seconds"`
+}
+
+type SessionPoolManagedIdentitySetting struct {
+	Identity  string `tfschema:"identity"`
+	Lifecycle string `tfschema:"lifecycle"`
+}
+
+type SessionPoolSecret struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+func SessionPoolCustomContainerTemplateSchema() *pluginsdk.Schema {
+	registry := ContainerAppRegistrySchema()
+	registry.MaxItems = 1
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"container": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validate.ContainerAppContainerName,
+								Description:  "The name of the container.",
+							},
+
+							"image": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The image to use to create the container.",
+							},
+
+							"cpu": {
+								Type:         pluginsdk.TypeFloat,
+								Required:     true,
+								ValidateFunc: validation.FloatAtLeast(0.1),
+								Description:  "The amount of vCPU to allocate to the container.",
+							},
+
+							"memory": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The amount of memory to allocate to the container.",
+							},
+
+							"env": ContainerEnvVarSchema(),
+
+							"args": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+								Description: "A list of args to pass to the container.",
+							},
+
+							"command": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+								Description: "A command to pass to the container to override the default. This is provided as a list of command line elements without spaces.",
+							},
+						},
+					},
+				},
+
+				"ingress_target_port": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IsPortNumber,
+					Description:  "The target port the session containers are listening on.",
+				},
+
+				"registry": registry,
+			},
+		},
+	}
+}
+
+func SessionPoolLifecycleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(containerappssessionpools.PossibleValuesForLifecycleType(), false),
+					Description:  "The lifecycle type of the sessions in this Session Pool.",
+				},
+
+				"cooldown_period_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of seconds a session is kept alive after its last use. Only applicable when `type` is `Timed`.",
+				},
+
+				"max_alive_period_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum number of seconds a session can be alive. Only applicable when `type` is `OnContainerExit`.",
+				},
+			},
+		},
+	}
+}
+
+func SessionPoolManagedIdentitySettingSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"identity": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.Any(
+						commonids.ValidateUserAssignedIdentityID,
+						validation.StringInSlice([]string{"system"}, false),
+					),
+					Description: "The ID of the User Assigned Identity, or `system` for the System Assigned Identity.",
+				},
+
+				"lifecycle": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(containerappssessionpools.IdentitySettingsLifeCycleNone),
+					ValidateFunc: validation.StringInSlice(containerappssessionpools.PossibleValuesForIdentitySettingsLifeCycle(), false),
+					Description:  "Where the managed identity is available within the sessions.",
+				},
+			},
+		},
+	}
+}
+
+func SessionPoolSecretsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:      pluginsdk.TypeSet,
+		Optional:  true,
+		Sensitive: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.SecretName,
+					Description:  "The secret name.",
+				},
+
+				"value": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The value for this secret.",
+				},
+			},
+		},
+	}
+}
+
+func ExpandSessionPoolCustomContainerTemplate(input []SessionPoolCustomContainerTemplate) (*containerappssessionpools.CustomContainerTemplate, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	template := input[0]
+	result := &containerappssessionpools.CustomContainerTemplate{
+		Containers: expandSessionPoolContainers(template.Containers),
+	}
+
+	if template.IngressTargetPort != 0 {
+		result.Ingress = &containerappssessionpools.SessionIngress{
+			TargetPort: pointer.To(template.IngressTargetPort),
+		}
+	}
+
+	if len(template.Registry) > 0 {
+		registry := template.Registry[0]
+		if err := ValidateContainerAppRegistry(registry); err != nil {
+			return nil, err
+		}
+
+		result.RegistryCredentials = &containerappssessionpools.SessionRegistryCredentials{
+			Identity:          pointer.To(registry.Identity),
+			PasswordSecretRef: pointer.To(registry.PasswordSecretRef),
+			Server:            pointer.To(registry.Server),
+			Username:          pointer.To(registry.UserName),
+		}
+	}
+
+	return result, nil
+}
+
+func FlattenSessionPoolCustomContainerTemplate(input *containerappssessionpools.CustomContainerTemplate) []SessionPoolCustomContainerTemplate {
+	if input == nil {
+		return []SessionPoolCustomContainerTemplate{}
+	}
+
+	result := SessionPoolCustomContainerTemplate{
+		Containers: flattenSessionPoolContainers(input.Containers),
+		Registry:   []Registry{},
+	}
+
+	if ingress := input.Ingress; ingress != nil {
+		result.IngressTargetPort = pointer.From(ingress.TargetPort)
+	}
+
+	if registry := input.RegistryCredentials; registry != nil {
+		result.Registry = []Registry{
+			{
+				Identity:          pointer.From(registry.Identity),
+				PasswordSecretRef: pointer.From(registry.PasswordSecretRef),
+				Server:            pointer.From(registry.Server),
+				UserName:          pointer.From(registry.Username),
+			},
+		}
+	}
+
+	return []SessionPoolCustomContainerTemplate{result}
+}
+
+func expandSessionPoolContainers(input []SessionPoolContainer) *[]containerappssessionpools.SessionContainer {
+	result := make([]containerappssessionpools.SessionContainer, 0)
+	for _, v := range input {
+		container := containerappssessionpools.SessionContainer{
+			Name:  pointer.To(v.Name),
+			Image: pointer.To(v.Image),
+			Resources: &containerappssessionpools.SessionContainerResources{
+				Cpu:    pointer.To(v.CPU),
+				Memory: pointer.To(v.Memory),
+			},
+			Env: expandSessionPoolContainerEnvVar(v.Env),
+		}
+
+		if len(v.Args) > 0 {
+			container.Args = pointer.To(v.Args)
+		}
+
+		if len(v.Command) > 0 {
+			container.Command = pointer.To(v.Command)
+		}
+
+		result = append(result, container)
+	}
+
+	return &result
+}
+
+func flattenSessionPoolContainers(input *[]containerappssessionpools.SessionContainer) []SessionPoolContainer {
+	if input == nil {
+		return []SessionPoolContainer{}
+	}
+
+	result := make([]SessionPoolContainer, 0)
+	for _, v := range *input {
+		container := SessionPoolContainer{
+			Name:    pointer.From(v.Name),
+			Image:   pointer.From(v.Image),
+			Args:    pointer.From(v.Args),
+			Command: pointer.From(v.Command),
+			Env:     flattenSessionPoolContainerEnvVar(v.Env),
+		}
+
+		if resources := v.Resources; resources != nil {
+			container.CPU = pointer.From(resources.Cpu)
+			container.Memory = pointer.From(resources.Memory)
+		}
+
+		result = append(result, container)
+	}
+
+	return result
+}
+
+func expandSessionPoolContainerEnvVar(input []ContainerEnvVar) *[]containerappssessionpools.EnvironmentVar {
+	envs := make([]containerappssessionpools.EnvironmentVar, 0)
+	for _, v := range input {
+		env := containerappssessionpools.EnvironmentVar{
+			Name: pointer.To(v.Name),
+		}
+		if v.SecretReference != "" {
+			env.SecretRef = pointer.To(v.SecretReference)
+		} else {
+			env.Value = pointer.To(v.Value)
+		}
+
+		envs = append(envs, env)
+	}
+
+	return &envs
+}
+
+func flattenSessionPoolContainerEnvVar(input *[]containerappssessionpools.EnvironmentVar) []ContainerEnvVar {
+	if input == nil || len(*input) == 0 {
+		return []ContainerEnvVar{}
+	}
+
+	result := make([]ContainerEnvVar, 0)
+	for _, v := range *input {
+		result = append(result, ContainerEnvVar{
+			Name:            pointer.From(v.Name),
+			SecretReference: pointer.From(v.SecretRef),
+			Value:           pointer.From(v.Value),
+		})
+	}
+
+	return result
+}
+
+func ExpandSessionPoolLifecycle(input []SessionPoolLifecycle) *containerappssessionpools.DynamicPoolConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	lifecycle := input[0]
+	config := &containerappssessionpools.LifecycleConfiguration{
+		LifecycleType: pointer.ToEnum[containerappssessionpools.LifecycleType](lifecycle.Type),
+	}
+
+	if lifecycle.CooldownPeriodInSeconds != 0 {
+		config.CooldownPeriodInSeconds = pointer.To(lifecycle.CooldownPeriodInSeconds)
+	}
+
+	if lifecycle.MaxAlivePeriodInSeconds != 0 {
+		config.MaxAlivePeriodInSeconds = pointer.To(lifecycle.MaxAlivePeriodInSeconds)
+	}
+
+	return &containerappssessionpools.DynamicPoolConfiguration{
+		LifecycleConfiguration: config,
+	}
+}
+
+func FlattenSessionPoolLifecycle(input *containerappssessionpools.DynamicPoolConfiguration) []SessionPoolLifecycle {
+	if input == nil || input.LifecycleConfiguration == nil {
+		return []SessionPoolLifecycle{}
+	}
+
+	config := input.LifecycleConfiguration
+	return []SessionPoolLifecycle{
+		{
+			Type:                    pointer.FromEnum(config.LifecycleType),
+			CooldownPeriodInSeconds: pointer.From(config.CooldownPeriodInSeconds),
+			MaxAlivePeriodInSeconds: pointer.From(config.MaxAlivePeriodInSeconds),
+		},
+	}
+}
+
+func ExpandSessionPoolManagedIdentitySettings(input []SessionPoolManagedIdentitySetting) *[]containerappssessionpools.ManagedIdentitySetting {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]containerappssessionpools.ManagedIdentitySetting, 0)
+	for _, v := range input {
+		result = append(result, containerappssessionpools.ManagedIdentitySetting{
+			Identity:  v.Identity,
+			Lifecycle: pointer.ToEnum[containerappssessionpools.IdentitySettingsLifeCycle](v.Lifecycle),
+		})
+	}
+
+	return &result
+}
+
+func FlattenSessionPoolManagedIdentitySettings(input *[]containerappssessionpools.ManagedIdentitySetting) []SessionPoolManagedIdentitySetting {
+	if input == nil {
+		return []SessionPoolManagedIdentitySetting{}
+	}
+
+	result := make([]SessionPoolManagedIdentitySetting, 0)
+	for _, v := range *input {
+		result = append(result, SessionPoolManagedIdentitySetting{
+			Identity:  v.Identity,
+			Lifecycle: pointer.FromEnum(v.Lifecycle),
+		})
+	}
+
+	return result
+}
+
+func ExpandSessionPoolSecrets(input []SessionPoolSecret) *[]containerappssessionpools.SessionPoolSecret {
+	result := make([]containerappssessionpools.SessionPoolSecret, 0)
+	for _, v := range input {
+		result = append(result, containerappssessionpools.SessionPoolSecret{
+			Name:  pointer.To(v.Name),
+			Value: pointer.To(v.Value),
+		})
+	}
+
+	return &result
+}
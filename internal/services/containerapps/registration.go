@@ -44,12 +44,15 @@ func (r Registration) Resources() []sdk.Resource {
 		ContainerAppEnvironmentCertificateResource{},
 		ContainerAppEnvironmentCustomDomainResource{},
 		ContainerAppEnvironmentDaprComponentResource{},
+		ContainerAppEnvironmentJavaComponentResource{},
 		ContainerAppEnvironmentManagedCertificateResource{},
 		ContainerAppEnvironmentResource{},
 		ContainerAppEnvironmentStorageResource{},
 		ContainerAppResource{},
+		ContainerAppAuthConfigResource{},
 		ContainerAppCustomDomainResource{},
 		ContainerAppJobResource{},
+		ContainerAppSessionPoolResource{},
 	}
 }
 
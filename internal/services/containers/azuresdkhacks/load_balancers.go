@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+)
+
+// This `azuresdkhack` only exists because `go-azure-sdk` does not currently include the `managedClusters/loadBalancers`
+// resource, which is only available in the Preview API versions of `Microsoft.ContainerService`.
+// Once this is available within a Stable API version this can be replaced by the generated SDK.
+
+const loadBalancersApiVersion = "2025-05-02-preview"
+
+type LoadBalancersClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewLoadBalancersClientWithBaseURI(sdkApi environments.Api) (*LoadBalancersClient, error) {
+	c, err := resourcemanager.NewClient(sdkApi, "loadbalancers", loadBalancersApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating LoadBalancersClient: %+v", err)
+	}
+
+	return &LoadBalancersClient{
+		Client: c,
+	}, nil
+}
+
+type LoadBalancer struct {
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *LoadBalancerProperties `json:"properties,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
+
+type LoadBalancerProperties struct {
+	AllowServicePlacement    *bool          `json:"allowServicePlacement,omitempty"`
+	NodeSelector             *LabelSelector `json:"nodeSelector,omitempty"`
+	PrimaryAgentPoolName     string         `json:"primaryAgentPoolName"`
+	ProvisioningState        *string        `json:"provisioningState,omitempty"`
+	ServiceLabelSelector     *LabelSelector `json:"serviceLabelSelector,omitempty"`
+	ServiceNamespaceSelector *LabelSelector `json:"serviceNamespaceSelector,omitempty"`
+}
+
+type LabelSelector struct {
+	MatchExpressions *[]LabelSelectorRequirement `json:"matchExpressions,omitempty"`
+	MatchLabels      *[]string                   `json:"matchLabels,omitempty"`
+}
+
+type LabelSelectorRequirement struct {
+	Key      *string   `json:"key,omitempty"`
+	Operator *Operator `json:"operator,omitempty"`
+	Values   *[]string `json:"values,omitempty"`
+}
+
+type Operator string
+
+const (
+	OperatorDoesNotExist Operator = "DoesNotExist"
+	OperatorExists       Operator = "Exists"
+	OperatorIn           Operator = "In"
+	OperatorNotIn        Operator = "NotIn"
+)
+
+func PossibleValuesForOperator() []string {
+	return []string{
+		string(OperatorDoesNotExist),
+		string(OperatorExists),
+		string(OperatorIn),
+		string(OperatorNotIn),
+	}
+}
+
+type LoadBalancerGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *LoadBalancer
+}
+
+type LoadBalancerCreateOrUpdateOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *LoadBalancer
+}
+
+type LoadBalancerDeleteOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+func (c LoadBalancersClient) Get(ctx context.Context, id parse.KubernetesClusterLoadBalancerId) (result LoadBalancerGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model LoadBalancer
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c LoadBalancersClient) CreateOrUpdate(ctx context.Context, id parse.KubernetesClusterLoadBalancerId, input LoadBalancer) (result LoadBalancerCreateOrUpdateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (c LoadBalancersClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.KubernetesClusterLoadBalancerId, input LoadBalancer) error {
+	result, err := c.CreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+func (c LoadBalancersClient) Delete(ctx context.Context, id parse.KubernetesClusterLoadBalancerId) (result LoadBalancerDeleteOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (c LoadBalancersClient) DeleteThenPoll(ctx context.Context, id parse.KubernetesClusterLoadBalancerId) error {
+	result, err := c.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
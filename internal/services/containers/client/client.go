@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/azuresdkhacks"
 )
 
 type Client struct {
@@ -41,6 +42,7 @@ type Client struct {
 	KubernetesClustersClient                    *managedclusters.ManagedClustersClient
 	KubernetesExtensionsClient                  *extensions.ExtensionsClient
 	KubernetesFluxConfigurationClient           *fluxconfiguration.FluxConfigurationClient
+	KubernetesLoadBalancersClient               *azuresdkhacks.LoadBalancersClient
 	MaintenanceConfigurationsClient             *maintenanceconfigurations.MaintenanceConfigurationsClient
 	ServicesClient                              *containerservices.ContainerServicesClient
 	SnapshotClient                              *snapshots.SnapshotsClient
@@ -118,6 +120,12 @@ func NewContainersClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(fluxConfigurationClient.Client, o.Authorizers.ResourceManager)
 
+	kubernetesLoadBalancersClient, err := azuresdkhacks.NewLoadBalancersClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Kubernetes Load Balancers Client: %+v", err)
+	}
+	o.Configure(kubernetesLoadBalancersClient.Client, o.Authorizers.ResourceManager)
+
 	agentPoolsClient, err := agentpools.NewAgentPoolsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Agent Pools Client: %+v", err)
@@ -161,6 +169,7 @@ func NewContainersClient(o *common.ClientOptions) (*Client, error) {
 		KubernetesClustersClient:                    kubernetesClustersClient,
 		KubernetesExtensionsClient:                  kubernetesExtensionsClient,
 		KubernetesFluxConfigurationClient:           fluxConfigurationClient,
+		KubernetesLoadBalancersClient:               kubernetesLoadBalancersClient,
 		MaintenanceConfigurationsClient:             maintenanceConfigurationsClient,
 		ServicesClient:                              servicesClient,
 		SnapshotClient:                              snapshotClient,
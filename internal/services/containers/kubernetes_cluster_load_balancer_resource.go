@@ -0,0 +1,415 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-10-01/managedclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = KubernetesClusterLoadBalancerResource{}
+
+type KubernetesClusterLoadBalancerResource struct{}
+
+type KubernetesClusterLoadBalancerResourceModel struct {
+	Name                     string                                  `tfschema:"name"`
+	KubernetesClusterId      string                                  `tfschema:"kubernetes_cluster_id"`
+	PrimaryAgentPoolName     string                                  `tfschema:"primary_agent_pool_name"`
+	AllowServicePlacement    bool                                    `tfschema:"allow_service_placement"`
+	NodeSelector             []KubernetesClusterLoadBalancerSelector `tfschema:"node_selector"`
+	ServiceLabelSelector     []KubernetesClusterLoadBalancerSelector `tfschema:"service_label_selector"`
+	ServiceNamespaceSelector []KubernetesClusterLoadBalancerSelector `tfschema:"service_namespace_selector"`
+}
+
+type KubernetesClusterLoadBalancerSelector struct {
+	MatchLabels     map[string]string                                 `tfschema:"match_labels"`
+	MatchExpression []KubernetesClusterLoadBalancerSelectorExpression `tfschema:"match_expression"`
+}
+
+type KubernetesClusterLoadBalancerSelectorExpression struct {
+	Key      string   `tfschema:"key"`
+	Operator string   `tfschema:"operator"`
+	Values   []string `tfschema:"values"`
+}
+
+func (r KubernetesClusterLoadBalancerResource) ModelObject() interface{} {
+	return &KubernetesClusterLoadBalancerResourceModel{}
+}
+
+func (r KubernetesClusterLoadBalancerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerValidate.KubernetesClusterLoadBalancerID
+}
+
+func (r KubernetesClusterLoadBalancerResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_load_balancer"
+}
+
+func (r KubernetesClusterLoadBalancerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kubernetes_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateKubernetesClusterID,
+		},
+
+		"primary_agent_pool_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: containerValidate.KubernetesAgentPoolName,
+		},
+
+		"allow_service_placement": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"node_selector": kubernetesClusterLoadBalancerSelectorSchema(),
+
+		"service_label_selector": kubernetesClusterLoadBalancerSelectorSchema(),
+
+		"service_namespace_selector": kubernetesClusterLoadBalancerSelectorSchema(),
+	}
+}
+
+func (r KubernetesClusterLoadBalancerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesClusterLoadBalancerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesLoadBalancersClient
+			clustersClient := metadata.Client.Containers.KubernetesClustersClient
+
+			var model KubernetesClusterLoadBalancerResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := commonids.ParseKubernetesClusterID(model.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewKubernetesClusterLoadBalancerID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.ManagedClusterName, model.Name)
+
+			locks.ByID(clusterId.ID())
+			defer locks.UnlockByID(clusterId.ID())
+
+			cluster, err := clustersClient.Get(ctx, *clusterId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *clusterId, err)
+			}
+			if err := validateKubernetesClusterSupportsMultipleLoadBalancers(cluster.Model); err != nil {
+				return fmt.Errorf("validating %s: %+v", *clusterId, err)
+			}
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := azuresdkhacks.LoadBalancer{
+				Properties: &azuresdkhacks.LoadBalancerProperties{
+					AllowServicePlacement:    pointer.To(model.AllowServicePlacement),
+					NodeSelector:             expandKubernetesClusterLoadBalancerSelector(model.NodeSelector),
+					PrimaryAgentPoolName:     model.PrimaryAgentPoolName,
+					ServiceLabelSelector:     expandKubernetesClusterLoadBalancerSelector(model.ServiceLabelSelector),
+					ServiceNamespaceSelector: expandKubernetesClusterLoadBalancerSelector(model.ServiceNamespaceSelector),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterLoadBalancerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesLoadBalancersClient
+
+			id, err := parse.KubernetesClusterLoadBalancerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := KubernetesClusterLoadBalancerResourceModel{
+				Name:                id.LoadBalancerName,
+				KubernetesClusterId: commonids.NewKubernetesClusterID(id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.PrimaryAgentPoolName = props.PrimaryAgentPoolName
+					state.AllowServicePlacement = pointer.From(props.AllowServicePlacement)
+					state.NodeSelector = flattenKubernetesClusterLoadBalancerSelector(props.NodeSelector)
+					state.ServiceLabelSelector = flattenKubernetesClusterLoadBalancerSelector(props.ServiceLabelSelector)
+					state.ServiceNamespaceSelector = flattenKubernetesClusterLoadBalancerSelector(props.ServiceNamespaceSelector)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesClusterLoadBalancerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesLoadBalancersClient
+
+			id, err := parse.KubernetesClusterLoadBalancerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesClusterLoadBalancerResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId := commonids.NewKubernetesClusterID(id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName)
+			locks.ByID(clusterId.ID())
+			defer locks.UnlockByID(clusterId.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			payload := *existing.Model
+			// `provisioningState` is read-only and must not be sent back to the API
+			payload.Properties.ProvisioningState = nil
+
+			if metadata.ResourceData.HasChange("primary_agent_pool_name") {
+				payload.Properties.PrimaryAgentPoolName = model.PrimaryAgentPoolName
+			}
+
+			if metadata.ResourceData.HasChange("allow_service_placement") {
+				payload.Properties.AllowServicePlacement = pointer.To(model.AllowServicePlacement)
+			}
+
+			if metadata.ResourceData.HasChange("node_selector") {
+				payload.Properties.NodeSelector = expandKubernetesClusterLoadBalancerSelector(model.NodeSelector)
+			}
+
+			if metadata.ResourceData.HasChange("service_label_selector") {
+				payload.Properties.ServiceLabelSelector = expandKubernetesClusterLoadBalancerSelector(model.ServiceLabelSelector)
+			}
+
+			if metadata.ResourceData.HasChange("service_namespace_selector") {
+				payload.Properties.ServiceNamespaceSelector = expandKubernetesClusterLoadBalancerSelector(model.ServiceNamespaceSelector)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterLoadBalancerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesLoadBalancersClient
+
+			id, err := parse.KubernetesClusterLoadBalancerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			clusterId := commonids.NewKubernetesClusterID(id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName)
+			locks.ByID(clusterId.ID())
+			defer locks.UnlockByID(clusterId.ID())
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func kubernetesClusterLoadBalancerSelectorSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"match_labels": {
+					Type:     pluginsdk.TypeMap,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"match_expression": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"key": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"operator": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForOperator(), false),
+							},
+
+							"values": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateKubernetesClusterSupportsMultipleLoadBalancers checks the Kubernetes Cluster has been configured to use multiple
+// Standard Load Balancers, which requires the `NodeIP` backend pool type - otherwise the API rejects the request.
+func validateKubernetesClusterSupportsMultipleLoadBalancers(cluster *managedclusters.ManagedCluster) error {
+	if cluster == nil || cluster.Properties == nil || cluster.Properties.NetworkProfile == nil || cluster.Properties.NetworkProfile.LoadBalancerProfile == nil {
+		return fmt.Errorf("the Kubernetes Cluster must have a `load_balancer_profile` with `multiple_standard_load_balancers_enabled` set to `true`")
+	}
+
+	profile := cluster.Properties.NetworkProfile.LoadBalancerProfile
+	if !pointer.From(profile.EnableMultipleStandardLoadBalancers) {
+		return fmt.Errorf("`multiple_standard_load_balancers_enabled` must be set to `true` within the `load_balancer_profile` of the Kubernetes Cluster")
+	}
+
+	if pointer.From(profile.BackendPoolType) != managedclusters.BackendPoolTypeNodeIP {
+		return fmt.Errorf("`backend_pool_type` must be set to `%s` within the `load_balancer_profile` of the Kubernetes Cluster", string(managedclusters.BackendPoolTypeNodeIP))
+	}
+
+	return nil
+}
+
+func expandKubernetesClusterLoadBalancerSelector(input []KubernetesClusterLoadBalancerSelector) *azuresdkhacks.LabelSelector {
+	if len(input) == 0 {
+		return nil
+	}
+
+	selector := input[0]
+
+	matchLabels := make([]string, 0)
+	for k, v := range selector.MatchLabels {
+		matchLabels = append(matchLabels, fmt.Sprintf("%s=%s", k, v))
+	}
+
+	matchExpressions := make([]azuresdkhacks.LabelSelectorRequirement, 0)
+	for _, expression := range selector.MatchExpression {
+		matchExpressions = append(matchExpressions, azuresdkhacks.LabelSelectorRequirement{
+			Key:      pointer.To(expression.Key),
+			Operator: pointer.ToEnum[azuresdkhacks.Operator](expression.Operator),
+			Values:   pointer.To(expression.Values),
+		})
+	}
+
+	return &azuresdkhacks.LabelSelector{
+		MatchExpressions: pointer.To(matchExpressions),
+		MatchLabels:      pointer.To(matchLabels),
+	}
+}
+
+func flattenKubernetesClusterLoadBalancerSelector(input *azuresdkhacks.LabelSelector) []KubernetesClusterLoadBalancerSelector {
+	if input == nil {
+		return []KubernetesClusterLoadBalancerSelector{}
+	}
+
+	matchLabels := make(map[string]string)
+	for _, label := range pointer.From(input.MatchLabels) {
+		// the API returns these in the format `key=value`
+		k, v, _ := strings.Cut(label, "=")
+		matchLabels[k] = v
+	}
+
+	matchExpressions := make([]KubernetesClusterLoadBalancerSelectorExpression, 0)
+	for _, expression := range pointer.From(input.MatchExpressions) {
+		matchExpressions = append(matchExpressions, KubernetesClusterLoadBalancerSelectorExpression{
+			Key:      pointer.From(expression.Key),
+			Operator: pointer.FromEnum(expression.Operator),
+			Values:   pointer.From(expression.Values),
+		})
+	}
+
+	if len(matchLabels) == 0 && len(matchExpressions) == 0 {
+		return []KubernetesClusterLoadBalancerSelector{}
+	}
+
+	return []KubernetesClusterLoadBalancerSelector{
+		{
+			MatchLabels:     matchLabels,
+			MatchExpression: matchExpressions,
+		},
+	}
+}
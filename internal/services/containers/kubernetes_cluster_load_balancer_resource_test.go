@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KubernetesClusterLoadBalancerResource struct{}
+
+func TestAccKubernetesClusterLoadBalancer_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_load_balancer", "test")
+	r := KubernetesClusterLoadBalancerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterLoadBalancer_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_load_balancer", "test")
+	r := KubernetesClusterLoadBalancerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesClusterLoadBalancer_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_load_balancer", "test")
+	r := KubernetesClusterLoadBalancerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterLoadBalancer_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_load_balancer", "test")
+	r := KubernetesClusterLoadBalancerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r KubernetesClusterLoadBalancerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.KubernetesClusterLoadBalancerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.KubernetesLoadBalancersClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r KubernetesClusterLoadBalancerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_cluster_load_balancer" "test" {
+  name                    = "kubernetes"
+  kubernetes_cluster_id   = azurerm_kubernetes_cluster.test.id
+  primary_agent_pool_name = azurerm_kubernetes_cluster.test.default_node_pool.0.name
+}
+`, r.template(data))
+}
+
+func (r KubernetesClusterLoadBalancerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_load_balancer" "import" {
+  name                    = azurerm_kubernetes_cluster_load_balancer.test.name
+  kubernetes_cluster_id   = azurerm_kubernetes_cluster_load_balancer.test.kubernetes_cluster_id
+  primary_agent_pool_name = azurerm_kubernetes_cluster_load_balancer.test.primary_agent_pool_name
+}
+`, r.basic(data))
+}
+
+func (r KubernetesClusterLoadBalancerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_cluster_load_balancer" "test" {
+  name                    = "kubernetes"
+  kubernetes_cluster_id   = azurerm_kubernetes_cluster.test.id
+  primary_agent_pool_name = azurerm_kubernetes_cluster.test.default_node_pool.0.name
+  allow_service_placement = false
+
+  service_label_selector {
+    match_labels = {
+      app = "frontend"
+    }
+  }
+
+  service_namespace_selector {
+    match_expression {
+      key      = "team"
+      operator = "In"
+      values   = ["web", "api"]
+    }
+  }
+
+  node_selector {
+    match_expression {
+      key      = "kubernetes.azure.com/agentpool"
+      operator = "Exists"
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r KubernetesClusterLoadBalancerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%[2]d"
+  location = "%[1]s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%[2]d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  network_profile {
+    network_plugin    = "azure"
+    load_balancer_sku = "standard"
+    load_balancer_profile {
+      backend_pool_type                        = "NodeIP"
+      multiple_standard_load_balancers_enabled = true
+    }
+  }
+}
+`, data.Locations.Primary, data.RandomInteger)
+}
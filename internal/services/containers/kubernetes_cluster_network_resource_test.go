@@ -852,6 +852,34 @@ func TestAccKubernetesCluster_standardLoadBalancerProfileWithPortAndTimeout(t *t
 	})
 }
 
+func TestAccKubernetesCluster_standardLoadBalancerProfileMultipleStandardLoadBalancers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.standardLoadBalancerProfileMultipleStandardLoadBalancersConfig(data, "NodeIP"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_profile.0.load_balancer_profile.0.multiple_standard_load_balancers_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesCluster_standardLoadBalancerProfileMultipleStandardLoadBalancersInvalidBackendPoolType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.standardLoadBalancerProfileMultipleStandardLoadBalancersConfig(data, "NodeIPConfiguration"),
+			ExpectError: regexp.MustCompile("`backend_pool_type` must be set to `NodeIP` when `multiple_standard_load_balancers_enabled` is set to `true`"),
+		},
+	})
+}
+
 func TestAccKubernetesCluster_basicLoadBalancerProfile(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
 	r := KubernetesClusterResource{}
@@ -3297,6 +3325,48 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, currentKubernetesVersion, data.RandomInteger)
 }
 
+func (KubernetesClusterResource) standardLoadBalancerProfileMultipleStandardLoadBalancersConfig(data acceptance.TestData, backendPoolType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%[1]d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  network_profile {
+    network_plugin    = "azure"
+    load_balancer_sku = "standard"
+    load_balancer_profile {
+      backend_pool_type                        = "%[3]s"
+      multiple_standard_load_balancers_enabled = true
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, backendPoolType)
+}
+
 func (KubernetesClusterResource) standardLoadBalancerProfileWithPortAndTimeoutConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -1299,6 +1299,12 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 											string(managedclusters.BackendPoolTypeNodeIP),
 										}, false),
 									},
+
+									"multiple_standard_load_balancers_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
 								},
 							},
 						},
@@ -2366,6 +2372,10 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 				loadBalancerProfile.BackendPoolType = pointer.To(managedclusters.BackendPoolType(backendPoolType))
 			}
 
+			if key := "network_profile.0.load_balancer_profile.0.multiple_standard_load_balancers_enabled"; d.HasChange(key) {
+				loadBalancerProfile.EnableMultipleStandardLoadBalancers = pointer.To(d.Get(key).(bool))
+			}
+
 			existing.Model.Properties.NetworkProfile.LoadBalancerProfile = &loadBalancerProfile
 		}
 
@@ -3817,6 +3827,10 @@ func expandLoadBalancerProfile(d []interface{}) *managedclusters.ManagedClusterL
 		profile.BackendPoolType = pointer.To(managedclusters.BackendPoolType(backendPoolType))
 	}
 
+	if multipleStandardLoadBalancersEnabled, ok := config["multiple_standard_load_balancers_enabled"].(bool); ok && multipleStandardLoadBalancersEnabled {
+		profile.EnableMultipleStandardLoadBalancers = pointer.To(true)
+	}
+
 	return profile
 }
 
@@ -3982,6 +3996,8 @@ func flattenKubernetesClusterNetworkProfile(profile *managedclusters.ContainerSe
 			lb["backend_pool_type"] = v
 		}
 
+		lb["multiple_standard_load_balancers_enabled"] = pointer.From(lbp.EnableMultipleStandardLoadBalancers)
+
 		lb["effective_outbound_ips"] = resourceReferencesToIds(profile.LoadBalancerProfile.EffectiveOutboundIPs)
 		lbProfiles = append(lbProfiles, lb)
 	}
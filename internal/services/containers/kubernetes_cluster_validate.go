@@ -53,6 +53,10 @@ func validateKubernetesCluster(d *pluginsdk.ResourceData, cluster *managedcluste
 					return fmt.Errorf("dual-stack networking must be enabled and `ip_versions` must be set to [\"IPv4\", \"IPv6\"] in order to specify multiple values in `pod_cidrs`")
 				}
 			}
+
+			if err := validateKubernetesClusterLoadBalancerProfile(profile); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -135,6 +139,30 @@ func validateKubernetesCluster(d *pluginsdk.ResourceData, cluster *managedcluste
 	return nil
 }
 
+// validateKubernetesClusterLoadBalancerProfile ensures the prerequisites for multiple Standard Load Balancers are met,
+// since the API only surfaces these as a generic error once the cluster update has been accepted.
+func validateKubernetesClusterLoadBalancerProfile(profile map[string]interface{}) error {
+	loadBalancerProfiles, ok := profile["load_balancer_profile"].([]interface{})
+	if !ok || len(loadBalancerProfiles) == 0 || loadBalancerProfiles[0] == nil {
+		return nil
+	}
+
+	loadBalancerProfile := loadBalancerProfiles[0].(map[string]interface{})
+	if enabled, ok := loadBalancerProfile["multiple_standard_load_balancers_enabled"].(bool); !ok || !enabled {
+		return nil
+	}
+
+	if sku := profile["load_balancer_sku"].(string); !strings.EqualFold(sku, string(managedclusters.LoadBalancerSkuStandard)) {
+		return fmt.Errorf("`load_balancer_sku` must be set to `standard` when `multiple_standard_load_balancers_enabled` is set to `true`")
+	}
+
+	if backendPoolType := loadBalancerProfile["backend_pool_type"].(string); backendPoolType != string(managedclusters.BackendPoolTypeNodeIP) {
+		return fmt.Errorf("`backend_pool_type` must be set to `%s` when `multiple_standard_load_balancers_enabled` is set to `true`", string(managedclusters.BackendPoolTypeNodeIP))
+	}
+
+	return nil
+}
+
 var errExistingClusterCommon = `
 Azure Kubernetes Service has recently made several breaking changes to Cluster Authentication as
 the Managed Identity Preview has concluded and entered General Availability.
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type KubernetesClusterLoadBalancerId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	ManagedClusterName string
+	LoadBalancerName   string
+}
+
+func NewKubernetesClusterLoadBalancerID(subscriptionId, resourceGroup, managedClusterName, loadBalancerName string) KubernetesClusterLoadBalancerId {
+	return KubernetesClusterLoadBalancerId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		ManagedClusterName: managedClusterName,
+		LoadBalancerName:   loadBalancerName,
+	}
+}
+
+func (id KubernetesClusterLoadBalancerId) String() string {
+	segments := []string{
+		fmt.Sprintf("Load Balancer Name %q", id.LoadBalancerName),
+		fmt.Sprintf("Managed Cluster Name %q", id.ManagedClusterName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Kubernetes Cluster Load Balancer", segmentsStr)
+}
+
+func (id KubernetesClusterLoadBalancerId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s/loadBalancers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName, id.LoadBalancerName)
+}
+
+// KubernetesClusterLoadBalancerID parses a KubernetesClusterLoadBalancer ID into an KubernetesClusterLoadBalancerId struct
+func KubernetesClusterLoadBalancerID(input string) (*KubernetesClusterLoadBalancerId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an KubernetesClusterLoadBalancer ID: %+v", input, err)
+	}
+
+	resourceId := KubernetesClusterLoadBalancerId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ManagedClusterName, err = id.PopSegment("managedClusters"); err != nil {
+		return nil, err
+	}
+	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/firewallpolicyrulecollectiongroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ResourceWithUpdate = FirewallPolicyApplicationRuleResource{}
+
+type FirewallPolicyApplicationRuleResource struct{}
+
+func (r FirewallPolicyApplicationRuleResource) base() firewallPolicyRuleResourceBase {
+	return firewallPolicyRuleResourceBase{
+		resourceType: r.ResourceType(),
+		block:        "application_rule_collection",
+		nat:          false,
+		newId: func(id firewallPolicyRuleId) resourceids.Id {
+			return parse.NewFirewallPolicyApplicationRuleID(id.groupId.SubscriptionId, id.groupId.ResourceGroupName, id.groupId.FirewallPolicyName, id.groupId.RuleCollectionGroupName, id.collectionName, id.name)
+		},
+		parseId: func(input string) (*firewallPolicyRuleId, error) {
+			id, err := parse.FirewallPolicyApplicationRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return &firewallPolicyRuleId{
+				groupId:        firewallpolicyrulecollectiongroups.NewRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName),
+				collectionName: id.RuleCollectionName,
+				name:           id.ApplicationRuleName,
+			}, nil
+		},
+		expand: func(input []interface{}) (*[]firewallpolicyrulecollectiongroups.FirewallPolicyRule, error) {
+			return expandFirewallPolicyRuleApplication(input), nil
+		},
+		flatten: flattenFirewallPolicyRuleApplication,
+	}
+}
+
+func (r FirewallPolicyApplicationRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.base().arguments()
+}
+
+func (r FirewallPolicyApplicationRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r FirewallPolicyApplicationRuleResource) ModelObject() interface{} {
+	return nil
+}
+
+func (r FirewallPolicyApplicationRuleResource) ResourceType() string {
+	return "azurerm_firewall_policy_application_rule"
+}
+
+func (r FirewallPolicyApplicationRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FirewallPolicyApplicationRuleID
+}
+
+func (r FirewallPolicyApplicationRuleResource) Create() sdk.ResourceFunc {
+	return r.base().createFunc()
+}
+
+func (r FirewallPolicyApplicationRuleResource) Read() sdk.ResourceFunc {
+	return r.base().readFunc()
+}
+
+func (r FirewallPolicyApplicationRuleResource) Update() sdk.ResourceFunc {
+	return r.base().updateFunc()
+}
+
+func (r FirewallPolicyApplicationRuleResource) Delete() sdk.ResourceFunc {
+	return r.base().deleteFunc()
+}
@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/firewallpolicyrulecollectiongroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FirewallPolicyApplicationRuleResource struct{}
+
+func TestAccFirewallPolicyApplicationRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule", "test")
+	r := FirewallPolicyApplicationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyApplicationRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule", "test")
+	r := FirewallPolicyApplicationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFirewallPolicyApplicationRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule", "test")
+	r := FirewallPolicyApplicationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyApplicationRule_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule", "test")
+	r := FirewallPolicyApplicationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_application_rule.second").ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_rule_collection_group.test").Key("application_rule_collection.0.rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (FirewallPolicyApplicationRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FirewallPolicyApplicationRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupId := firewallpolicyrulecollectiongroups.NewRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	resp, err := clients.Network.FirewallPolicyRuleCollectionGroups.Get(ctx, groupId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", groupId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		for _, v := range pointer.From(model.Properties.RuleCollections) {
+			collection, ok := v.(firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection)
+			if !ok || !strings.EqualFold(pointer.From(collection.Name), id.RuleCollectionName) {
+				continue
+			}
+			for _, rule := range pointer.From(collection.Rules) {
+				if strings.EqualFold(pointer.From(rule.FirewallPolicyRule().Name), id.ApplicationRuleName) {
+					return pointer.To(true), nil
+				}
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (FirewallPolicyApplicationRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-rule-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-rule-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name                              = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id                = azurerm_firewall_policy.test.id
+  priority                          = 500
+  child_resource_management_enabled = true
+
+  application_rule_collection {
+    name     = "application_rule_collection1"
+    priority = 400
+    action   = "Allow"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r FirewallPolicyApplicationRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule" "test" {
+  name                     = "application_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "application_rule_collection1"
+  source_addresses         = ["10.0.0.1"]
+  destination_fqdns        = ["pluginsdk.io"]
+
+  protocols {
+    type = "Https"
+    port = 443
+  }
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyApplicationRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule" "test" {
+  name                     = "application_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "application_rule_collection1"
+  description              = "application rule managed outside of the rule collection group"
+  source_addresses         = ["10.0.0.1", "10.0.0.2"]
+  destination_fqdns        = ["pluginsdk.io", "terraform.io"]
+
+  protocols {
+    type = "Http"
+    port = 80
+  }
+
+  protocols {
+    type = "Https"
+    port = 443
+  }
+
+  http_headers {
+    name  = "head_foo"
+    value = "value_bar"
+  }
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyApplicationRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule" "import" {
+  name                     = azurerm_firewall_policy_application_rule.test.name
+  rule_collection_group_id = azurerm_firewall_policy_application_rule.test.rule_collection_group_id
+  rule_collection_name     = azurerm_firewall_policy_application_rule.test.rule_collection_name
+  source_addresses         = azurerm_firewall_policy_application_rule.test.source_addresses
+  destination_fqdns        = azurerm_firewall_policy_application_rule.test.destination_fqdns
+
+  protocols {
+    type = "Https"
+    port = 443
+  }
+}
+`, r.basic(data))
+}
+
+func (FirewallPolicyApplicationRuleResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-rule-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-rule-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name                              = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id                = azurerm_firewall_policy.test.id
+  priority                          = 500
+  child_resource_management_enabled = true
+
+  application_rule_collection {
+    name     = "application_rule_collection1"
+    priority = 400
+    action   = "Allow"
+
+    rule {
+      name              = "group_application_rule"
+      source_addresses  = ["10.0.0.1"]
+      destination_fqdns = ["hashicorp.com"]
+
+      protocols {
+        type = "Https"
+        port = 443
+      }
+    }
+  }
+}
+
+resource "azurerm_firewall_policy_application_rule" "test" {
+  name                     = "application_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "application_rule_collection1"
+  source_addresses         = ["10.0.0.1"]
+  destination_fqdns        = ["pluginsdk.io"]
+
+  protocols {
+    type = "Https"
+    port = 443
+  }
+}
+
+resource "azurerm_firewall_policy_application_rule" "second" {
+  name                     = "application_rule2"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "application_rule_collection1"
+  source_addresses         = ["10.0.0.2"]
+  destination_fqdns        = ["terraform.io"]
+
+  protocols {
+    type = "Http"
+    port = 80
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
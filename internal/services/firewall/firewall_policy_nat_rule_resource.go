@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/firewallpolicyrulecollectiongroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ResourceWithUpdate = FirewallPolicyNatRuleResource{}
+
+type FirewallPolicyNatRuleResource struct{}
+
+func (r FirewallPolicyNatRuleResource) base() firewallPolicyRuleResourceBase {
+	return firewallPolicyRuleResourceBase{
+		resourceType: r.ResourceType(),
+		block:        "nat_rule_collection",
+		nat:          true,
+		newId: func(id firewallPolicyRuleId) resourceids.Id {
+			return parse.NewFirewallPolicyNatRuleID(id.groupId.SubscriptionId, id.groupId.ResourceGroupName, id.groupId.FirewallPolicyName, id.groupId.RuleCollectionGroupName, id.collectionName, id.name)
+		},
+		parseId: func(input string) (*firewallPolicyRuleId, error) {
+			id, err := parse.FirewallPolicyNatRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return &firewallPolicyRuleId{
+				groupId:        firewallpolicyrulecollectiongroups.NewRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName),
+				collectionName: id.RuleCollectionName,
+				name:           id.NatRuleName,
+			}, nil
+		},
+		expand: func(input []interface{}) (*[]firewallpolicyrulecollectiongroups.FirewallPolicyRule, error) {
+			return expandFirewallPolicyRuleNat(input)
+		},
+		flatten: flattenFirewallPolicyRuleNat,
+	}
+}
+
+func (r FirewallPolicyNatRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.base().arguments()
+}
+
+func (r FirewallPolicyNatRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r FirewallPolicyNatRuleResource) ModelObject() interface{} {
+	return nil
+}
+
+func (r FirewallPolicyNatRuleResource) ResourceType() string {
+	return "azurerm_firewall_policy_nat_rule"
+}
+
+func (r FirewallPolicyNatRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FirewallPolicyNatRuleID
+}
+
+func (r FirewallPolicyNatRuleResource) Create() sdk.ResourceFunc {
+	return r.base().createFunc()
+}
+
+func (r FirewallPolicyNatRuleResource) Read() sdk.ResourceFunc {
+	return r.base().readFunc()
+}
+
+func (r FirewallPolicyNatRuleResource) Update() sdk.ResourceFunc {
+	return r.base().updateFunc()
+}
+
+func (r FirewallPolicyNatRuleResource) Delete() sdk.ResourceFunc {
+	return r.base().deleteFunc()
+}
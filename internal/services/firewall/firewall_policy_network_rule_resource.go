@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/firewallpolicyrulecollectiongroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ResourceWithUpdate = FirewallPolicyNetworkRuleResource{}
+
+type FirewallPolicyNetworkRuleResource struct{}
+
+func (r FirewallPolicyNetworkRuleResource) base() firewallPolicyRuleResourceBase {
+	return firewallPolicyRuleResourceBase{
+		resourceType: r.ResourceType(),
+		block:        "network_rule_collection",
+		nat:          false,
+		newId: func(id firewallPolicyRuleId) resourceids.Id {
+			return parse.NewFirewallPolicyNetworkRuleID(id.groupId.SubscriptionId, id.groupId.ResourceGroupName, id.groupId.FirewallPolicyName, id.groupId.RuleCollectionGroupName, id.collectionName, id.name)
+		},
+		parseId: func(input string) (*firewallPolicyRuleId, error) {
+			id, err := parse.FirewallPolicyNetworkRuleID(input)
+			if err != nil {
+				return nil, err
+			}
+			return &firewallPolicyRuleId{
+				groupId:        firewallpolicyrulecollectiongroups.NewRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName),
+				collectionName: id.RuleCollectionName,
+				name:           id.NetworkRuleName,
+			}, nil
+		},
+		expand: func(input []interface{}) (*[]firewallpolicyrulecollectiongroups.FirewallPolicyRule, error) {
+			return expandFirewallPolicyRuleNetwork(input), nil
+		},
+		flatten: flattenFirewallPolicyRuleNetwork,
+	}
+}
+
+func (r FirewallPolicyNetworkRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.base().arguments()
+}
+
+func (r FirewallPolicyNetworkRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r FirewallPolicyNetworkRuleResource) ModelObject() interface{} {
+	return nil
+}
+
+func (r FirewallPolicyNetworkRuleResource) ResourceType() string {
+	return "azurerm_firewall_policy_network_rule"
+}
+
+func (r FirewallPolicyNetworkRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FirewallPolicyNetworkRuleID
+}
+
+func (r FirewallPolicyNetworkRuleResource) Create() sdk.ResourceFunc {
+	return r.base().createFunc()
+}
+
+func (r FirewallPolicyNetworkRuleResource) Read() sdk.ResourceFunc {
+	return r.base().readFunc()
+}
+
+func (r FirewallPolicyNetworkRuleResource) Update() sdk.ResourceFunc {
+	return r.base().updateFunc()
+}
+
+func (r FirewallPolicyNetworkRuleResource) Delete() sdk.ResourceFunc {
+	return r.base().deleteFunc()
+}
@@ -0,0 +1,267 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/firewallpolicyrulecollectiongroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FirewallPolicyNetworkRuleResource struct{}
+
+func TestAccFirewallPolicyNetworkRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule", "test")
+	r := FirewallPolicyNetworkRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyNetworkRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule", "test")
+	r := FirewallPolicyNetworkRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFirewallPolicyNetworkRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule", "test")
+	r := FirewallPolicyNetworkRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyNetworkRule_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_network_rule", "test")
+	r := FirewallPolicyNetworkRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_network_rule.second").ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_rule_collection_group.test").Key("network_rule_collection.0.rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (FirewallPolicyNetworkRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FirewallPolicyNetworkRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupId := firewallpolicyrulecollectiongroups.NewRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	resp, err := clients.Network.FirewallPolicyRuleCollectionGroups.Get(ctx, groupId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", groupId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		for _, v := range pointer.From(model.Properties.RuleCollections) {
+			collection, ok := v.(firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection)
+			if !ok || !strings.EqualFold(pointer.From(collection.Name), id.RuleCollectionName) {
+				continue
+			}
+			for _, rule := range pointer.From(collection.Rules) {
+				if strings.EqualFold(pointer.From(rule.FirewallPolicyRule().Name), id.NetworkRuleName) {
+					return pointer.To(true), nil
+				}
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (FirewallPolicyNetworkRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-rule-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-rule-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name                              = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id                = azurerm_firewall_policy.test.id
+  priority                          = 500
+  child_resource_management_enabled = true
+
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r FirewallPolicyNetworkRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_network_rule" "test" {
+  name                     = "network_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection1"
+  protocols                = ["TCP", "UDP"]
+  source_addresses         = ["10.0.0.1"]
+  destination_addresses    = ["192.168.1.1"]
+  destination_ports        = ["80"]
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyNetworkRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_network_rule" "test" {
+  name                     = "network_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection1"
+  description              = "network rule managed outside of the rule collection group"
+  protocols                = ["TCP"]
+  source_addresses         = ["10.0.0.1", "10.0.0.2"]
+  destination_fqdns        = ["pluginsdk.io"]
+  destination_ports        = ["443", "8080-8090"]
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyNetworkRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_network_rule" "import" {
+  name                     = azurerm_firewall_policy_network_rule.test.name
+  rule_collection_group_id = azurerm_firewall_policy_network_rule.test.rule_collection_group_id
+  rule_collection_name     = azurerm_firewall_policy_network_rule.test.rule_collection_name
+  protocols                = azurerm_firewall_policy_network_rule.test.protocols
+  source_addresses         = azurerm_firewall_policy_network_rule.test.source_addresses
+  destination_addresses    = azurerm_firewall_policy_network_rule.test.destination_addresses
+  destination_ports        = azurerm_firewall_policy_network_rule.test.destination_ports
+}
+`, r.basic(data))
+}
+
+func (FirewallPolicyNetworkRuleResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-rule-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-rule-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name                              = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id                = azurerm_firewall_policy.test.id
+  priority                          = 500
+  child_resource_management_enabled = true
+
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+
+    rule {
+      name                  = "group_network_rule"
+      protocols             = ["TCP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_addresses = ["192.168.1.1"]
+      destination_ports     = ["22"]
+    }
+  }
+}
+
+resource "azurerm_firewall_policy_network_rule" "test" {
+  name                     = "network_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection1"
+  protocols                = ["TCP", "UDP"]
+  source_addresses         = ["10.0.0.1"]
+  destination_addresses    = ["192.168.1.1"]
+  destination_ports        = ["80"]
+}
+
+resource "azurerm_firewall_policy_network_rule" "second" {
+  name                     = "network_rule2"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection1"
+  protocols                = ["UDP"]
+  source_addresses         = ["10.0.0.2"]
+  destination_addresses    = ["192.168.1.2"]
+  destination_ports        = ["53"]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,442 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/firewallpolicyrulecollectiongroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// firewallPolicyRuleId identifies a single rule within a rule collection of a Firewall Policy Rule Collection Group
+type firewallPolicyRuleId struct {
+	groupId        firewallpolicyrulecollectiongroups.RuleCollectionGroupId
+	collectionName string
+	name           string
+}
+
+// firewallPolicyRuleResourceBase contains the behaviour shared by the `azurerm_firewall_policy_application_rule`,
+// `azurerm_firewall_policy_network_rule` and `azurerm_firewall_policy_nat_rule` resources. Rules are only available as
+// part of their Rule Collection Group, so each operation retrieves the Rule Collection Group, changes the rule within
+// the named rule collection and writes the whole Rule Collection Group back.
+type firewallPolicyRuleResourceBase struct {
+	// resourceType is the name of the rule resource, e.g. `azurerm_firewall_policy_network_rule`
+	resourceType string
+
+	// block is the name of the rule collection block within `azurerm_firewall_policy_rule_collection_group` from
+	// which the schema of the rule is taken
+	block string
+
+	// nat is whether the rule belongs to a NAT rule collection rather than to a filter rule collection
+	nat bool
+
+	newId   func(id firewallPolicyRuleId) resourceids.Id
+	parseId func(input string) (*firewallPolicyRuleId, error)
+	expand  func(input []interface{}) (*[]firewallpolicyrulecollectiongroups.FirewallPolicyRule, error)
+	flatten func(input *[]firewallpolicyrulecollectiongroups.FirewallPolicyRule) ([]interface{}, error)
+}
+
+func firewallPolicyRuleName(v firewallpolicyrulecollectiongroups.FirewallPolicyRule) *string {
+	return v.FirewallPolicyRule().Name
+}
+
+func (br firewallPolicyRuleResourceBase) arguments() map[string]*pluginsdk.Schema {
+	out := map[string]*pluginsdk.Schema{
+		"rule_collection_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: firewallpolicyrulecollectiongroups.ValidateRuleCollectionGroupID,
+		},
+
+		"rule_collection_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+
+	// the schema is taken from the `rule` block of `azurerm_firewall_policy_rule_collection_group` so that both
+	// resources stay consistent
+	collection := resourceFirewallPolicyRuleCollectionGroup().Schema[br.block].Elem.(*pluginsdk.Resource)
+	for k, v := range collection.Schema["rule"].Elem.(*pluginsdk.Resource).Schema {
+		out[k] = v
+	}
+
+	out["name"].ForceNew = true
+
+	return out
+}
+
+func (br firewallPolicyRuleResourceBase) createFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.FirewallPolicyRuleCollectionGroups
+
+			groupId, err := firewallpolicyrulecollectiongroups.ParseRuleCollectionGroupID(metadata.ResourceData.Get("rule_collection_group_id").(string))
+			if err != nil {
+				return err
+			}
+
+			ruleId := firewallPolicyRuleId{
+				groupId:        *groupId,
+				collectionName: metadata.ResourceData.Get("rule_collection_name").(string),
+				name:           metadata.ResourceData.Get("name").(string),
+			}
+			id := br.newId(ruleId)
+
+			locks.ByName(groupId.FirewallPolicyName, AzureFirewallPolicyResourceName)
+			defer locks.UnlockByName(groupId.FirewallPolicyName, AzureFirewallPolicyResourceName)
+
+			rule, err := br.expandRule(metadata.ResourceData)
+			if err != nil {
+				return fmt.Errorf("expanding %s: %+v", id, err)
+			}
+
+			err = updateFirewallPolicyRuleCollectionRules(ctx, client, ruleId.groupId, ruleId.collectionName, br.nat, func(rules []firewallpolicyrulecollectiongroups.FirewallPolicyRule) ([]firewallpolicyrulecollectiongroups.FirewallPolicyRule, error) {
+				if helpers.ExistsByName(rules, ruleId.name, firewallPolicyRuleName) {
+					return nil, metadata.ResourceRequiresImport(br.resourceType, id)
+				}
+				return append(rules, rule), nil
+			})
+			if err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (br firewallPolicyRuleResourceBase) readFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.FirewallPolicyRuleCollectionGroups
+
+			ruleId, err := br.parseId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := br.newId(*ruleId)
+
+			resp, err := client.Get(ctx, ruleId.groupId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", ruleId.groupId, err)
+			}
+
+			rule := findFirewallPolicyRule(resp.Model, ruleId.collectionName, ruleId.name)
+			if rule == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			flattened, err := br.flatten(&[]firewallpolicyrulecollectiongroups.FirewallPolicyRule{rule})
+			if err != nil {
+				return fmt.Errorf("flattening %s: %+v", id, err)
+			}
+
+			if entry := helpers.FindFlattenedByName(flattened, ruleId.name); entry != nil {
+				if err := helpers.SetEntry(metadata.ResourceData, br.arguments(), entry, "rule_collection_group_id", "rule_collection_name"); err != nil {
+					return err
+				}
+			}
+
+			metadata.ResourceData.Set("name", ruleId.name)
+			metadata.ResourceData.Set("rule_collection_name", ruleId.collectionName)
+			metadata.ResourceData.Set("rule_collection_group_id", ruleId.groupId.ID())
+
+			return nil
+		},
+	}
+}
+
+func (br firewallPolicyRuleResourceBase) updateFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.FirewallPolicyRuleCollectionGroups
+
+			ruleId, err := br.parseId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := br.newId(*ruleId)
+
+			locks.ByName(ruleId.groupId.FirewallPolicyName, AzureFirewallPolicyResourceName)
+			defer locks.UnlockByName(ruleId.groupId.FirewallPolicyName, AzureFirewallPolicyResourceName)
+
+			rule, err := br.expandRule(metadata.ResourceData)
+			if err != nil {
+				return fmt.Errorf("expanding %s: %+v", id, err)
+			}
+
+			return updateFirewallPolicyRuleCollectionRules(ctx, client, ruleId.groupId, ruleId.collectionName, br.nat, func(rules []firewallpolicyrulecollectiongroups.FirewallPolicyRule) ([]firewallpolicyrulecollectiongroups.FirewallPolicyRule, error) {
+				return helpers.UpsertByName(rules, rule, firewallPolicyRuleName), nil
+			})
+		},
+	}
+}
+
+func (br firewallPolicyRuleResourceBase) deleteFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.FirewallPolicyRuleCollectionGroups
+
+			ruleId, err := br.parseId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(ruleId.groupId.FirewallPolicyName, AzureFirewallPolicyResourceName)
+			defer locks.UnlockByName(ruleId.groupId.FirewallPolicyName, AzureFirewallPolicyResourceName)
+
+			return updateFirewallPolicyRuleCollectionRules(ctx, client, ruleId.groupId, ruleId.collectionName, br.nat, func(rules []firewallpolicyrulecollectiongroups.FirewallPolicyRule) ([]firewallpolicyrulecollectiongroups.FirewallPolicyRule, error) {
+				return helpers.RemoveByName(rules, ruleId.name, firewallPolicyRuleName), nil
+			})
+		},
+	}
+}
+
+// expandRule expands the configuration of the rule resource using the expand function for the `rule` block of
+// `azurerm_firewall_policy_rule_collection_group`, since the fields of both are the same
+func (br firewallPolicyRuleResourceBase) expandRule(d *pluginsdk.ResourceData) (firewallpolicyrulecollectiongroups.FirewallPolicyRule, error) {
+	expanded, err := br.expand([]interface{}{helpers.ExpandEntry(d, br.arguments(), "rule_collection_group_id", "rule_collection_name")})
+	if err != nil {
+		return nil, err
+	}
+	if expanded == nil || len(*expanded) != 1 {
+		return nil, fmt.Errorf("expected a single `rule` to be expanded")
+	}
+
+	return (*expanded)[0], nil
+}
+
+// findFirewallPolicyRule returns the rule with the specified name from within the named rule collection of the Rule
+// Collection Group, returning nil if either the rule collection or the rule doesn't exist
+func findFirewallPolicyRule(model *firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollectionGroup, collectionName string, ruleName string) firewallpolicyrulecollectiongroups.FirewallPolicyRule {
+	if model == nil || model.Properties == nil || model.Properties.RuleCollections == nil {
+		return nil
+	}
+
+	for _, collection := range *model.Properties.RuleCollections {
+		var rules *[]firewallpolicyrulecollectiongroups.FirewallPolicyRule
+		switch v := collection.(type) {
+		case firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection:
+			if !strings.EqualFold(pointer.From(v.Name), collectionName) {
+				continue
+			}
+			rules = v.Rules
+		case firewallpolicyrulecollectiongroups.FirewallPolicyNatRuleCollection:
+			if !strings.EqualFold(pointer.From(v.Name), collectionName) {
+				continue
+			}
+			rules = v.Rules
+		default:
+			continue
+		}
+
+		for _, rule := range pointer.From(rules) {
+			if strings.EqualFold(pointer.From(firewallPolicyRuleName(rule)), ruleName) {
+				return rule
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateFirewallPolicyRuleCollectionRules retrieves the Rule Collection Group, applies the specified update to the
+// rules within the named rule collection and then writes it back. Callers are expected to hold a lock on the Firewall
+// Policy name.
+func updateFirewallPolicyRuleCollectionRules(ctx context.Context, client *firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollectionGroupsClient, id firewallpolicyrulecollectiongroups.RuleCollectionGroupId, collectionName string, nat bool, update func(rules []firewallpolicyrulecollectiongroups.FirewallPolicyRule) ([]firewallpolicyrulecollectiongroups.FirewallPolicyRule, error)) error {
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+	if existing.Model.Properties == nil || existing.Model.Properties.RuleCollections == nil {
+		return fmt.Errorf("rule collection %q was not found within %s", collectionName, id)
+	}
+
+	collections := *existing.Model.Properties.RuleCollections
+	found := false
+	for i, collection := range collections {
+		switch v := collection.(type) {
+		case firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection:
+			if !strings.EqualFold(pointer.From(v.Name), collectionName) {
+				continue
+			}
+			if nat {
+				return fmt.Errorf("rule collection %q within %s is not a NAT rule collection", collectionName, id)
+			}
+
+			rules, err := update(pointer.From(v.Rules))
+			if err != nil {
+				return err
+			}
+			v.Rules = &rules
+			collections[i] = v
+			found = true
+
+		case firewallpolicyrulecollectiongroups.FirewallPolicyNatRuleCollection:
+			if !strings.EqualFold(pointer.From(v.Name), collectionName) {
+				continue
+			}
+			if !nat {
+				return fmt.Errorf("rule collection %q within %s is a NAT rule collection", collectionName, id)
+			}
+
+			rules, err := update(pointer.From(v.Rules))
+			if err != nil {
+				return err
+			}
+			v.Rules = &rules
+			collections[i] = v
+			found = true
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("rule collection %q was not found within %s", collectionName, id)
+	}
+
+	existing.Model.Properties.RuleCollections = &collections
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, *existing.Model); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// filterFirewallPolicyRuleCollectionRules returns the flattened rule collections, limiting the rules within each rule
+// collection to those defined within `configured`. Since the type of a filter rule collection can't be determined once
+// it contains no rules, the empty rule collections which are defined within `configured` are also included.
+func filterFirewallPolicyRuleCollectionRules(input []interface{}, configured []interface{}, existing *[]firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection) []interface{} {
+	names := make(map[string]map[string]struct{})
+	for _, raw := range configured {
+		if collection, ok := raw.(map[string]interface{}); ok {
+			names[strings.ToLower(fmt.Sprint(collection["name"]))] = helpers.FlattenedNames(collection["rule"])
+		}
+	}
+
+	results := make([]interface{}, 0)
+	flattened := make(map[string]struct{})
+	for _, raw := range input {
+		collection, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		collectionName := strings.ToLower(fmt.Sprint(collection["name"]))
+		flattened[collectionName] = struct{}{}
+
+		rules, _ := collection["rule"].([]interface{})
+		collection["rule"] = helpers.FilterFlattenedByName(rules, names[collectionName])
+
+		results = append(results, collection)
+	}
+
+	for _, raw := range pointer.From(existing) {
+		collection, ok := raw.(firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection)
+		if !ok || len(pointer.From(collection.Rules)) > 0 {
+			continue
+		}
+
+		collectionName := strings.ToLower(pointer.From(collection.Name))
+		if _, ok := flattened[collectionName]; ok {
+			continue
+		}
+		if _, ok := names[collectionName]; !ok {
+			continue
+		}
+
+		var action string
+		if collection.Action != nil {
+			action = string(pointer.From(collection.Action.Type))
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":     pointer.From(collection.Name),
+			"priority": pointer.From(collection.Priority),
+			"action":   action,
+			"rule":     []interface{}{},
+		})
+	}
+
+	return results
+}
+
+// mergeFirewallPolicyRuleCollections returns the rule collections defined within
+// `azurerm_firewall_policy_rule_collection_group`, adding to each of them the existing rules of the rule collection
+// with the same name which are managed by the rule resources. `previous` contains the names of the rules which were
+// previously defined within each rule collection of the resource: existing rules which were but no longer are have been
+// removed from the configuration and are deleted, while the remaining existing rules were never defined within the
+// resource and so are managed by the rule resources. Rule collections which aren't defined within the resource are
+// removed, since a rule resource can only manage rules within a rule collection which is.
+func mergeFirewallPolicyRuleCollections(configured []firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection, existing *[]firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection, previous map[string]map[string]struct{}) []firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection {
+	existingRules := make(map[string][]firewallpolicyrulecollectiongroups.FirewallPolicyRule)
+	for _, collection := range pointer.From(existing) {
+		switch v := collection.(type) {
+		case firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection:
+			existingRules[strings.ToLower(pointer.From(v.Name))] = pointer.From(v.Rules)
+		case firewallpolicyrulecollectiongroups.FirewallPolicyNatRuleCollection:
+			existingRules[strings.ToLower(pointer.From(v.Name))] = pointer.From(v.Rules)
+		}
+	}
+
+	results := make([]firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection, 0)
+	for _, collection := range configured {
+		switch v := collection.(type) {
+		case *firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection:
+			name := strings.ToLower(pointer.From(v.Name))
+			v.Rules = pointer.To(helpers.MergeByName(pointer.From(v.Rules), existingRules[name], previous[name], firewallPolicyRuleName))
+		case *firewallpolicyrulecollectiongroups.FirewallPolicyNatRuleCollection:
+			name := strings.ToLower(pointer.From(v.Name))
+			v.Rules = pointer.To(helpers.MergeByName(pointer.From(v.Rules), existingRules[name], previous[name], firewallPolicyRuleName))
+		}
+		results = append(results, collection)
+	}
+
+	return results
+}
+
+// firewallPolicyRuleCollectionRuleNames returns the lower-cased names of the rules within each of the flattened rule
+// collections, keyed by the lower-cased name of the rule collection
+func firewallPolicyRuleCollectionRuleNames(input ...[]interface{}) map[string]map[string]struct{} {
+	results := make(map[string]map[string]struct{})
+	for _, collections := range input {
+		for _, raw := range collections {
+			collection, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			results[strings.ToLower(fmt.Sprint(collection["name"]))] = helpers.FlattenedNames(collection["rule"])
+		}
+	}
+
+	return results
+}
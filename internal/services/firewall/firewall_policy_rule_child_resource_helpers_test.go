@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/firewallpolicyrulecollectiongroups"
+)
+
+func TestMergeFirewallPolicyRuleCollections(t *testing.T) {
+	rule := func(name string) firewallpolicyrulecollectiongroups.FirewallPolicyRule {
+		return firewallpolicyrulecollectiongroups.NetworkRule{Name: pointer.To(name)}
+	}
+
+	existing := []firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection{
+		firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection{
+			Name: pointer.To("collection"),
+			Rules: &[]firewallpolicyrulecollectiongroups.FirewallPolicyRule{
+				rule("configured"),
+				rule("removed"),
+				rule("child"),
+			},
+		},
+	}
+
+	configured := []firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection{
+		&firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection{
+			Name:  pointer.To("collection"),
+			Rules: &[]firewallpolicyrulecollectiongroups.FirewallPolicyRule{rule("configured")},
+		},
+	}
+
+	// `removed` was previously defined within the resource, whereas `child` is managed by a rule resource
+	previous := firewallPolicyRuleCollectionRuleNames([]interface{}{
+		map[string]interface{}{
+			"name": "Collection",
+			"rule": []interface{}{
+				map[string]interface{}{"name": "configured"},
+				map[string]interface{}{"name": "Removed"},
+			},
+		},
+	})
+
+	actual := mergeFirewallPolicyRuleCollections(configured, &existing, previous)
+	if len(actual) != 1 {
+		t.Fatalf("expected 1 rule collection but got %d", len(actual))
+	}
+
+	names := make([]string, 0)
+	for _, v := range pointer.From(actual[0].(*firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection).Rules) {
+		names = append(names, pointer.From(firewallPolicyRuleName(v)))
+	}
+
+	if expected := []string{"configured", "child"}; !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected the rules %+v but got %+v", expected, names)
+	}
+}
@@ -4,6 +4,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -36,6 +37,8 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 
 		Importer: pluginsdk.ImporterValidatingIdentity(&firewallpolicyrulecollectiongroups.RuleCollectionGroupId{}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceFirewallPolicyRuleCollectionGroupCustomizeDiff),
+
 		Identity: &schema.ResourceIdentity{
 			SchemaFunc: pluginsdk.GenerateIdentitySchema(&firewallpolicyrulecollectiongroups.RuleCollectionGroupId{}),
 		},
@@ -68,6 +71,12 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(100, 65000),
 			},
 
+			"child_resource_management_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"application_rule_collection": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -94,7 +103,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 						},
 						"rule": {
 							Type:     pluginsdk.TypeList,
-							Required: true,
+							Optional: true,
 							MinItems: 1,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
@@ -251,7 +260,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 						},
 						"rule": {
 							Type:     pluginsdk.TypeList,
-							Required: true,
+							Optional: true,
 							MinItems: 1,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
@@ -369,7 +378,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 						},
 						"rule": {
 							Type:     pluginsdk.TypeList,
-							Required: true,
+							Optional: true,
 							MinItems: 1,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
@@ -458,6 +467,34 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 	}
 }
 
+// resourceFirewallPolicyRuleCollectionGroupCustomizeDiff ensures that each rule collection contains at least one
+// rule, unless the rules can be managed by the rule resources
+func resourceFirewallPolicyRuleCollectionGroupCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.GetAttr("child_resource_management_enabled").IsKnown() || d.Get("child_resource_management_enabled").(bool) {
+		return nil
+	}
+
+	for _, block := range []string{"application_rule_collection", "network_rule_collection", "nat_rule_collection"} {
+		// the rules can't be counted until they're known, e.g. when they're generated by a `dynamic` block
+		if !config.GetAttr(block).IsWhollyKnown() {
+			continue
+		}
+
+		for _, raw := range d.Get(block).([]interface{}) {
+			collection, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if rules, _ := collection["rule"].([]interface{}); len(rules) == 0 {
+				return fmt.Errorf("at least one `rule` must be specified within the `%s` %q when `child_resource_management_enabled` is `false`", block, collection["name"].(string))
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.FirewallPolicyRuleCollectionGroups
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -491,6 +528,10 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 			Priority: pointer.To(int64(d.Get("priority").(int))),
 		},
 	}
+
+	// rules which aren't defined within this resource may be managed by the rule resources, and must be retained
+	childResourceManagementEnabled := d.Get("child_resource_management_enabled").(bool)
+
 	var rulesCollections []firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection
 	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionApplication(d.Get("application_rule_collection").([]interface{}))...)
 	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNetwork(d.Get("network_rule_collection").([]interface{}))...)
@@ -501,6 +542,31 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 	}
 	rulesCollections = append(rulesCollections, natRules...)
 
+	if childResourceManagementEnabled && !d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		var existingRuleCollections *[]firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection
+		if existing.Model != nil && existing.Model.Properties != nil {
+			existingRuleCollections = existing.Model.Properties.RuleCollections
+		}
+
+		// the rules previously tracked by this resource are those it manages, unless `child_resource_management_enabled`
+		// has just been enabled in which case all rules were tracked - including those which are about to be managed by
+		// the rule resources - so none are removed
+		var previous map[string]map[string]struct{}
+		if oldEnabled, _ := d.GetChange("child_resource_management_enabled"); oldEnabled.(bool) {
+			oldApplication, _ := d.GetChange("application_rule_collection")
+			oldNetwork, _ := d.GetChange("network_rule_collection")
+			oldNat, _ := d.GetChange("nat_rule_collection")
+			previous = firewallPolicyRuleCollectionRuleNames(oldApplication.([]interface{}), oldNetwork.([]interface{}), oldNat.([]interface{}))
+		}
+
+		rulesCollections = mergeFirewallPolicyRuleCollections(rulesCollections, existingRuleCollections, previous)
+	}
+
 	param.Properties.RuleCollections = &rulesCollections
 
 	if err = client.CreateOrUpdateThenPoll(ctx, id, param); err != nil {
@@ -542,6 +608,9 @@ func resourceFirewallPolicyRuleCollectionGroupSetFlatten(d *pluginsdk.ResourceDa
 	d.Set("name", id.RuleCollectionGroupName)
 	d.Set("firewall_policy_id", firewallpolicies.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroupName, id.FirewallPolicyName).ID())
 
+	childResourceManagementEnabled := d.Get("child_resource_management_enabled").(bool)
+	d.Set("child_resource_management_enabled", childResourceManagementEnabled)
+
 	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("priority", props.Priority)
@@ -551,6 +620,17 @@ func resourceFirewallPolicyRuleCollectionGroupSetFlatten(d *pluginsdk.ResourceDa
 				return fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
 			}
 
+			// when the rule resources are in use only the rules defined within this resource are tracked, since the
+			// remaining rules are managed by the rule resources. `child_resource_management_enabled` can't be
+			// determined when importing, in which case it's `false` and all rules are tracked - these rules aren't
+			// removed when the field is subsequently enabled, since rules which aren't defined within this resource
+			// are retained on update.
+			if childResourceManagementEnabled {
+				applicationRuleCollections = filterFirewallPolicyRuleCollectionRules(applicationRuleCollections, d.Get("application_rule_collection").([]interface{}), props.RuleCollections)
+				networkRuleCollections = filterFirewallPolicyRuleCollectionRules(networkRuleCollections, d.Get("network_rule_collection").([]interface{}), props.RuleCollections)
+				natRuleCollections = filterFirewallPolicyRuleCollectionRules(natRuleCollections, d.Get("nat_rule_collection").([]interface{}), props.RuleCollections)
+			}
+
 			if err := d.Set("application_rule_collection", applicationRuleCollections); err != nil {
 				return fmt.Errorf("setting `application_rule_collection`: %+v", err)
 			}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FirewallPolicyApplicationRuleId struct {
+	SubscriptionId          string
+	ResourceGroup           string
+	FirewallPolicyName      string
+	RuleCollectionGroupName string
+	RuleCollectionName      string
+	ApplicationRuleName     string
+}
+
+func NewFirewallPolicyApplicationRuleID(subscriptionId, resourceGroup, firewallPolicyName, ruleCollectionGroupName, ruleCollectionName, applicationRuleName string) FirewallPolicyApplicationRuleId {
+	return FirewallPolicyApplicationRuleId{
+		SubscriptionId:          subscriptionId,
+		ResourceGroup:           resourceGroup,
+		FirewallPolicyName:      firewallPolicyName,
+		RuleCollectionGroupName: ruleCollectionGroupName,
+		RuleCollectionName:      ruleCollectionName,
+		ApplicationRuleName:     applicationRuleName,
+	}
+}
+
+func (id FirewallPolicyApplicationRuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Application Rule Name %q", id.ApplicationRuleName),
+		fmt.Sprintf("Rule Collection Name %q", id.RuleCollectionName),
+		fmt.Sprintf("Rule Collection Group Name %q", id.RuleCollectionGroupName),
+		fmt.Sprintf("Firewall Policy Name %q", id.FirewallPolicyName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Firewall Policy Application Rule", segmentsStr)
+}
+
+func (id FirewallPolicyApplicationRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/firewallPolicies/%s/ruleCollectionGroups/%s/ruleCollections/%s/applicationRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName, id.RuleCollectionName, id.ApplicationRuleName)
+}
+
+// FirewallPolicyApplicationRuleID parses a FirewallPolicyApplicationRule ID into an FirewallPolicyApplicationRuleId struct
+func FirewallPolicyApplicationRuleID(input string) (*FirewallPolicyApplicationRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an FirewallPolicyApplicationRule ID: %+v", input, err)
+	}
+
+	resourceId := FirewallPolicyApplicationRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FirewallPolicyName, err = id.PopSegment("firewallPolicies"); err != nil {
+		return nil, err
+	}
+	if resourceId.RuleCollectionGroupName, err = id.PopSegment("ruleCollectionGroups"); err != nil {
+		return nil, err
+	}
+	if resourceId.RuleCollectionName, err = id.PopSegment("ruleCollections"); err != nil {
+		return nil, err
+	}
+	if resourceId.ApplicationRuleName, err = id.PopSegment("applicationRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// This `azuresdkhack` only exists because `go-azure-sdk` does not currently include the `workspaces/summaryLogs`
+// resource (Summary Rules), which is only available in the Preview API versions of `Microsoft.OperationalInsights`.
+// Once this is available within a Stable API version this can be replaced by the generated SDK.
+
+const summaryLogsApiVersion = "2023-01-01-preview"
+
+type SummaryLogsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewSummaryLogsClientWithBaseURI(sdkApi environments.Api) (*SummaryLogsClient, error) {
+	c, err := resourcemanager.NewClient(sdkApi, "summarylogs", summaryLogsApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating SummaryLogsClient: %+v", err)
+	}
+
+	return &SummaryLogsClient{
+		Client: c,
+	}, nil
+}
+
+type SummaryLogs struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *SummaryLogsProperties `json:"properties,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type SummaryLogsProperties struct {
+	Description       *string                    `json:"description,omitempty"`
+	DisplayName       *string                    `json:"displayName,omitempty"`
+	IsActive          *bool                      `json:"isActive,omitempty"`
+	ProvisioningState *string                    `json:"provisioningState,omitempty"`
+	RuleDefinition    *SummaryLogsRuleDefinition `json:"ruleDefinition,omitempty"`
+	RuleType          *RuleType                  `json:"ruleType,omitempty"`
+	StatusCode        *string                    `json:"statusCode,omitempty"`
+}
+
+type SummaryLogsRuleDefinition struct {
+	BinDelay         *int64  `json:"binDelay,omitempty"`
+	BinSize          *int64  `json:"binSize,omitempty"`
+	BinStartTime     *string `json:"binStartTime,omitempty"`
+	DestinationTable *string `json:"destinationTable,omitempty"`
+	Query            *string `json:"query,omitempty"`
+	TimeSelector     *string `json:"timeSelector,omitempty"`
+}
+
+type RuleType string
+
+const (
+	RuleTypeUser RuleType = "User"
+)
+
+type SummaryLogsGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *SummaryLogs
+}
+
+type SummaryLogsCreateOrUpdateOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *SummaryLogs
+}
+
+type SummaryLogsDeleteOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+func (c SummaryLogsClient) Get(ctx context.Context, id SummaryLogId) (result SummaryLogsGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model SummaryLogs
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c SummaryLogsClient) CreateOrUpdate(ctx context.Context, id SummaryLogId, input SummaryLogs) (result SummaryLogsCreateOrUpdateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (c SummaryLogsClient) CreateOrUpdateThenPoll(ctx context.Context, id SummaryLogId, input SummaryLogs) error {
+	result, err := c.CreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+func (c SummaryLogsClient) Delete(ctx context.Context, id SummaryLogId) (result SummaryLogsDeleteOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (c SummaryLogsClient) DeleteThenPoll(ctx context.Context, id SummaryLogId) error {
+	result, err := c.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2023-09-01/deletedworkspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationsmanagement/2015-11-01-preview/solution"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/azuresdkhacks"
 )
 
 type Client struct {
@@ -34,6 +35,7 @@ type Client struct {
 	SavedSearchesClient        *savedsearches.SavedSearchesClient
 	SolutionsClient            *solution.SolutionClient
 	StorageInsightsClient      *storageinsights.StorageInsightsClient
+	SummaryLogsClient          *azuresdkhacks.SummaryLogsClient
 	QueryPackQueriesClient     *querypackqueries.QueryPackQueriesClient
 	SharedKeyWorkspacesClient  *workspaces.WorkspacesClient
 	TablesClient               *tables.TablesClient
@@ -119,6 +121,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(queryPackQueriesClient.Client, o.Authorizers.ResourceManager)
 
+	summaryLogsClient, err := azuresdkhacks.NewSummaryLogsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building SummaryLogs client: %+v", err)
+	}
+	o.Configure(summaryLogsClient.Client, o.Authorizers.ResourceManager)
+
 	tablesClient, err := tables.NewTablesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Tables client: %+v", err)
@@ -137,6 +145,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		SavedSearchesClient:        savedSearchesClient,
 		SolutionsClient:            solutionsClient,
 		StorageInsightsClient:      storageInsightsClient,
+		SummaryLogsClient:          summaryLogsClient,
 		SharedKeyWorkspacesClient:  workspacesClient,
 		TablesClient:               tablesClient,
 		WorkspaceClient:            featureWorkspaceClient,
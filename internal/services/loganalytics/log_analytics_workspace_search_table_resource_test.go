@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogAnalyticsWorkspaceSearchTableResource struct{}
+
+func TestAccLogAnalyticsWorkspaceSearchTable_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_search_table", "test")
+	r := LogAnalyticsWorkspaceSearchTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_table").HasValue("Heartbeat"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceSearchTable_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_search_table", "test")
+	r := LogAnalyticsWorkspaceSearchTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceSearchTable_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_search_table", "test")
+	r := LogAnalyticsWorkspaceSearchTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := tables.ParseTableID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LogAnalytics.TablesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) basic(data acceptance.TestData) string {
+	endTime := time.Now().UTC().Truncate(time.Hour)
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_search_table" "test" {
+  name              = "acctestsearch%d_SRCH"
+  workspace_id      = azurerm_log_analytics_workspace.test.id
+  query             = "Heartbeat"
+  start_search_time = "%s"
+  end_search_time   = "%s"
+}
+`, r.template(data), data.RandomInteger, endTime.Add(-24*time.Hour).Format(time.RFC3339), endTime.Format(time.RFC3339))
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_search_table" "import" {
+  name              = azurerm_log_analytics_workspace_search_table.test.name
+  workspace_id      = azurerm_log_analytics_workspace_search_table.test.workspace_id
+  query             = azurerm_log_analytics_workspace_search_table.test.query
+  start_search_time = azurerm_log_analytics_workspace_search_table.test.start_search_time
+  end_search_time   = azurerm_log_analytics_workspace_search_table.test.end_search_time
+}
+`, r.basic(data))
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) complete(data acceptance.TestData) string {
+	endTime := time.Now().UTC().Truncate(time.Hour)
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_search_table" "test" {
+  name              = "acctestsearch%d_SRCH"
+  workspace_id      = azurerm_log_analytics_workspace.test.id
+  query             = "Heartbeat | where Computer startswith 'acctest'"
+  start_search_time = "%s"
+  end_search_time   = "%s"
+  description       = "acceptance test search job"
+  limit             = 1000
+}
+`, r.template(data), data.RandomInteger, endTime.Add(-24*time.Hour).Format(time.RFC3339), endTime.Format(time.RFC3339))
+}
+
+func (r LogAnalyticsWorkspaceSearchTableResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  retention_in_days   = 30
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
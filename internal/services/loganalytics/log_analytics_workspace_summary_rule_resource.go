@@ -0,0 +1,332 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = WorkspaceSummaryRuleResource{}
+
+type WorkspaceSummaryRuleResource struct{}
+
+type WorkspaceSummaryRuleResourceModel struct {
+	Name              string `tfschema:"name"`
+	WorkspaceId       string `tfschema:"workspace_id"`
+	DisplayName       string `tfschema:"display_name"`
+	Description       string `tfschema:"description"`
+	Query             string `tfschema:"query"`
+	BinSizeInMinutes  int64  `tfschema:"bin_size_in_minutes"`
+	BinDelayInMinutes int64  `tfschema:"bin_delay_in_minutes"`
+	BinStartTime      string `tfschema:"bin_start_time"`
+	TimeSelector      string `tfschema:"time_selector"`
+	DestinationTable  string `tfschema:"destination_table"`
+	Active            bool   `tfschema:"active"`
+}
+
+func (r WorkspaceSummaryRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"bin_size_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntInSlice([]int{20, 30, 60, 120, 180, 360, 720, 1440}),
+		},
+
+		"destination_table": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`_CL$`), "must end with '_CL'."),
+		},
+
+		"bin_delay_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 1440),
+		},
+
+		"bin_start_time": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppress.RFC3339Time,
+			ValidateFunc:     validation.IsRFC3339Time,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"time_selector": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "TimeGenerated",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r WorkspaceSummaryRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"active": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func (r WorkspaceSummaryRuleResource) ModelObject() interface{} {
+	return &WorkspaceSummaryRuleResourceModel{}
+}
+
+func (r WorkspaceSummaryRuleResource) ResourceType() string {
+	return "azurerm_log_analytics_workspace_summary_rule"
+}
+
+func (r WorkspaceSummaryRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateSummaryLogID
+}
+
+func (r WorkspaceSummaryRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SummaryLogsClient
+
+			var model WorkspaceSummaryRuleResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewSummaryLogID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			param := azuresdkhacks.SummaryLogs{
+				Properties: &azuresdkhacks.SummaryLogsProperties{
+					RuleType: pointer.To(azuresdkhacks.RuleTypeUser),
+					RuleDefinition: &azuresdkhacks.SummaryLogsRuleDefinition{
+						Query:            pointer.To(model.Query),
+						BinSize:          pointer.To(model.BinSizeInMinutes),
+						BinDelay:         pointer.To(model.BinDelayInMinutes),
+						TimeSelector:     pointer.To(model.TimeSelector),
+						DestinationTable: pointer.To(model.DestinationTable),
+					},
+				},
+			}
+
+			if model.BinStartTime != "" {
+				param.Properties.RuleDefinition.BinStartTime = pointer.To(model.BinStartTime)
+			}
+
+			if model.Description != "" {
+				param.Properties.Description = pointer.To(model.Description)
+			}
+
+			if model.DisplayName != "" {
+				param.Properties.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WorkspaceSummaryRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SummaryLogsClient
+
+			id, err := azuresdkhacks.ParseSummaryLogID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := WorkspaceSummaryRuleResourceModel{
+				Name:        id.SummaryLogName,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Active = pointer.From(props.IsActive)
+					state.Description = pointer.From(props.Description)
+					state.DisplayName = pointer.From(props.DisplayName)
+
+					if definition := props.RuleDefinition; definition != nil {
+						state.BinDelayInMinutes = pointer.From(definition.BinDelay)
+						state.BinSizeInMinutes = pointer.From(definition.BinSize)
+						state.BinStartTime = pointer.From(definition.BinStartTime)
+						state.DestinationTable = pointer.From(definition.DestinationTable)
+						state.Query = pointer.From(definition.Query)
+						state.TimeSelector = pointer.From(definition.TimeSelector)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WorkspaceSummaryRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SummaryLogsClient
+
+			id, err := azuresdkhacks.ParseSummaryLogID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config WorkspaceSummaryRuleResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := azuresdkhacks.SummaryLogs{
+				Properties: &azuresdkhacks.SummaryLogsProperties{
+					Description:    existing.Model.Properties.Description,
+					DisplayName:    existing.Model.Properties.DisplayName,
+					RuleDefinition: existing.Model.Properties.RuleDefinition,
+					RuleType:       existing.Model.Properties.RuleType,
+				},
+			}
+			props := payload.Properties
+
+			if props.RuleDefinition == nil {
+				props.RuleDefinition = &azuresdkhacks.SummaryLogsRuleDefinition{}
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				props.Description = pointer.To(config.Description)
+			}
+
+			if metadata.ResourceData.HasChange("display_name") {
+				props.DisplayName = pointer.To(config.DisplayName)
+			}
+
+			if metadata.ResourceData.HasChange("query") {
+				props.RuleDefinition.Query = pointer.To(config.Query)
+			}
+
+			if metadata.ResourceData.HasChange("bin_size_in_minutes") {
+				props.RuleDefinition.BinSize = pointer.To(config.BinSizeInMinutes)
+			}
+
+			if metadata.ResourceData.HasChange("bin_delay_in_minutes") {
+				props.RuleDefinition.BinDelay = pointer.To(config.BinDelayInMinutes)
+			}
+
+			if metadata.ResourceData.HasChange("time_selector") {
+				props.RuleDefinition.TimeSelector = pointer.To(config.TimeSelector)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WorkspaceSummaryRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SummaryLogsClient
+
+			id, err := azuresdkhacks.ParseSummaryLogID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
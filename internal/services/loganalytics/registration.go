@@ -35,6 +35,9 @@ func (r Registration) Resources() []sdk.Resource {
 		LogAnalyticsQueryPackQueryResource{},
 		LogAnalyticsSolutionResource{},
 		LogAnalyticsWorkspaceTableResource{},
+		WorkspaceRestoreTableResource{},
+		WorkspaceSearchTableResource{},
+		WorkspaceSummaryRuleResource{},
 		WorkspaceTableCustomLogResource{},
 	}
 }
@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/capabilityhost"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AIFoundryCapabilityHost struct{}
+
+type AIFoundryCapabilityHostModel struct {
+	Name                         string   `tfschema:"name"`
+	WorkspaceId                  string   `tfschema:"workspace_id"`
+	Kind                         string   `tfschema:"kind"`
+	AIServicesConnectionNames    []string `tfschema:"ai_services_connection_names"`
+	CustomerSubnetId             string   `tfschema:"customer_subnet_id"`
+	Description                  string   `tfschema:"description"`
+	StorageConnectionNames       []string `tfschema:"storage_connection_names"`
+	ThreadStorageConnectionNames []string `tfschema:"thread_storage_connection_names"`
+	VectorStoreConnectionNames   []string `tfschema:"vector_store_connection_names"`
+}
+
+var _ sdk.Resource = AIFoundryCapabilityHost{}
+
+func (r AIFoundryCapabilityHost) ModelObject() interface{} {
+	return &AIFoundryCapabilityHostModel{}
+}
+
+func (r AIFoundryCapabilityHost) ResourceType() string {
+	return "azurerm_ai_foundry_capability_host"
+}
+
+func (r AIFoundryCapabilityHost) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return capabilityhost.ValidateCapabilityHostID
+}
+
+// NOTE: the service doesn't support updating a Capability Host, so all arguments are ForceNew
+func (r AIFoundryCapabilityHost) Arguments() map[string]*pluginsdk.Schema {
+	connectionNamesSchema := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z0-9][a-zA-Z0-9_-]{2,32}$"),
+				"AI Foundry Capability Host name must be 3 - 33 characters long, start with a letter or number and contain only letters, numbers, underscores and hyphens.",
+			),
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"kind": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(capabilityhost.CapabilityHostKindAgents),
+			ValidateFunc: validation.StringInSlice(capabilityhost.PossibleValuesForCapabilityHostKind(), false),
+		},
+
+		"ai_services_connection_names": connectionNamesSchema(),
+
+		"customer_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSubnetID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"storage_connection_names": connectionNamesSchema(),
+
+		"thread_storage_connection_names": connectionNamesSchema(),
+
+		"vector_store_connection_names": connectionNamesSchema(),
+	}
+}
+
+func (r AIFoundryCapabilityHost) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AIFoundryCapabilityHost) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.CapabilityHosts
+
+			var model AIFoundryCapabilityHostModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := capabilityhost.NewCapabilityHostID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := capabilityhost.CapabilityHostResource{
+				Properties: capabilityhost.CapabilityHost{
+					CapabilityHostKind: pointer.To(capabilityhost.CapabilityHostKind(model.Kind)),
+				},
+			}
+
+			if len(model.AIServicesConnectionNames) > 0 {
+				payload.Properties.AiServicesConnections = pointer.To(model.AIServicesConnectionNames)
+			}
+
+			if model.CustomerSubnetId != "" {
+				payload.Properties.CustomerSubnet = pointer.To(model.CustomerSubnetId)
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if len(model.StorageConnectionNames) > 0 {
+				payload.Properties.StorageConnections = pointer.To(model.StorageConnectionNames)
+			}
+
+			if len(model.ThreadStorageConnectionNames) > 0 {
+				payload.Properties.ThreadStorageConnections = pointer.To(model.ThreadStorageConnectionNames)
+			}
+
+			if len(model.VectorStoreConnectionNames) > 0 {
+				payload.Properties.VectorStoreConnections = pointer.To(model.VectorStoreConnectionNames)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AIFoundryCapabilityHost) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.CapabilityHosts
+
+			id, err := capabilityhost.ParseCapabilityHostID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := AIFoundryCapabilityHostModel{
+				Name:        id.CapabilityHostName,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+
+				state.AIServicesConnectionNames = pointer.From(props.AiServicesConnections)
+				state.Description = pointer.From(props.Description)
+				state.Kind = string(pointer.From(props.CapabilityHostKind))
+				state.StorageConnectionNames = pointer.From(props.StorageConnections)
+				state.ThreadStorageConnectionNames = pointer.From(props.ThreadStorageConnections)
+				state.VectorStoreConnectionNames = pointer.From(props.VectorStoreConnections)
+
+				if v := pointer.From(props.CustomerSubnet); v != "" {
+					subnetId, err := commonids.ParseSubnetIDInsensitively(v)
+					if err != nil {
+						return err
+					}
+					state.CustomerSubnetId = subnetId.ID()
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AIFoundryCapabilityHost) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.CapabilityHosts
+
+			id, err := capabilityhost.ParseCapabilityHostID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
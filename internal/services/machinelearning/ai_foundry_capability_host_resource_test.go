@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/capabilityhost"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AIFoundryCapabilityHost struct{}
+
+func TestAccAIFoundryCapabilityHost_hub(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_capability_host", "test")
+	r := AIFoundryCapabilityHost{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hub(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAIFoundryCapabilityHost_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_capability_host", "test")
+	r := AIFoundryCapabilityHost{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hub(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAIFoundryCapabilityHost_project(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_capability_host", "test")
+	r := AIFoundryCapabilityHost{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.project(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("kind").HasValue("Agents"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (AIFoundryCapabilityHost) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := capabilityhost.ParseCapabilityHostID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MachineLearning.CapabilityHosts.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r AIFoundryCapabilityHost) hub(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_capability_host" "test" {
+  name         = "acctestcaphost%[2]d"
+  workspace_id = azurerm_ai_foundry.test.id
+  description  = "Agents capability host"
+}
+`, AIFoundry{}.basic(data), data.RandomIntOfLength(8))
+}
+
+func (r AIFoundryCapabilityHost) project(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_capability_host" "hub" {
+  name         = "acctestcaphub%[2]d"
+  workspace_id = azurerm_ai_foundry.test.id
+}
+
+resource "azurerm_ai_foundry_connection" "ai_services" {
+  name         = "acctestconnais%[2]d"
+  workspace_id = azurerm_ai_foundry_project.test.id
+  category     = "AIServices"
+  target       = azurerm_ai_services.test.endpoint
+  auth_type    = "AAD"
+
+  metadata = {
+    ApiType    = "Azure"
+    ResourceId = azurerm_ai_services.test.id
+  }
+}
+
+resource "azurerm_ai_foundry_connection" "storage" {
+  name         = "acctestconnsa%[2]d"
+  workspace_id = azurerm_ai_foundry_project.test.id
+  category     = "AzureBlob"
+  target       = azurerm_storage_account.test.primary_blob_endpoint
+  auth_type    = "AAD"
+
+  metadata = {
+    ResourceId    = azurerm_storage_account.test.id
+    AccountName   = azurerm_storage_account.test.name
+    ContainerName = "default"
+  }
+}
+
+resource "azurerm_ai_foundry_capability_host" "test" {
+  name                         = "acctestcaphost%[2]d"
+  workspace_id                 = azurerm_ai_foundry_project.test.id
+  ai_services_connection_names = [azurerm_ai_foundry_connection.ai_services.name]
+  storage_connection_names     = [azurerm_ai_foundry_connection.storage.name]
+
+  depends_on = [azurerm_ai_foundry_capability_host.hub]
+}
+`, AIFoundryProject{}.basic(data), data.RandomIntOfLength(8))
+}
+
+func (r AIFoundryCapabilityHost) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_capability_host" "import" {
+  name         = azurerm_ai_foundry_capability_host.test.name
+  workspace_id = azurerm_ai_foundry_capability_host.test.workspace_id
+  description  = azurerm_ai_foundry_capability_host.test.description
+}
+`, r.hub(data))
+}
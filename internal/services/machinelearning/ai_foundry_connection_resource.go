@@ -0,0 +1,398 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/v2workspaceconnectionresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AIFoundryConnection struct{}
+
+type AIFoundryConnectionModel struct {
+	Name                string            `tfschema:"name"`
+	WorkspaceId         string            `tfschema:"workspace_id"`
+	Category            string            `tfschema:"category"`
+	Target              string            `tfschema:"target"`
+	AuthType            string            `tfschema:"auth_type"`
+	ApiKeyWoVersion     int64             `tfschema:"api_key_wo_version"`
+	CustomKeysWoVersion int64             `tfschema:"custom_keys_wo_version"`
+	Metadata            map[string]string `tfschema:"metadata"`
+	SharedToAllEnabled  bool              `tfschema:"shared_to_all_enabled"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate        = AIFoundryConnection{}
+	_ sdk.ResourceWithCustomizeDiff = AIFoundryConnection{}
+)
+
+func (r AIFoundryConnection) ModelObject() interface{} {
+	return &AIFoundryConnectionModel{}
+}
+
+func (r AIFoundryConnection) ResourceType() string {
+	return "azurerm_ai_foundry_connection"
+}
+
+func (r AIFoundryConnection) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return v2workspaceconnectionresource.ValidateConnectionID
+}
+
+func (r AIFoundryConnection) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z0-9][a-zA-Z0-9_-]{2,254}$"),
+				"AI Foundry Connection name must be 3 - 255 characters long, start with a letter or number and contain only letters, numbers, underscores and hyphens.",
+			),
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"category": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(v2workspaceconnectionresource.PossibleValuesForConnectionCategory(), false),
+		},
+
+		"target": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"auth_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(v2workspaceconnectionresource.ConnectionAuthTypeAAD),
+				string(v2workspaceconnectionresource.ConnectionAuthTypeApiKey),
+				string(v2workspaceconnectionresource.ConnectionAuthTypeCustomKeys),
+				string(v2workspaceconnectionresource.ConnectionAuthTypeNone),
+			}, false),
+		},
+
+		"api_key_wo": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			WriteOnly:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			RequiredWith:  []string{"api_key_wo_version"},
+			ConflictsWith: []string{"custom_keys_wo"},
+		},
+
+		"api_key_wo_version": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			RequiredWith: []string{"api_key_wo"},
+		},
+
+		// NOTE: write-only arguments can't be maps, so the custom keys are specified as a JSON object
+		"custom_keys_wo": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			WriteOnly:     true,
+			ValidateFunc:  validation.StringIsJSON,
+			RequiredWith:  []string{"custom_keys_wo_version"},
+			ConflictsWith: []string{"api_key_wo"},
+		},
+
+		"custom_keys_wo_version": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			RequiredWith: []string{"custom_keys_wo"},
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"shared_to_all_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r AIFoundryConnection) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AIFoundryConnection) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			if !rd.NewValueKnown("auth_type") {
+				return nil
+			}
+
+			// the write-only credentials aren't available in the diff, so their presence is checked using their versions which are required with them
+			authType := v2workspaceconnectionresource.ConnectionAuthType(rd.Get("auth_type").(string))
+			apiKeySet := rd.Get("api_key_wo_version").(int) > 0
+			customKeysSet := rd.Get("custom_keys_wo_version").(int) > 0
+
+			switch authType {
+			case v2workspaceconnectionresource.ConnectionAuthTypeApiKey:
+				if !apiKeySet {
+					return fmt.Errorf("`api_key_wo` must be specified when `auth_type` is `%s`", authType)
+				}
+			case v2workspaceconnectionresource.ConnectionAuthTypeCustomKeys:
+				if !customKeysSet {
+					return fmt.Errorf("`custom_keys_wo` must be specified when `auth_type` is `%s`", authType)
+				}
+			default:
+				if apiKeySet || customKeysSet {
+					return fmt.Errorf("`api_key_wo` and `custom_keys_wo` cannot be specified when `auth_type` is `%s`", authType)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AIFoundryConnection) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Connections
+
+			var model AIFoundryConnectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := v2workspaceconnectionresource.NewConnectionID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.WorkspaceConnectionsGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandAIFoundryConnectionProperties(metadata.ResourceData, model)
+			if err != nil {
+				return err
+			}
+
+			payload := v2workspaceconnectionresource.WorkspaceConnectionPropertiesV2BasicResource{
+				Properties: properties,
+			}
+
+			if _, err := client.WorkspaceConnectionsCreate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AIFoundryConnection) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Connections
+
+			id, err := v2workspaceconnectionresource.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AIFoundryConnectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the credentials are never returned by the API, so the connection is replaced in full using the write-only values from the config
+			properties, err := expandAIFoundryConnectionProperties(metadata.ResourceData, model)
+			if err != nil {
+				return err
+			}
+
+			payload := v2workspaceconnectionresource.WorkspaceConnectionPropertiesV2BasicResource{
+				Properties: properties,
+			}
+
+			if _, err := client.WorkspaceConnectionsCreate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AIFoundryConnection) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Connections
+
+			id, err := v2workspaceconnectionresource.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.WorkspaceConnectionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := AIFoundryConnectionModel{
+				Name:                id.ConnectionName,
+				WorkspaceId:         workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+				ApiKeyWoVersion:     int64(metadata.ResourceData.Get("api_key_wo_version").(int)),
+				CustomKeysWoVersion: int64(metadata.ResourceData.Get("custom_keys_wo_version").(int)),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties.WorkspaceConnectionPropertiesV2()
+
+				state.AuthType = string(props.AuthType)
+				state.Category = string(pointer.From(props.Category))
+				state.Metadata = pointer.From(props.Metadata)
+				state.SharedToAllEnabled = pointer.From(props.IsSharedToAll)
+				state.Target = pointer.From(props.Target)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AIFoundryConnection) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Connections
+
+			id, err := v2workspaceconnectionresource.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.WorkspaceConnectionsDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandAIFoundryConnectionProperties(d *pluginsdk.ResourceData, model AIFoundryConnectionModel) (v2workspaceconnectionresource.WorkspaceConnectionPropertiesV2, error) {
+	category := v2workspaceconnectionresource.ConnectionCategory(model.Category)
+	var metadata *map[string]string
+	if len(model.Metadata) > 0 {
+		metadata = pointer.To(model.Metadata)
+	}
+
+	switch v2workspaceconnectionresource.ConnectionAuthType(model.AuthType) {
+	case v2workspaceconnectionresource.ConnectionAuthTypeApiKey:
+		apiKey, err := pluginsdk.GetWriteOnly(d, "api_key_wo", cty.String)
+		if err != nil {
+			return nil, err
+		}
+		if apiKey.IsNull() {
+			return nil, fmt.Errorf("`api_key_wo` must be specified when `auth_type` is `%s`", model.AuthType)
+		}
+
+		return v2workspaceconnectionresource.ApiKeyAuthWorkspaceConnectionProperties{
+			AuthType: v2workspaceconnectionresource.ConnectionAuthTypeApiKey,
+			Category: pointer.To(category),
+			Credentials: &v2workspaceconnectionresource.WorkspaceConnectionApiKey{
+				Key: pointer.To(apiKey.AsString()),
+			},
+			IsSharedToAll: pointer.To(model.SharedToAllEnabled),
+			Metadata:      metadata,
+			Target:        pointer.To(model.Target),
+		}, nil
+
+	case v2workspaceconnectionresource.ConnectionAuthTypeCustomKeys:
+		customKeys, err := pluginsdk.GetWriteOnly(d, "custom_keys_wo", cty.String)
+		if err != nil {
+			return nil, err
+		}
+		if customKeys.IsNull() {
+			return nil, fmt.Errorf("`custom_keys_wo` must be specified when `auth_type` is `%s`", model.AuthType)
+		}
+
+		keys := make(map[string]string)
+		if err := json.Unmarshal([]byte(customKeys.AsString()), &keys); err != nil {
+			return nil, fmt.Errorf("`custom_keys_wo` must be a JSON object with string values: %+v", err)
+		}
+
+		return v2workspaceconnectionresource.CustomKeysWorkspaceConnectionProperties{
+			AuthType: v2workspaceconnectionresource.ConnectionAuthTypeCustomKeys,
+			Category: pointer.To(category),
+			Credentials: &v2workspaceconnectionresource.CustomKeys{
+				Keys: pointer.To(keys),
+			},
+			IsSharedToAll: pointer.To(model.SharedToAllEnabled),
+			Metadata:      metadata,
+			Target:        pointer.To(model.Target),
+		}, nil
+
+	case v2workspaceconnectionresource.ConnectionAuthTypeNone:
+		return v2workspaceconnectionresource.NoneAuthTypeWorkspaceConnectionProperties{
+			AuthType:      v2workspaceconnectionresource.ConnectionAuthTypeNone,
+			Category:      pointer.To(category),
+			IsSharedToAll: pointer.To(model.SharedToAllEnabled),
+			Metadata:      metadata,
+			Target:        pointer.To(model.Target),
+		}, nil
+	}
+
+	return v2workspaceconnectionresource.AADAuthTypeWorkspaceConnectionProperties{
+		AuthType:      v2workspaceconnectionresource.ConnectionAuthTypeAAD,
+		Category:      pointer.To(category),
+		IsSharedToAll: pointer.To(model.SharedToAllEnabled),
+		Metadata:      metadata,
+		Target:        pointer.To(model.Target),
+	}, nil
+}
@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/v2workspaceconnectionresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AIFoundryConnection struct{}
+
+func TestAccAIFoundryConnection_aad(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnection{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.aad(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAIFoundryConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnection{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.aad(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAIFoundryConnection_apiKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnection{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.apiKey(data, "first-key", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("api_key_wo_version"),
+		{
+			Config: r.apiKey(data, "second-key", 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("api_key_wo_version"),
+		{
+			Config: r.aad(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAIFoundryConnection_customKeysProject(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnection{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customKeysProject(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("custom_keys_wo_version"),
+	})
+}
+
+func TestAccAIFoundryConnection_apiKeyMissing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnection{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.apiKeyMissing(data),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("`api_key_wo` must be specified when `auth_type` is `ApiKey`"),
+		},
+	})
+}
+
+func (AIFoundryConnection) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := v2workspaceconnectionresource.ParseConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MachineLearning.Connections.WorkspaceConnectionsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r AIFoundryConnection) aad(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "test" {
+  name         = "acctestconn-%[2]d"
+  workspace_id = azurerm_ai_foundry.test.id
+  category     = "AIServices"
+  target       = azurerm_ai_services.test.endpoint
+  auth_type    = "AAD"
+
+  metadata = {
+    ApiType    = "Azure"
+    ResourceId = azurerm_ai_services.test.id
+  }
+}
+`, AIFoundry{}.basic(data), data.RandomInteger)
+}
+
+func (r AIFoundryConnection) apiKey(data acceptance.TestData, apiKey string, version int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "test" {
+  name                  = "acctestconn-%[2]d"
+  workspace_id          = azurerm_ai_foundry.test.id
+  category              = "AIServices"
+  target                = azurerm_ai_services.test.endpoint
+  auth_type             = "ApiKey"
+  api_key_wo            = %[3]q
+  api_key_wo_version    = %[4]d
+  shared_to_all_enabled = true
+
+  metadata = {
+    ApiType    = "Azure"
+    ResourceId = azurerm_ai_services.test.id
+  }
+}
+`, AIFoundry{}.basic(data), data.RandomInteger, apiKey, version)
+}
+
+func (r AIFoundryConnection) customKeysProject(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "test" {
+  name         = "acctestconn-%[2]d"
+  workspace_id = azurerm_ai_foundry_project.test.id
+  category     = "CustomKeys"
+  target       = "https://api.example.com"
+  auth_type    = "CustomKeys"
+
+  custom_keys_wo = jsonencode({
+    x-api-key = "secret"
+    x-tenant  = "acctest"
+  })
+  custom_keys_wo_version = 1
+}
+`, AIFoundryProject{}.basic(data), data.RandomInteger)
+}
+
+func (r AIFoundryConnection) apiKeyMissing(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "test" {
+  name         = "acctestconn-%[2]d"
+  workspace_id = azurerm_ai_foundry.test.id
+  category     = "ApiKey"
+  target       = "https://api.example.com"
+  auth_type    = "ApiKey"
+}
+`, AIFoundry{}.basic(data), data.RandomInteger)
+}
+
+func (r AIFoundryConnection) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "import" {
+  name         = azurerm_ai_foundry_connection.test.name
+  workspace_id = azurerm_ai_foundry_connection.test.workspace_id
+  category     = azurerm_ai_foundry_connection.test.category
+  target       = azurerm_ai_foundry_connection.test.target
+  auth_type    = azurerm_ai_foundry_connection.test.auth_type
+  metadata     = azurerm_ai_foundry_connection.test.metadata
+}
+`, r.aad(data))
+}
@@ -6,16 +6,34 @@ package client
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/capabilityhost"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/datastore"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/environmentcontainer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/environmentversion"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/machinelearningcomputes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/managednetwork"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/modelcontainer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/modelversion"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/onlinedeployment"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/onlineendpoint"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/registrymanagement"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/v2workspaceconnectionresource"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
+	CapabilityHosts         *capabilityhost.CapabilityHostClient
+	Connections             *v2workspaceconnectionresource.V2WorkspaceConnectionResourceClient
 	Datastore               *datastore.DatastoreClient
+	EnvironmentContainers   *environmentcontainer.EnvironmentContainerClient
+	EnvironmentVersions     *environmentversion.EnvironmentVersionClient
 	MachineLearningComputes *machinelearningcomputes.MachineLearningComputesClient
+	ModelContainers         *modelcontainer.ModelContainerClient
+	ModelVersions           *modelversion.ModelVersionClient
+	OnlineDeployments       *onlinedeployment.OnlineDeploymentClient
+	OnlineEndpoints         *onlineendpoint.OnlineEndpointClient
+	Registries              *registrymanagement.RegistryManagementClient
 	Workspaces              *workspaces.WorkspacesClient
 	ManagedNetwork          *managednetwork.ManagedNetworkClient
 }
@@ -45,9 +63,72 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(managedNetworkClient.Client, o.Authorizers.ResourceManager)
 
+	capabilityHostsClient, err := capabilityhost.NewCapabilityHostClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building CapabilityHost client: %+v", err)
+	}
+	o.Configure(capabilityHostsClient.Client, o.Authorizers.ResourceManager)
+
+	connectionsClient, err := v2workspaceconnectionresource.NewV2WorkspaceConnectionResourceClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building WorkspaceConnection client: %+v", err)
+	}
+	o.Configure(connectionsClient.Client, o.Authorizers.ResourceManager)
+
+	onlineDeploymentsClient, err := onlinedeployment.NewOnlineDeploymentClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building OnlineDeployment client: %+v", err)
+	}
+	o.Configure(onlineDeploymentsClient.Client, o.Authorizers.ResourceManager)
+
+	onlineEndpointsClient, err := onlineendpoint.NewOnlineEndpointClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building OnlineEndpoint client: %+v", err)
+	}
+	o.Configure(onlineEndpointsClient.Client, o.Authorizers.ResourceManager)
+
+	environmentContainersClient, err := environmentcontainer.NewEnvironmentContainerClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building EnvironmentContainer client: %+v", err)
+	}
+	o.Configure(environmentContainersClient.Client, o.Authorizers.ResourceManager)
+
+	environmentVersionsClient, err := environmentversion.NewEnvironmentVersionClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building EnvironmentVersion client: %+v", err)
+	}
+	o.Configure(environmentVersionsClient.Client, o.Authorizers.ResourceManager)
+
+	modelContainersClient, err := modelcontainer.NewModelContainerClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ModelContainer client: %+v", err)
+	}
+	o.Configure(modelContainersClient.Client, o.Authorizers.ResourceManager)
+
+	modelVersionsClient, err := modelversion.NewModelVersionClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ModelVersion client: %+v", err)
+	}
+	o.Configure(modelVersionsClient.Client, o.Authorizers.ResourceManager)
+
+	registriesClient, err := registrymanagement.NewRegistryManagementClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building RegistryManagement client: %+v", err)
+	}
+	o.Configure(registriesClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
+		CapabilityHosts:         capabilityHostsClient,
+		Connections:             connectionsClient,
 		MachineLearningComputes: computesClient,
 		Datastore:               datastoreClient,
+		EnvironmentContainers:   environmentContainersClient,
+		EnvironmentVersions:     environmentVersionsClient,
+		ModelContainers:         modelContainersClient,
+		ModelVersions:           modelVersionsClient,
+		OnlineDeployments:       onlineDeploymentsClient,
+		OnlineEndpoints:         onlineEndpointsClient,
+		Registries:              registriesClient,
 		Workspaces:              workspacesClient,
 		ManagedNetwork:          managedNetworkClient,
 	}, nil
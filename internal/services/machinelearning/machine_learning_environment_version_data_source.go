@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/environmentcontainer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/environmentversion"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/registrymanagement"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MachineLearningEnvironmentVersionDataSource struct{}
+
+type MachineLearningEnvironmentVersionDataSourceModel struct {
+	Name         string                                          `tfschema:"name"`
+	Version      string                                          `tfschema:"version"`
+	WorkspaceId  string                                          `tfschema:"workspace_id"`
+	RegistryId   string                                          `tfschema:"registry_id"`
+	Image        string                                          `tfschema:"image"`
+	BuildContext []MachineLearningEnvironmentVersionBuildContext `tfschema:"build_context"`
+	CondaFile    string                                          `tfschema:"conda_file"`
+	OsType       string                                          `tfschema:"os_type"`
+	Description  string                                          `tfschema:"description"`
+	Tags         map[string]string                               `tfschema:"tags"`
+	AssetId      string                                          `tfschema:"asset_id"`
+}
+
+var _ sdk.DataSource = MachineLearningEnvironmentVersionDataSource{}
+
+func (d MachineLearningEnvironmentVersionDataSource) ResourceType() string {
+	return "azurerm_machine_learning_environment_version"
+}
+
+func (d MachineLearningEnvironmentVersionDataSource) ModelObject() interface{} {
+	return &MachineLearningEnvironmentVersionDataSourceModel{}
+}
+
+func (d MachineLearningEnvironmentVersionDataSource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.Any(environmentversion.ValidateEnvironmentVersionID, environmentversion.ValidateRegistryEnvironmentVersionID)
+}
+
+func (d MachineLearningEnvironmentVersionDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.AssetName,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+			ExactlyOneOf: []string{"workspace_id", "registry_id"},
+		},
+
+		"registry_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: registrymanagement.ValidateRegistryID,
+			ExactlyOneOf: []string{"workspace_id", "registry_id"},
+		},
+
+		// when omitted the latest version of the environment is looked up
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.AssetName,
+		},
+	}
+}
+
+func (d MachineLearningEnvironmentVersionDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"asset_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"build_context": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"context_uri": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"dockerfile_path": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"conda_file": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"image": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"os_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (d MachineLearningEnvironmentVersionDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.EnvironmentVersions
+			containersClient := metadata.Client.MachineLearning.EnvironmentContainers
+
+			var model MachineLearningEnvironmentVersionDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := MachineLearningEnvironmentVersionDataSourceModel{
+				Name:        model.Name,
+				WorkspaceId: model.WorkspaceId,
+				RegistryId:  model.RegistryId,
+			}
+			var resource *environmentversion.EnvironmentVersionResource
+
+			if model.WorkspaceId != "" {
+				workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+				if err != nil {
+					return err
+				}
+
+				version := model.Version
+				if version == "" {
+					containerId := environmentcontainer.NewEnvironmentID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+					container, err := containersClient.Get(ctx, containerId)
+					if err != nil {
+						if response.WasNotFound(container.HttpResponse) {
+							return fmt.Errorf("%s was not found", containerId)
+						}
+						return fmt.Errorf("retrieving %s: %+v", containerId, err)
+					}
+
+					if container.Model == nil || container.Model.Properties.LatestVersion == nil {
+						return fmt.Errorf("retrieving %s: `latestVersion` was nil", containerId)
+					}
+					version = *container.Model.Properties.LatestVersion
+				}
+
+				id := environmentversion.NewEnvironmentVersionID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name, version)
+				resp, err := client.Get(ctx, id)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return fmt.Errorf("%s was not found", id)
+					}
+					return fmt.Errorf("retrieving %s: %+v", id, err)
+				}
+
+				metadata.SetID(id)
+				state.Version = id.VersionName
+				state.AssetId = id.ID()
+				resource = resp.Model
+			} else {
+				registryId, err := registrymanagement.ParseRegistryID(model.RegistryId)
+				if err != nil {
+					return err
+				}
+
+				version := model.Version
+				if version == "" {
+					containerId := environmentcontainer.NewRegistryEnvironmentID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, model.Name)
+					container, err := containersClient.RegistryEnvironmentContainersGet(ctx, containerId)
+					if err != nil {
+						if response.WasNotFound(container.HttpResponse) {
+							return fmt.Errorf("%s was not found", containerId)
+						}
+						return fmt.Errorf("retrieving %s: %+v", containerId, err)
+					}
+
+					if container.Model == nil || container.Model.Properties.LatestVersion == nil {
+						return fmt.Errorf("retrieving %s: `latestVersion` was nil", containerId)
+					}
+					version = *container.Model.Properties.LatestVersion
+				}
+
+				id := environmentversion.NewRegistryEnvironmentVersionID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, model.Name, version)
+				resp, err := client.RegistryEnvironmentVersionsGet(ctx, id)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return fmt.Errorf("%s was not found", id)
+					}
+					return fmt.Errorf("retrieving %s: %+v", id, err)
+				}
+
+				metadata.SetID(id)
+				state.Version = id.VersionName
+				state.AssetId = fmt.Sprintf("azureml://registries/%s/environments/%s/versions/%s", id.RegistryName, id.EnvironmentName, id.VersionName)
+				resource = resp.Model
+			}
+
+			if resource != nil {
+				props := resource.Properties
+				state.Description = pointer.From(props.Description)
+				state.Image = pointer.From(props.Image)
+				state.CondaFile = pointer.From(props.CondaFile)
+				state.OsType = string(pointer.From(props.OsType))
+
+				if build := props.Build; build != nil {
+					state.BuildContext = []MachineLearningEnvironmentVersionBuildContext{
+						{
+							ContextUri:     build.ContextUri,
+							DockerfilePath: pointer.From(build.DockerfilePath),
+						},
+					}
+				}
+				state.Tags = pointer.From(props.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
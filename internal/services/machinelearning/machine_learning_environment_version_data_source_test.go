@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MachineLearningEnvironmentVersionDataSource struct{}
+
+func TestAccMachineLearningEnvironmentVersionDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_machine_learning_environment_version", "test")
+	r := MachineLearningEnvironmentVersionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("version").HasValue("1"),
+				check.That(data.ResourceName).Key("image").Exists(),
+				check.That(data.ResourceName).Key("os_type").HasValue("Linux"),
+				check.That(data.ResourceName).Key("asset_id").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccMachineLearningEnvironmentVersionDataSource_latest(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_machine_learning_environment_version", "test")
+	r := MachineLearningEnvironmentVersionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.latest(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("version").HasValue("2"),
+				check.That(data.ResourceName).Key("description").HasValue("second version"),
+			),
+		},
+	})
+}
+
+func (MachineLearningEnvironmentVersionDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_machine_learning_environment_version" "test" {
+  name         = azurerm_machine_learning_environment_version.test.name
+  version      = azurerm_machine_learning_environment_version.test.version
+  workspace_id = azurerm_machine_learning_environment_version.test.workspace_id
+}
+`, MachineLearningEnvironmentVersionResource{}.basic(data))
+}
+
+func (MachineLearningEnvironmentVersionDataSource) latest(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_environment_version" "second" {
+  name         = azurerm_machine_learning_environment_version.test.name
+  version      = "2"
+  workspace_id = azurerm_machine_learning_workspace.test.id
+  image        = azurerm_machine_learning_environment_version.test.image
+  description  = "second version"
+}
+
+data "azurerm_machine_learning_environment_version" "test" {
+  name         = azurerm_machine_learning_environment_version.second.name
+  workspace_id = azurerm_machine_learning_environment_version.second.workspace_id
+}
+`, MachineLearningEnvironmentVersionResource{}.basic(data))
+}
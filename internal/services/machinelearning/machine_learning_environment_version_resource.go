@@ -0,0 +1,355 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/environmentversion"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/registrymanagement"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MachineLearningEnvironmentVersion struct{}
+
+type MachineLearningEnvironmentVersionModel struct {
+	Name         string                                          `tfschema:"name"`
+	Version      string                                          `tfschema:"version"`
+	WorkspaceId  string                                          `tfschema:"workspace_id"`
+	RegistryId   string                                          `tfschema:"registry_id"`
+	Image        string                                          `tfschema:"image"`
+	BuildContext []MachineLearningEnvironmentVersionBuildContext `tfschema:"build_context"`
+	CondaFile    string                                          `tfschema:"conda_file"`
+	OsType       string                                          `tfschema:"os_type"`
+	Description  string                                          `tfschema:"description"`
+	Tags         map[string]string                               `tfschema:"tags"`
+	AssetId      string                                          `tfschema:"asset_id"`
+}
+
+type MachineLearningEnvironmentVersionBuildContext struct {
+	ContextUri     string `tfschema:"context_uri"`
+	DockerfilePath string `tfschema:"dockerfile_path"`
+}
+
+var _ sdk.Resource = MachineLearningEnvironmentVersion{}
+
+func (r MachineLearningEnvironmentVersion) ModelObject() interface{} {
+	return &MachineLearningEnvironmentVersionModel{}
+}
+
+func (r MachineLearningEnvironmentVersion) ResourceType() string {
+	return "azurerm_machine_learning_environment_version"
+}
+
+func (r MachineLearningEnvironmentVersion) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.Any(environmentversion.ValidateEnvironmentVersionID, environmentversion.ValidateRegistryEnvironmentVersionID)
+}
+
+// NOTE: versions of an asset are immutable, so all arguments are ForceNew
+func (r MachineLearningEnvironmentVersion) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.AssetName,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.AssetName,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+			ExactlyOneOf: []string{"workspace_id", "registry_id"},
+		},
+
+		"registry_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: registrymanagement.ValidateRegistryID,
+			ExactlyOneOf: []string{"workspace_id", "registry_id"},
+		},
+
+		"image": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"image", "build_context"},
+		},
+
+		"build_context": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"image", "build_context"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"context_uri": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"dockerfile_path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      "Dockerfile",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"conda_file": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"os_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(environmentversion.OperatingSystemTypeLinux),
+			ValidateFunc: validation.StringInSlice(environmentversion.PossibleValuesForOperatingSystemType(), false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r MachineLearningEnvironmentVersion) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"asset_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MachineLearningEnvironmentVersion) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.EnvironmentVersions
+
+			var model MachineLearningEnvironmentVersionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := environmentversion.EnvironmentVersionResource{
+				Properties: environmentversion.EnvironmentVersion{
+					OsType: pointer.To(environmentversion.OperatingSystemType(model.OsType)),
+				},
+			}
+
+			if model.Image != "" {
+				payload.Properties.Image = pointer.To(model.Image)
+			}
+
+			if len(model.BuildContext) > 0 {
+				payload.Properties.Build = &environmentversion.BuildContext{
+					ContextUri:     model.BuildContext[0].ContextUri,
+					DockerfilePath: pointer.To(model.BuildContext[0].DockerfilePath),
+				}
+			}
+
+			if model.CondaFile != "" {
+				payload.Properties.CondaFile = pointer.To(model.CondaFile)
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if len(model.Tags) > 0 {
+				payload.Properties.Tags = pointer.To(model.Tags)
+			}
+
+			if model.WorkspaceId != "" {
+				workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+				if err != nil {
+					return err
+				}
+
+				id := environmentversion.NewEnvironmentVersionID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name, model.Version)
+
+				existing, err := client.Get(ctx, id)
+				if err != nil {
+					if !response.WasNotFound(existing.HttpResponse) {
+						return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+					}
+				}
+				if !response.WasNotFound(existing.HttpResponse) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+
+				if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+					return fmt.Errorf("creating %s: %+v", id, err)
+				}
+
+				metadata.SetID(id)
+				return nil
+			}
+
+			registryId, err := registrymanagement.ParseRegistryID(model.RegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := environmentversion.NewRegistryEnvironmentVersionID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, model.Name, model.Version)
+
+			existing, err := client.RegistryEnvironmentVersionsGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.RegistryEnvironmentVersionsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningEnvironmentVersion) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.EnvironmentVersions
+
+			var state MachineLearningEnvironmentVersionModel
+			var resource *environmentversion.EnvironmentVersionResource
+
+			if id, err := environmentversion.ParseEnvironmentVersionID(metadata.ResourceData.Id()); err == nil {
+				resp, err := client.Get(ctx, *id)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				state = MachineLearningEnvironmentVersionModel{
+					Name:        id.EnvironmentName,
+					Version:     id.VersionName,
+					WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+					AssetId:     id.ID(),
+				}
+				resource = resp.Model
+			} else {
+				id, err := environmentversion.ParseRegistryEnvironmentVersionID(metadata.ResourceData.Id())
+				if err != nil {
+					return err
+				}
+
+				resp, err := client.RegistryEnvironmentVersionsGet(ctx, *id)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				state = MachineLearningEnvironmentVersionModel{
+					Name:       id.EnvironmentName,
+					Version:    id.VersionName,
+					RegistryId: registrymanagement.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+					AssetId:    fmt.Sprintf("azureml://registries/%s/environments/%s/versions/%s", id.RegistryName, id.EnvironmentName, id.VersionName),
+				}
+				resource = resp.Model
+			}
+
+			if resource != nil {
+				props := resource.Properties
+				state.Description = pointer.From(props.Description)
+				state.Image = pointer.From(props.Image)
+				state.CondaFile = pointer.From(props.CondaFile)
+				state.OsType = string(pointer.From(props.OsType))
+
+				if build := props.Build; build != nil {
+					state.BuildContext = []MachineLearningEnvironmentVersionBuildContext{
+						{
+							ContextUri:     build.ContextUri,
+							DockerfilePath: pointer.From(build.DockerfilePath),
+						},
+					}
+				}
+				state.Tags = pointer.From(props.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningEnvironmentVersion) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.EnvironmentVersions
+
+			if id, err := environmentversion.ParseEnvironmentVersionID(metadata.ResourceData.Id()); err == nil {
+				if _, err := client.Delete(ctx, *id); err != nil {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+				return nil
+			}
+
+			id, err := environmentversion.ParseRegistryEnvironmentVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.RegistryEnvironmentVersionsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
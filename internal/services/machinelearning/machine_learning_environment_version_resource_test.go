@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/environmentversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MachineLearningEnvironmentVersionResource struct{}
+
+func TestAccMachineLearningEnvironmentVersion_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_environment_version", "test")
+	r := MachineLearningEnvironmentVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("asset_id").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningEnvironmentVersion_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_environment_version", "test")
+	r := MachineLearningEnvironmentVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMachineLearningEnvironmentVersion_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_environment_version", "test")
+	r := MachineLearningEnvironmentVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningEnvironmentVersion_registry(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_environment_version", "test")
+	r := MachineLearningEnvironmentVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.registry(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("asset_id").HasValue(fmt.Sprintf("azureml://registries/acctestmlreg%d/environments/acctest-env/versions/1", data.RandomIntOfLength(8))),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MachineLearningEnvironmentVersionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if id, err := environmentversion.ParseEnvironmentVersionID(state.ID); err == nil {
+		resp, err := clients.MachineLearning.EnvironmentVersions.Get(ctx, *id)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		return pointer.To(resp.Model != nil), nil
+	}
+
+	id, err := environmentversion.ParseRegistryEnvironmentVersionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MachineLearning.EnvironmentVersions.RegistryEnvironmentVersionsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MachineLearningEnvironmentVersionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_environment_version" "test" {
+  name         = "acctest-env"
+  version      = "1"
+  workspace_id = azurerm_machine_learning_workspace.test.id
+  image        = "mcr.microsoft.com/azureml/openmpi4.1.0-ubuntu20.04:latest"
+}
+`, WorkspaceResource{}.basic(data))
+}
+
+func (r MachineLearningEnvironmentVersionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_environment_version" "import" {
+  name         = azurerm_machine_learning_environment_version.test.name
+  version      = azurerm_machine_learning_environment_version.test.version
+  workspace_id = azurerm_machine_learning_environment_version.test.workspace_id
+  image        = azurerm_machine_learning_environment_version.test.image
+}
+`, r.basic(data))
+}
+
+func (r MachineLearningEnvironmentVersionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_environment_version" "test" {
+  name         = "acctest-env"
+  version      = "2"
+  workspace_id = azurerm_machine_learning_workspace.test.id
+  image        = "mcr.microsoft.com/azureml/openmpi4.1.0-ubuntu20.04:latest"
+  os_type      = "Linux"
+  description  = "acceptance test environment"
+
+  conda_file = <<YAML
+name: acctest
+channels:
+  - conda-forge
+dependencies:
+  - python=3.10
+  - pip
+YAML
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, WorkspaceResource{}.basic(data))
+}
+
+func (r MachineLearningEnvironmentVersionResource) registry(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_registry" "test" {
+  name                = "acctestmlreg%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_machine_learning_environment_version" "test" {
+  name        = "acctest-env"
+  version     = "1"
+  registry_id = azurerm_machine_learning_registry.test.id
+  image       = "mcr.microsoft.com/azureml/openmpi4.1.0-ubuntu20.04:latest"
+}
+`, WorkspaceResource{}.basic(data), data.RandomIntOfLength(8))
+}
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/modelcontainer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/modelversion"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/registrymanagement"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MachineLearningModelVersionDataSource struct{}
+
+type MachineLearningModelVersionDataSourceModel struct {
+	Name        string            `tfschema:"name"`
+	Version     string            `tfschema:"version"`
+	WorkspaceId string            `tfschema:"workspace_id"`
+	RegistryId  string            `tfschema:"registry_id"`
+	ModelUri    string            `tfschema:"model_uri"`
+	ModelType   string            `tfschema:"model_type"`
+	Description string            `tfschema:"description"`
+	Properties  map[string]string `tfschema:"properties"`
+	Tags        map[string]string `tfschema:"tags"`
+	AssetId     string            `tfschema:"asset_id"`
+}
+
+var _ sdk.DataSource = MachineLearningModelVersionDataSource{}
+
+func (d MachineLearningModelVersionDataSource) ResourceType() string {
+	return "azurerm_machine_learning_model_version"
+}
+
+func (d MachineLearningModelVersionDataSource) ModelObject() interface{} {
+	return &MachineLearningModelVersionDataSourceModel{}
+}
+
+func (d MachineLearningModelVersionDataSource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.Any(modelversion.ValidateModelVersionID, modelversion.ValidateRegistryModelVersionID)
+}
+
+func (d MachineLearningModelVersionDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.AssetName,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+			ExactlyOneOf: []string{"workspace_id", "registry_id"},
+		},
+
+		"registry_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: registrymanagement.ValidateRegistryID,
+			ExactlyOneOf: []string{"workspace_id", "registry_id"},
+		},
+
+		// when omitted the latest version of the model is looked up
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.AssetName,
+		},
+	}
+}
+
+func (d MachineLearningModelVersionDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"asset_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"model_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"model_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"properties": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (d MachineLearningModelVersionDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.ModelVersions
+			containersClient := metadata.Client.MachineLearning.ModelContainers
+
+			var model MachineLearningModelVersionDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := MachineLearningModelVersionDataSourceModel{
+				Name:        model.Name,
+				WorkspaceId: model.WorkspaceId,
+				RegistryId:  model.RegistryId,
+			}
+			var resource *modelversion.ModelVersionResource
+
+			if model.WorkspaceId != "" {
+				workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+				if err != nil {
+					return err
+				}
+
+				version := model.Version
+				if version == "" {
+					containerId := modelcontainer.NewModelID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+					container, err := containersClient.Get(ctx, containerId)
+					if err != nil {
+						if response.WasNotFound(container.HttpResponse) {
+							return fmt.Errorf("%s was not found", containerId)
+						}
+						return fmt.Errorf("retrieving %s: %+v", containerId, err)
+					}
+
+					if container.Model == nil || container.Model.Properties.LatestVersion == nil {
+						return fmt.Errorf("retrieving %s: `latestVersion` was nil", containerId)
+					}
+					version = *container.Model.Properties.LatestVersion
+				}
+
+				id := modelversion.NewModelVersionID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name, version)
+				resp, err := client.Get(ctx, id)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return fmt.Errorf("%s was not found", id)
+					}
+					return fmt.Errorf("retrieving %s: %+v", id, err)
+				}
+
+				metadata.SetID(id)
+				state.Version = id.VersionName
+				state.AssetId = id.ID()
+				resource = resp.Model
+			} else {
+				registryId, err := registrymanagement.ParseRegistryID(model.RegistryId)
+				if err != nil {
+					return err
+				}
+
+				version := model.Version
+				if version == "" {
+					containerId := modelcontainer.NewRegistryModelID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, model.Name)
+					container, err := containersClient.RegistryModelContainersGet(ctx, containerId)
+					if err != nil {
+						if response.WasNotFound(container.HttpResponse) {
+							return fmt.Errorf("%s was not found", containerId)
+						}
+						return fmt.Errorf("retrieving %s: %+v", containerId, err)
+					}
+
+					if container.Model == nil || container.Model.Properties.LatestVersion == nil {
+						return fmt.Errorf("retrieving %s: `latestVersion` was nil", containerId)
+					}
+					version = *container.Model.Properties.LatestVersion
+				}
+
+				id := modelversion.NewRegistryModelVersionID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, model.Name, version)
+				resp, err := client.RegistryModelVersionsGet(ctx, id)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return fmt.Errorf("%s was not found", id)
+					}
+					return fmt.Errorf("retrieving %s: %+v", id, err)
+				}
+
+				metadata.SetID(id)
+				state.Version = id.VersionName
+				state.AssetId = fmt.Sprintf("azureml://registries/%s/models/%s/versions/%s", id.RegistryName, id.ModelName, id.VersionName)
+				resource = resp.Model
+			}
+
+			if resource != nil {
+				props := resource.Properties
+				state.Description = pointer.From(props.Description)
+				state.ModelType = pointer.From(props.ModelType)
+				state.ModelUri = pointer.From(props.ModelUri)
+				state.Properties = pointer.From(props.Properties)
+				state.Tags = pointer.From(props.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/modelversion"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/registrymanagement"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MachineLearningModelVersion struct{}
+
+type MachineLearningModelVersionModel struct {
+	Name        string            `tfschema:"name"`
+	Version     string            `tfschema:"version"`
+	WorkspaceId string            `tfschema:"workspace_id"`
+	RegistryId  string            `tfschema:"registry_id"`
+	ModelUri    string            `tfschema:"model_uri"`
+	ModelType   string            `tfschema:"model_type"`
+	Description string            `tfschema:"description"`
+	Properties  map[string]string `tfschema:"properties"`
+	Tags        map[string]string `tfschema:"tags"`
+	AssetId     string            `tfschema:"asset_id"`
+}
+
+var _ sdk.Resource = MachineLearningModelVersion{}
+
+func (r MachineLearningModelVersion) ModelObject() interface{} {
+	return &MachineLearningModelVersionModel{}
+}
+
+func (r MachineLearningModelVersion) ResourceType() string {
+	return "azurerm_machine_learning_model_version"
+}
+
+func (r MachineLearningModelVersion) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.Any(modelversion.ValidateModelVersionID, modelversion.ValidateRegistryModelVersionID)
+}
+
+// NOTE: versions of an asset are immutable, so all arguments are ForceNew
+func (r MachineLearningModelVersion) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.AssetName,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.AssetName,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+			ExactlyOneOf: []string{"workspace_id", "registry_id"},
+		},
+
+		"registry_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: registrymanagement.ValidateRegistryID,
+			ExactlyOneOf: []string{"workspace_id", "registry_id"},
+		},
+
+		"model_uri": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"model_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "custom_model",
+			ValidateFunc: validation.StringInSlice([]string{
+				"custom_model",
+				"mlflow_model",
+				"triton_model",
+			}, false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"properties": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r MachineLearningModelVersion) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"asset_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MachineLearningModelVersion) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.ModelVersions
+
+			var model MachineLearningModelVersionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := modelversion.ModelVersionResource{
+				Properties: modelversion.ModelVersion{
+					ModelType: pointer.To(model.ModelType),
+					ModelUri:  pointer.To(model.ModelUri),
+				},
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if len(model.Properties) > 0 {
+				payload.Properties.Properties = pointer.To(model.Properties)
+			}
+
+			if len(model.Tags) > 0 {
+				payload.Properties.Tags = pointer.To(model.Tags)
+			}
+
+			if model.WorkspaceId != "" {
+				workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+				if err != nil {
+					return err
+				}
+
+				id := modelversion.NewModelVersionID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name, model.Version)
+
+				existing, err := client.Get(ctx, id)
+				if err != nil {
+					if !response.WasNotFound(existing.HttpResponse) {
+						return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+					}
+				}
+				if !response.WasNotFound(existing.HttpResponse) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+
+				if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+					return fmt.Errorf("creating %s: %+v", id, err)
+				}
+
+				metadata.SetID(id)
+				return nil
+			}
+
+			registryId, err := registrymanagement.ParseRegistryID(model.RegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := modelversion.NewRegistryModelVersionID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, model.Name, model.Version)
+
+			existing, err := client.RegistryModelVersionsGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.RegistryModelVersionsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningModelVersion) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.ModelVersions
+
+			var state MachineLearningModelVersionModel
+			var resource *modelversion.ModelVersionResource
+
+			if id, err := modelversion.ParseModelVersionID(metadata.ResourceData.Id()); err == nil {
+				resp, err := client.Get(ctx, *id)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				state = MachineLearningModelVersionModel{
+					Name:        id.ModelName,
+					Version:     id.VersionName,
+					WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+					AssetId:     id.ID(),
+				}
+				resource = resp.Model
+			} else {
+				id, err := modelversion.ParseRegistryModelVersionID(metadata.ResourceData.Id())
+				if err != nil {
+					return err
+				}
+
+				resp, err := client.RegistryModelVersionsGet(ctx, *id)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				state = MachineLearningModelVersionModel{
+					Name:       id.ModelName,
+					Version:    id.VersionName,
+					RegistryId: registrymanagement.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+					AssetId:    fmt.Sprintf("azureml://registries/%s/models/%s/versions/%s", id.RegistryName, id.ModelName, id.VersionName),
+				}
+				resource = resp.Model
+			}
+
+			if resource != nil {
+				props := resource.Properties
+				state.Description = pointer.From(props.Description)
+				state.ModelType = pointer.From(props.ModelType)
+				state.ModelUri = pointer.From(props.ModelUri)
+				state.Properties = pointer.From(props.Properties)
+				state.Tags = pointer.From(props.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningModelVersion) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.ModelVersions
+
+			if id, err := modelversion.ParseModelVersionID(metadata.ResourceData.Id()); err == nil {
+				if _, err := client.Delete(ctx, *id); err != nil {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+				return nil
+			}
+
+			id, err := modelversion.ParseRegistryModelVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.RegistryModelVersionsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/modelversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MachineLearningModelVersionResource struct{}
+
+func TestAccMachineLearningModelVersion_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_model_version", "test")
+	r := MachineLearningModelVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("asset_id").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningModelVersion_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_model_version", "test")
+	r := MachineLearningModelVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMachineLearningModelVersion_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_model_version", "test")
+	r := MachineLearningModelVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningModelVersion_registry(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_model_version", "test")
+	r := MachineLearningModelVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.registry(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("asset_id").HasValue(fmt.Sprintf("azureml://registries/acctestmlreg%d/models/acctest-model/versions/1", data.RandomIntOfLength(8))),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MachineLearningModelVersionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if id, err := modelversion.ParseModelVersionID(state.ID); err == nil {
+		resp, err := clients.MachineLearning.ModelVersions.Get(ctx, *id)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		return pointer.To(resp.Model != nil), nil
+	}
+
+	id, err := modelversion.ParseRegistryModelVersionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MachineLearning.ModelVersions.RegistryModelVersionsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MachineLearningModelVersionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container" "model" {
+  name                  = "models"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "model" {
+  name                   = "acctest/model.pkl"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.model.name
+  type                   = "Block"
+  source_content         = "model"
+}
+`, WorkspaceResource{}.basic(data))
+}
+
+func (r MachineLearningModelVersionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_model_version" "test" {
+  name         = "acctest-model"
+  version      = "1"
+  workspace_id = azurerm_machine_learning_workspace.test.id
+  model_uri    = azurerm_storage_blob.model.url
+}
+`, r.template(data))
+}
+
+func (r MachineLearningModelVersionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_model_version" "import" {
+  name         = azurerm_machine_learning_model_version.test.name
+  version      = azurerm_machine_learning_model_version.test.version
+  workspace_id = azurerm_machine_learning_model_version.test.workspace_id
+  model_uri    = azurerm_machine_learning_model_version.test.model_uri
+}
+`, r.basic(data))
+}
+
+func (r MachineLearningModelVersionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_model_version" "test" {
+  name         = "acctest-model"
+  version      = "2.0.1"
+  workspace_id = azurerm_machine_learning_workspace.test.id
+  model_uri    = azurerm_storage_blob.model.url
+  model_type   = "custom_model"
+  description  = "acceptance test model"
+
+  properties = {
+    framework = "sklearn"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data))
+}
+
+func (r MachineLearningModelVersionResource) registry(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_registry" "test" {
+  name                = "acctestmlreg%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_machine_learning_model_version" "test" {
+  name        = "acctest-model"
+  version     = "1"
+  registry_id = azurerm_machine_learning_registry.test.id
+  model_uri   = azurerm_storage_blob.model.url
+}
+`, r.template(data), data.RandomIntOfLength(8))
+}
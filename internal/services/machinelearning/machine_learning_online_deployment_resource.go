@@ -0,0 +1,612 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/onlinedeployment"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/onlineendpoint"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// machineLearningOnlineDeploymentLogsTail is the number of lines of the inference server logs which are included in the
+// error returned when a Deployment fails to provision
+const machineLearningOnlineDeploymentLogsTail = 50
+
+type MachineLearningOnlineDeployment struct{}
+
+type MachineLearningOnlineDeploymentModel struct {
+	Name                             string                                        `tfschema:"name"`
+	OnlineEndpointId                 string                                        `tfschema:"online_endpoint_id"`
+	InstanceType                     string                                        `tfschema:"instance_type"`
+	AppInsightsEnabled               bool                                          `tfschema:"app_insights_enabled"`
+	CodeConfiguration                []MachineLearningOnlineDeploymentCodeModel    `tfschema:"code_configuration"`
+	Description                      string                                        `tfschema:"description"`
+	EgressPublicNetworkAccessEnabled bool                                          `tfschema:"egress_public_network_access_enabled"`
+	EnvironmentId                    string                                        `tfschema:"environment_id"`
+	EnvironmentVariables             map[string]string                             `tfschema:"environment_variables"`
+	InstanceCount                    int64                                         `tfschema:"instance_count"`
+	LivenessProbe                    []MachineLearningOnlineDeploymentProbeModel   `tfschema:"liveness_probe"`
+	ModelId                          string                                        `tfschema:"model_id"`
+	ReadinessProbe                   []MachineLearningOnlineDeploymentProbeModel   `tfschema:"readiness_probe"`
+	RequestSettings                  []MachineLearningOnlineDeploymentRequestModel `tfschema:"request_settings"`
+	TrafficPercentage                int64                                         `tfschema:"traffic_percentage"`
+	Tags                             map[string]interface{}                        `tfschema:"tags"`
+}
+
+type MachineLearningOnlineDeploymentCodeModel struct {
+	CodeId        string `tfschema:"code_id"`
+	ScoringScript string `tfschema:"scoring_script"`
+}
+
+type MachineLearningOnlineDeploymentProbeModel struct {
+	FailureThreshold int64  `tfschema:"failure_threshold"`
+	InitialDelay     string `tfschema:"initial_delay"`
+	Period           string `tfschema:"period"`
+	SuccessThreshold int64  `tfschema:"success_threshold"`
+	Timeout          string `tfschema:"timeout"`
+}
+
+type MachineLearningOnlineDeploymentRequestModel struct {
+	MaxConcurrentRequestsPerInstance int64  `tfschema:"max_concurrent_requests_per_instance"`
+	RequestTimeout                   string `tfschema:"request_timeout"`
+}
+
+var _ sdk.ResourceWithUpdate = MachineLearningOnlineDeployment{}
+
+func (r MachineLearningOnlineDeployment) ModelObject() interface{} {
+	return &MachineLearningOnlineDeploymentModel{}
+}
+
+func (r MachineLearningOnlineDeployment) ResourceType() string {
+	return "azurerm_machine_learning_online_deployment"
+}
+
+func (r MachineLearningOnlineDeployment) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return onlinedeployment.ValidateOnlineEndpointDeploymentID
+}
+
+func (r MachineLearningOnlineDeployment) Arguments() map[string]*pluginsdk.Schema {
+	probeSchema := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"failure_threshold": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      30,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"initial_delay": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "PT10S",
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+
+					"period": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "PT10S",
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+
+					"success_threshold": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"timeout": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "PT2S",
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9-]{1,30}[a-zA-Z0-9]$"),
+				"Machine Learning Online Deployment name must be 3 - 32 characters long, start with a letter, end with a letter or number and contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"online_endpoint_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: onlineendpoint.ValidateOnlineEndpointID,
+		},
+
+		"instance_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"app_insights_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"code_configuration": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			RequiredWith: []string{"environment_id"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"code_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"scoring_script": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"egress_public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"environment_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"environment_variables": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"instance_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"liveness_probe": probeSchema(),
+
+		"model_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"readiness_probe": probeSchema(),
+
+		"request_settings": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"max_concurrent_requests_per_instance": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"request_timeout": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "PT5S",
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+				},
+			},
+		},
+
+		// NOTE: O+C the traffic of the Online Endpoint can also be managed using `traffic` within `azurerm_machine_learning_online_endpoint`
+		"traffic_percentage": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MachineLearningOnlineDeployment) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployments
+			endpointsClient := metadata.Client.MachineLearning.OnlineEndpoints
+
+			var model MachineLearningOnlineDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			endpointId, err := onlineendpoint.ParseOnlineEndpointID(model.OnlineEndpointId)
+			if err != nil {
+				return err
+			}
+
+			id := onlinedeployment.NewOnlineEndpointDeploymentID(endpointId.SubscriptionId, endpointId.ResourceGroupName, endpointId.WorkspaceName, endpointId.OnlineEndpointName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// a Deployment must be in the same location as its Online Endpoint
+			endpoint, err := endpointsClient.Get(ctx, *endpointId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *endpointId, err)
+			}
+			if endpoint.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *endpointId)
+			}
+
+			payload := onlinedeployment.OnlineDeploymentTrackedResource{
+				Location:   location.Normalize(endpoint.Model.Location),
+				Properties: expandMachineLearningOnlineDeployment(model),
+				Sku: &onlinedeployment.Sku{
+					Name:     "Default",
+					Capacity: pointer.To(model.InstanceCount),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, withMachineLearningOnlineDeploymentLogs(ctx, client, id, err))
+			}
+
+			metadata.SetID(id)
+
+			if v := metadata.ResourceData.GetRawConfig().AsValueMap()["traffic_percentage"]; !v.IsNull() {
+				if err := updateMachineLearningOnlineEndpointTraffic(ctx, endpointsClient, *endpointId, id.DeploymentName, pointer.To(model.TrafficPercentage)); err != nil {
+					return fmt.Errorf("shifting traffic to %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployments
+			endpointsClient := metadata.Client.MachineLearning.OnlineEndpoints
+
+			id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config MachineLearningOnlineDeploymentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			endpointId := onlineendpoint.NewOnlineEndpointID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.OnlineEndpointName)
+
+			if metadata.ResourceData.HasChangesExcept("traffic_percentage") {
+				existing, err := client.Get(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				if existing.Model == nil {
+					return fmt.Errorf("retrieving %s: `model` was nil", *id)
+				}
+
+				payload := existing.Model
+				payload.Properties = expandMachineLearningOnlineDeployment(config)
+				payload.Sku = &onlinedeployment.Sku{
+					Name:     "Default",
+					Capacity: pointer.To(config.InstanceCount),
+				}
+				payload.Tags = tags.Expand(config.Tags)
+
+				if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, withMachineLearningOnlineDeploymentLogs(ctx, client, *id, err))
+				}
+			}
+
+			// the traffic is shifted once the Deployment has been updated so that the new version receives the traffic
+			if metadata.ResourceData.HasChange("traffic_percentage") {
+				if err := updateMachineLearningOnlineEndpointTraffic(ctx, endpointsClient, endpointId, id.DeploymentName, pointer.To(config.TrafficPercentage)); err != nil {
+					return fmt.Errorf("shifting traffic to %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployments
+			endpointsClient := metadata.Client.MachineLearning.OnlineEndpoints
+
+			id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			endpointId := onlineendpoint.NewOnlineEndpointID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.OnlineEndpointName)
+
+			state := MachineLearningOnlineDeploymentModel{
+				Name:             id.DeploymentName,
+				OnlineEndpointId: endpointId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = tags.Flatten(model.Tags)
+
+				if sku := model.Sku; sku != nil {
+					state.InstanceCount = pointer.From(sku.Capacity)
+				}
+
+				if model.Properties != nil {
+					props, ok := model.Properties.(onlinedeployment.ManagedOnlineDeployment)
+					if !ok {
+						return fmt.Errorf("retrieving %s: expected a Managed Online Deployment but got %+v", *id, model.Properties)
+					}
+
+					state.AppInsightsEnabled = pointer.From(props.AppInsightsEnabled)
+					state.CodeConfiguration = flattenMachineLearningOnlineDeploymentCode(props.CodeConfiguration)
+					state.Description = pointer.From(props.Description)
+					state.EgressPublicNetworkAccessEnabled = pointer.From(props.EgressPublicNetworkAccess) != onlinedeployment.EgressPublicNetworkAccessTypeDisabled
+					state.EnvironmentId = pointer.From(props.EnvironmentId)
+					state.EnvironmentVariables = pointer.From(props.EnvironmentVariables)
+					state.InstanceType = pointer.From(props.InstanceType)
+					state.LivenessProbe = flattenMachineLearningOnlineDeploymentProbe(props.LivenessProbe)
+					state.ModelId = pointer.From(props.Model)
+					state.ReadinessProbe = flattenMachineLearningOnlineDeploymentProbe(props.ReadinessProbe)
+					state.RequestSettings = flattenMachineLearningOnlineDeploymentRequestSettings(props.RequestSettings)
+				}
+			}
+
+			// the traffic of a Deployment is configured on its Online Endpoint
+			endpoint, err := endpointsClient.Get(ctx, endpointId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", endpointId, err)
+			}
+			if model := endpoint.Model; model != nil {
+				state.TrafficPercentage = pointer.From(model.Properties.Traffic)[id.DeploymentName]
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningOnlineDeployment) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineDeployments
+			endpointsClient := metadata.Client.MachineLearning.OnlineEndpoints
+
+			id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a Deployment which receives traffic can't be deleted, so its traffic is shifted to the other Deployments first
+			endpointId := onlineendpoint.NewOnlineEndpointID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.OnlineEndpointName)
+			if err := updateMachineLearningOnlineEndpointTraffic(ctx, endpointsClient, endpointId, id.DeploymentName, nil); err != nil {
+				return fmt.Errorf("shifting traffic away from %s: %+v", *id, err)
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMachineLearningOnlineDeployment(input MachineLearningOnlineDeploymentModel) onlinedeployment.ManagedOnlineDeployment {
+	egressPublicNetworkAccess := onlinedeployment.EgressPublicNetworkAccessTypeDisabled
+	if input.EgressPublicNetworkAccessEnabled {
+		egressPublicNetworkAccess = onlinedeployment.EgressPublicNetworkAccessTypeEnabled
+	}
+
+	output := onlinedeployment.ManagedOnlineDeployment{
+		AppInsightsEnabled:        pointer.To(input.AppInsightsEnabled),
+		EgressPublicNetworkAccess: pointer.To(egressPublicNetworkAccess),
+		EndpointComputeType:       onlinedeployment.EndpointComputeTypeManaged,
+		InstanceType:              pointer.To(input.InstanceType),
+		// NOTE: Managed Online Deployments only support the default scale settings, the number of instances is configured using the SKU capacity
+		ScaleSettings: onlinedeployment.DefaultScaleSettings{
+			ScaleType: onlinedeployment.ScaleTypeDefault,
+		},
+	}
+
+	if len(input.CodeConfiguration) > 0 {
+		code := input.CodeConfiguration[0]
+		output.CodeConfiguration = &onlinedeployment.CodeConfiguration{
+			CodeId:        pointer.To(code.CodeId),
+			ScoringScript: code.ScoringScript,
+		}
+	}
+
+	if input.Description != "" {
+		output.Description = pointer.To(input.Description)
+	}
+
+	if input.EnvironmentId != "" {
+		output.EnvironmentId = pointer.To(input.EnvironmentId)
+	}
+
+	if len(input.EnvironmentVariables) > 0 {
+		output.EnvironmentVariables = pointer.To(input.EnvironmentVariables)
+	}
+
+	if len(input.LivenessProbe) > 0 {
+		output.LivenessProbe = expandMachineLearningOnlineDeploymentProbe(input.LivenessProbe[0])
+	}
+
+	if input.ModelId != "" {
+		output.Model = pointer.To(input.ModelId)
+	}
+
+	if len(input.ReadinessProbe) > 0 {
+		output.ReadinessProbe = expandMachineLearningOnlineDeploymentProbe(input.ReadinessProbe[0])
+	}
+
+	if len(input.RequestSettings) > 0 {
+		settings := input.RequestSettings[0]
+		output.RequestSettings = &onlinedeployment.OnlineRequestSettings{
+			MaxConcurrentRequestsPerInstance: pointer.To(settings.MaxConcurrentRequestsPerInstance),
+			RequestTimeout:                   pointer.To(settings.RequestTimeout),
+		}
+	}
+
+	return output
+}
+
+func expandMachineLearningOnlineDeploymentProbe(input MachineLearningOnlineDeploymentProbeModel) *onlinedeployment.ProbeSettings {
+	return &onlinedeployment.ProbeSettings{
+		FailureThreshold: pointer.To(input.FailureThreshold),
+		InitialDelay:     pointer.To(input.InitialDelay),
+		Period:           pointer.To(input.Period),
+		SuccessThreshold: pointer.To(input.SuccessThreshold),
+		Timeout:          pointer.To(input.Timeout),
+	}
+}
+
+func flattenMachineLearningOnlineDeploymentCode(input *onlinedeployment.CodeConfiguration) []MachineLearningOnlineDeploymentCodeModel {
+	if input == nil {
+		return []MachineLearningOnlineDeploymentCodeModel{}
+	}
+
+	return []MachineLearningOnlineDeploymentCodeModel{
+		{
+			CodeId:        pointer.From(input.CodeId),
+			ScoringScript: input.ScoringScript,
+		},
+	}
+}
+
+func flattenMachineLearningOnlineDeploymentProbe(input *onlinedeployment.ProbeSettings) []MachineLearningOnlineDeploymentProbeModel {
+	if input == nil {
+		return []MachineLearningOnlineDeploymentProbeModel{}
+	}
+
+	return []MachineLearningOnlineDeploymentProbeModel{
+		{
+			FailureThreshold: pointer.From(input.FailureThreshold),
+			InitialDelay:     pointer.From(input.InitialDelay),
+			Period:           pointer.From(input.Period),
+			SuccessThreshold: pointer.From(input.SuccessThreshold),
+			Timeout:          pointer.From(input.Timeout),
+		},
+	}
+}
+
+func flattenMachineLearningOnlineDeploymentRequestSettings(input *onlinedeployment.OnlineRequestSettings) []MachineLearningOnlineDeploymentRequestModel {
+	if input == nil {
+		return []MachineLearningOnlineDeploymentRequestModel{}
+	}
+
+	return []MachineLearningOnlineDeploymentRequestModel{
+		{
+			MaxConcurrentRequestsPerInstance: pointer.From(input.MaxConcurrentRequestsPerInstance),
+			RequestTimeout:                   pointer.From(input.RequestTimeout),
+		},
+	}
+}
+
+// withMachineLearningOnlineDeploymentLogs appends the tail of the inference server logs of the Deployment to `err`, since
+// the errors returned when a Deployment fails to provision rarely contain the underlying cause (e.g. the scoring script failing)
+func withMachineLearningOnlineDeploymentLogs(ctx context.Context, client *onlinedeployment.OnlineDeploymentClient, id onlinedeployment.OnlineEndpointDeploymentId, err error) error {
+	input := onlinedeployment.DeploymentLogsRequest{
+		ContainerType: pointer.To(onlinedeployment.ContainerTypeInferenceServer),
+		Tail:          pointer.To(int64(machineLearningOnlineDeploymentLogsTail)),
+	}
+
+	resp, logsErr := client.GetLogs(ctx, id, input)
+	if logsErr != nil || resp.Model == nil {
+		return err
+	}
+
+	logs := strings.TrimSpace(pointer.From(resp.Model.Content))
+	if logs == "" {
+		return err
+	}
+
+	return fmt.Errorf("%+v\n\nThe last %d lines of the inference server logs were:\n\n%s", err, machineLearningOnlineDeploymentLogsTail, logs)
+}
@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/onlinedeployment"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MachineLearningOnlineDeploymentResource struct{}
+
+func TestAccMachineLearningOnlineDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_deployment", "test")
+	r := MachineLearningOnlineDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic_percentage").HasValue("100"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningOnlineDeployment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_deployment", "test")
+	r := MachineLearningOnlineDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMachineLearningOnlineDeployment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_deployment", "test")
+	r := MachineLearningOnlineDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningOnlineDeployment_blueGreen(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_deployment", "green")
+	r := MachineLearningOnlineDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+		},
+		{
+			Config: r.blueGreen(data, 10),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic_percentage").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.blueGreen(data, 100),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic_percentage").HasValue("100"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// removing the green Deployment shifts its traffic back to the blue Deployment
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_machine_learning_online_deployment.test").Key("traffic_percentage").HasValue("100"),
+			),
+		},
+	})
+}
+
+func (MachineLearningOnlineDeploymentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := onlinedeployment.ParseOnlineEndpointDeploymentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MachineLearning.OnlineDeployments.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MachineLearningOnlineDeploymentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_deployment" "test" {
+  name               = "blue"
+  online_endpoint_id = azurerm_machine_learning_online_endpoint.test.id
+  model_id           = "azureml://registries/azureml/models/distilbert-base-uncased-finetuned-sst-2-english/labels/latest"
+  instance_type      = "Standard_DS3_v2"
+  traffic_percentage = 100
+}
+`, MachineLearningOnlineEndpointResource{}.basic(data))
+}
+
+func (r MachineLearningOnlineDeploymentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_deployment" "import" {
+  name               = azurerm_machine_learning_online_deployment.test.name
+  online_endpoint_id = azurerm_machine_learning_online_deployment.test.online_endpoint_id
+  model_id           = azurerm_machine_learning_online_deployment.test.model_id
+  instance_type      = azurerm_machine_learning_online_deployment.test.instance_type
+}
+`, r.basic(data))
+}
+
+func (r MachineLearningOnlineDeploymentResource) complete(data acceptance.TestData, instanceCount int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_deployment" "test" {
+  name                 = "blue"
+  online_endpoint_id   = azurerm_machine_learning_online_endpoint.test.id
+  model_id             = "azureml://registries/azureml/models/distilbert-base-uncased-finetuned-sst-2-english/labels/latest"
+  instance_type        = "Standard_DS3_v2"
+  instance_count       = %[2]d
+  app_insights_enabled = true
+  description          = "Blue Deployment"
+  traffic_percentage   = 100
+
+  environment_variables = {
+    WORKER_COUNT = "1"
+  }
+
+  liveness_probe {
+    failure_threshold = 10
+    initial_delay     = "PT30S"
+    period            = "PT15S"
+    timeout           = "PT5S"
+  }
+
+  readiness_probe {
+    failure_threshold = 10
+    initial_delay     = "PT30S"
+  }
+
+  request_settings {
+    max_concurrent_requests_per_instance = 2
+    request_timeout                      = "PT30S"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, MachineLearningOnlineEndpointResource{}.basic(data), instanceCount)
+}
+
+func (r MachineLearningOnlineDeploymentResource) blueGreen(data acceptance.TestData, greenTraffic int) string {
+	return fmt.Sprintf(`
+%s
+
+# the traffic of the blue Deployment is shifted by the green Deployment, so it's omitted here
+resource "azurerm_machine_learning_online_deployment" "test" {
+  name               = "blue"
+  online_endpoint_id = azurerm_machine_learning_online_endpoint.test.id
+  model_id           = "azureml://registries/azureml/models/distilbert-base-uncased-finetuned-sst-2-english/labels/latest"
+  instance_type      = "Standard_DS3_v2"
+}
+
+resource "azurerm_machine_learning_online_deployment" "green" {
+  name               = "green"
+  online_endpoint_id = azurerm_machine_learning_online_endpoint.test.id
+  model_id           = "azureml://registries/azureml/models/distilbert-base-uncased-finetuned-sst-2-english/labels/latest"
+  instance_type      = "Standard_DS3_v2"
+  traffic_percentage = %[2]d
+
+  depends_on = [azurerm_machine_learning_online_deployment.test]
+}
+`, MachineLearningOnlineEndpointResource{}.basic(data), greenTraffic)
+}
@@ -0,0 +1,390 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/onlineendpoint"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MachineLearningOnlineEndpoint struct{}
+
+type MachineLearningOnlineEndpointModel struct {
+	Name                       string                                     `tfschema:"name"`
+	WorkspaceId                string                                     `tfschema:"workspace_id"`
+	Location                   string                                     `tfschema:"location"`
+	AuthMode                   string                                     `tfschema:"auth_mode"`
+	Description                string                                     `tfschema:"description"`
+	Identity                   []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	MirrorTraffic              map[string]int64                           `tfschema:"mirror_traffic"`
+	PublicNetworkAccessEnabled bool                                       `tfschema:"public_network_access_enabled"`
+	Traffic                    map[string]int64                           `tfschema:"traffic"`
+	Tags                       map[string]interface{}                     `tfschema:"tags"`
+	PrimaryKey                 string                                     `tfschema:"primary_key"`
+	ScoringUri                 string                                     `tfschema:"scoring_uri"`
+	SecondaryKey               string                                     `tfschema:"secondary_key"`
+	SwaggerUri                 string                                     `tfschema:"swagger_uri"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate        = MachineLearningOnlineEndpoint{}
+	_ sdk.ResourceWithCustomizeDiff = MachineLearningOnlineEndpoint{}
+)
+
+func (r MachineLearningOnlineEndpoint) ModelObject() interface{} {
+	return &MachineLearningOnlineEndpointModel{}
+}
+
+func (r MachineLearningOnlineEndpoint) ResourceType() string {
+	return "azurerm_machine_learning_online_endpoint"
+}
+
+func (r MachineLearningOnlineEndpoint) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return onlineendpoint.ValidateOnlineEndpointID
+}
+
+func (r MachineLearningOnlineEndpoint) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9-]{1,30}[a-zA-Z0-9]$"),
+				"Machine Learning Online Endpoint name must be 3 - 32 characters long, start with a letter, end with a letter or number and contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"location": commonschema.Location(),
+
+		"auth_mode": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(onlineendpoint.PossibleValuesForEndpointAuthMode(), false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptionalForceNew(),
+
+		"mirror_traffic": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 50),
+			},
+		},
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		// NOTE: O+C the traffic can also be shifted by `traffic_percentage` within `azurerm_machine_learning_online_deployment`
+		"traffic": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"primary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"scoring_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"secondary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"swagger_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			if !rd.NewValueKnown("traffic") {
+				return nil
+			}
+
+			var total int
+			for _, v := range rd.Get("traffic").(map[string]interface{}) {
+				total += v.(int)
+			}
+			if total != 0 && total != 100 {
+				return fmt.Errorf("the values of `traffic` must add up to either `0` or `100`, got `%d`", total)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoints
+
+			var model MachineLearningOnlineEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := onlineendpoint.NewOnlineEndpointID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			publicNetworkAccess := onlineendpoint.PublicNetworkAccessTypeDisabled
+			if model.PublicNetworkAccessEnabled {
+				publicNetworkAccess = onlineendpoint.PublicNetworkAccessTypeEnabled
+			}
+
+			payload := onlineendpoint.OnlineEndpointTrackedResource{
+				Location: location.Normalize(model.Location),
+				Properties: onlineendpoint.OnlineEndpoint{
+					AuthMode:            onlineendpoint.EndpointAuthMode(model.AuthMode),
+					PublicNetworkAccess: pointer.To(publicNetworkAccess),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if len(model.Identity) > 0 {
+				expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			if len(model.MirrorTraffic) > 0 {
+				payload.Properties.MirrorTraffic = pointer.To(model.MirrorTraffic)
+			}
+
+			if len(model.Traffic) > 0 {
+				payload.Properties.Traffic = pointer.To(model.Traffic)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoints
+
+			id, err := onlineendpoint.ParseOnlineEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config MachineLearningOnlineEndpointModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the traffic of the Online Endpoint can also be shifted by the Online Deployments
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := existing.Model
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = pointer.To(config.Description)
+			}
+
+			if metadata.ResourceData.HasChange("mirror_traffic") {
+				payload.Properties.MirrorTraffic = pointer.To(config.MirrorTraffic)
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access_enabled") {
+				publicNetworkAccess := onlineendpoint.PublicNetworkAccessTypeDisabled
+				if config.PublicNetworkAccessEnabled {
+					publicNetworkAccess = onlineendpoint.PublicNetworkAccessTypeEnabled
+				}
+				payload.Properties.PublicNetworkAccess = pointer.To(publicNetworkAccess)
+			}
+
+			if metadata.ResourceData.HasChange("traffic") {
+				payload.Properties.Traffic = pointer.To(config.Traffic)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.Expand(config.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoints
+
+			id, err := onlineendpoint.ParseOnlineEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MachineLearningOnlineEndpointModel{
+				Name:        id.OnlineEndpointName,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				flattenedIdentity, err := identity.FlattenLegacySystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = flattenedIdentity
+
+				props := model.Properties
+				state.AuthMode = string(props.AuthMode)
+				state.Description = pointer.From(props.Description)
+				state.MirrorTraffic = pointer.From(props.MirrorTraffic)
+				state.PublicNetworkAccessEnabled = pointer.From(props.PublicNetworkAccess) != onlineendpoint.PublicNetworkAccessTypeDisabled
+				state.ScoringUri = pointer.From(props.ScoringUri)
+				state.SwaggerUri = pointer.From(props.SwaggerUri)
+				state.Traffic = pointer.From(props.Traffic)
+
+				if props.AuthMode == onlineendpoint.EndpointAuthModeKey {
+					keys, err := client.ListKeys(ctx, *id)
+					if err != nil {
+						return fmt.Errorf("listing keys for %s: %+v", *id, err)
+					}
+					if keys.Model != nil {
+						state.PrimaryKey = pointer.From(keys.Model.PrimaryKey)
+						state.SecondaryKey = pointer.From(keys.Model.SecondaryKey)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningOnlineEndpoint) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.OnlineEndpoints
+
+			id, err := onlineendpoint.ParseOnlineEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
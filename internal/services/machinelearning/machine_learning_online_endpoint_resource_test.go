@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/onlineendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MachineLearningOnlineEndpointResource struct{}
+
+func TestAccMachineLearningOnlineEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_endpoint", "test")
+	r := MachineLearningOnlineEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scoring_uri").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningOnlineEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_endpoint", "test")
+	r := MachineLearningOnlineEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMachineLearningOnlineEndpoint_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_endpoint", "test")
+	r := MachineLearningOnlineEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("primary_key").IsSet(),
+				check.That(data.ResourceName).Key("secondary_key").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningOnlineEndpoint_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_endpoint", "test")
+	r := MachineLearningOnlineEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.completeUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_network_access_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningOnlineEndpoint_invalidTraffic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_online_endpoint", "test")
+	r := MachineLearningOnlineEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidTraffic(data),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("the values of `traffic` must add up to either `0` or `100`"),
+		},
+	})
+}
+
+func (MachineLearningOnlineEndpointResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := onlineendpoint.ParseOnlineEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MachineLearning.OnlineEndpoints.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MachineLearningOnlineEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_endpoint" "test" {
+  name         = "acctest-mloe-%[2]d"
+  workspace_id = azurerm_machine_learning_workspace.test.id
+  location     = azurerm_resource_group.test.location
+  auth_mode    = "AADToken"
+}
+`, WorkspaceResource{}.basic(data), data.RandomIntOfLength(8))
+}
+
+func (r MachineLearningOnlineEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_endpoint" "import" {
+  name         = azurerm_machine_learning_online_endpoint.test.name
+  workspace_id = azurerm_machine_learning_online_endpoint.test.workspace_id
+  location     = azurerm_machine_learning_online_endpoint.test.location
+  auth_mode    = azurerm_machine_learning_online_endpoint.test.auth_mode
+}
+`, r.basic(data))
+}
+
+func (r MachineLearningOnlineEndpointResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_endpoint" "test" {
+  name         = "acctest-mloe-%[2]d"
+  workspace_id = azurerm_machine_learning_workspace.test.id
+  location     = azurerm_resource_group.test.location
+  auth_mode    = "Key"
+  description  = "Online Endpoint"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, WorkspaceResource{}.basic(data), data.RandomIntOfLength(8))
+}
+
+func (r MachineLearningOnlineEndpointResource) completeUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_endpoint" "test" {
+  name                          = "acctest-mloe-%[2]d"
+  workspace_id                  = azurerm_machine_learning_workspace.test.id
+  location                      = azurerm_resource_group.test.location
+  auth_mode                     = "Key"
+  description                   = "Updated Online Endpoint"
+  public_network_access_enabled = false
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    environment = "test"
+    updated     = "true"
+  }
+}
+`, WorkspaceResource{}.basic(data), data.RandomIntOfLength(8))
+}
+
+func (r MachineLearningOnlineEndpointResource) invalidTraffic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_online_endpoint" "test" {
+  name         = "acctest-mloe-%[2]d"
+  workspace_id = azurerm_machine_learning_workspace.test.id
+  location     = azurerm_resource_group.test.location
+  auth_mode    = "AADToken"
+
+  traffic = {
+    blue  = 60
+    green = 30
+  }
+}
+`, WorkspaceResource{}.basic(data), data.RandomIntOfLength(8))
+}
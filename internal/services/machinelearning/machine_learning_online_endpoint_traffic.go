@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/onlineendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+)
+
+// updateMachineLearningOnlineEndpointTraffic shifts `percentage` of the traffic of the Online Endpoint to the Deployment
+// `name`, or removes the Deployment from the traffic and mirror traffic of the Online Endpoint when `percentage` is nil.
+func updateMachineLearningOnlineEndpointTraffic(ctx context.Context, client *onlineendpoint.OnlineEndpointClient, id onlineendpoint.OnlineEndpointId, name string, percentage *int64) error {
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+
+	payload := existing.Model
+	traffic := pointer.From(payload.Properties.Traffic)
+	mirrorTraffic := pointer.From(payload.Properties.MirrorTraffic)
+
+	if percentage == nil {
+		_, hasTraffic := traffic[name]
+		_, hasMirrorTraffic := mirrorTraffic[name]
+		if !hasTraffic && !hasMirrorTraffic {
+			return nil
+		}
+
+		if traffic[name] > 0 {
+			if traffic, err = shiftMachineLearningOnlineEndpointTraffic(traffic, name, 0); err != nil {
+				return err
+			}
+		}
+		delete(traffic, name)
+		delete(mirrorTraffic, name)
+		payload.Properties.MirrorTraffic = pointer.To(mirrorTraffic)
+	} else {
+		if traffic, err = shiftMachineLearningOnlineEndpointTraffic(traffic, name, *percentage); err != nil {
+			return err
+		}
+	}
+	payload.Properties.Traffic = pointer.To(traffic)
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, *payload); err != nil {
+		return fmt.Errorf("updating the traffic of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// shiftMachineLearningOnlineEndpointTraffic returns the traffic of an Online Endpoint once `percentage` of the traffic
+// has been shifted to (or away from) the Deployment `name`. The remaining traffic is split between the other Deployments
+// in proportion to the traffic they currently receive, or evenly when none of them receive traffic, so that the
+// traffic of the Endpoint continues to add up to 100 (or 0 when no Deployment receives traffic).
+func shiftMachineLearningOnlineEndpointTraffic(traffic map[string]int64, name string, percentage int64) (map[string]int64, error) {
+	others := make([]string, 0)
+	var othersTotal int64
+	for k, v := range traffic {
+		if k == name {
+			continue
+		}
+		others = append(others, k)
+		othersTotal += v
+	}
+	sort.Strings(others)
+
+	output := map[string]int64{
+		name: percentage,
+	}
+
+	remaining := 100 - percentage
+	if len(others) == 0 {
+		if percentage != 0 && percentage != 100 {
+			return nil, fmt.Errorf("the traffic of the Deployment %q must be either `0` or `100` since it's the only Deployment of the Online Endpoint", name)
+		}
+		return output, nil
+	}
+
+	if percentage == 0 && othersTotal == 0 && traffic[name] == 0 {
+		// no Deployment receives traffic, which is valid
+		for _, k := range others {
+			output[k] = 0
+		}
+		return output, nil
+	}
+
+	// the traffic is split using the largest remainder method so that the shares add up to exactly `remaining`
+	type share struct {
+		name      string
+		remainder int64
+	}
+	shares := make([]share, 0, len(others))
+	var allocated int64
+	for _, k := range others {
+		weight, total := traffic[k], othersTotal
+		if othersTotal == 0 {
+			weight, total = 1, int64(len(others))
+		}
+
+		output[k] = remaining * weight / total
+		allocated += output[k]
+		shares = append(shares, share{name: k, remainder: remaining * weight % total})
+	}
+
+	sort.SliceStable(shares, func(i, j int) bool {
+		return shares[i].remainder > shares[j].remainder
+	})
+	for i := 0; allocated < remaining; i++ {
+		output[shares[i%len(shares)].name]++
+		allocated++
+	}
+
+	return output, nil
+}
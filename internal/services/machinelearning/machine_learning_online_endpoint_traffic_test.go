@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestShiftMachineLearningOnlineEndpointTraffic(t *testing.T) {
+	testData := []struct {
+		Name       string
+		Traffic    map[string]int64
+		Deployment string
+		Percentage int64
+		Expected   map[string]int64
+		Error      bool
+	}{
+		{
+			Name:       "first deployment",
+			Traffic:    map[string]int64{},
+			Deployment: "blue",
+			Percentage: 100,
+			Expected:   map[string]int64{"blue": 100},
+		},
+		{
+			Name:       "first deployment without traffic",
+			Traffic:    map[string]int64{},
+			Deployment: "blue",
+			Percentage: 0,
+			Expected:   map[string]int64{"blue": 0},
+		},
+		{
+			Name:       "first deployment with partial traffic",
+			Traffic:    map[string]int64{},
+			Deployment: "blue",
+			Percentage: 50,
+			Error:      true,
+		},
+		{
+			Name:       "green receives some traffic",
+			Traffic:    map[string]int64{"blue": 100},
+			Deployment: "green",
+			Percentage: 10,
+			Expected:   map[string]int64{"blue": 90, "green": 10},
+		},
+		{
+			Name:       "green receives all traffic",
+			Traffic:    map[string]int64{"blue": 90, "green": 10},
+			Deployment: "green",
+			Percentage: 100,
+			Expected:   map[string]int64{"blue": 0, "green": 100},
+		},
+		{
+			Name:       "traffic is shifted in proportion",
+			Traffic:    map[string]int64{"blue": 60, "green": 20, "red": 20},
+			Deployment: "red",
+			Percentage: 60,
+			Expected:   map[string]int64{"blue": 30, "green": 10, "red": 60},
+		},
+		{
+			Name:       "traffic is shifted evenly when no other deployment receives traffic",
+			Traffic:    map[string]int64{"blue": 0, "green": 0, "red": 100},
+			Deployment: "red",
+			Percentage: 0,
+			Expected:   map[string]int64{"blue": 50, "green": 50, "red": 0},
+		},
+		{
+			Name:       "rounding adds up to 100",
+			Traffic:    map[string]int64{"blue": 0, "green": 0, "red": 0, "yellow": 100},
+			Deployment: "yellow",
+			Percentage: 0,
+			Expected:   map[string]int64{"blue": 34, "green": 33, "red": 33, "yellow": 0},
+		},
+		{
+			Name:       "no deployment receives traffic",
+			Traffic:    map[string]int64{"blue": 0, "green": 0},
+			Deployment: "green",
+			Percentage: 0,
+			Expected:   map[string]int64{"blue": 0, "green": 0},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := shiftMachineLearningOnlineEndpointTraffic(v.Traffic, v.Deployment, v.Percentage)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,461 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/registrymanagement"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MachineLearningRegistry struct{}
+
+type MachineLearningRegistryModel struct {
+	Name                       string                                     `tfschema:"name"`
+	ResourceGroupName          string                                     `tfschema:"resource_group_name"`
+	Location                   string                                     `tfschema:"location"`
+	Identity                   []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	PublicNetworkAccessEnabled bool                                       `tfschema:"public_network_access_enabled"`
+	StorageAccountHnsEnabled   bool                                       `tfschema:"storage_account_hns_enabled"`
+	StorageAccountType         string                                     `tfschema:"storage_account_type"`
+	ReplicationRegion          []MachineLearningRegistryRegionModel       `tfschema:"replication_region"`
+	Tags                       map[string]interface{}                     `tfschema:"tags"`
+	DiscoveryUrl               string                                     `tfschema:"discovery_url"`
+	ManagedResourceGroupId     string                                     `tfschema:"managed_resource_group_id"`
+	MlFlowRegistryUri          string                                     `tfschema:"mlflow_registry_uri"`
+}
+
+type MachineLearningRegistryRegionModel struct {
+	Location                 string `tfschema:"location"`
+	StorageAccountHnsEnabled bool   `tfschema:"storage_account_hns_enabled"`
+	StorageAccountType       string `tfschema:"storage_account_type"`
+}
+
+var _ sdk.ResourceWithUpdate = MachineLearningRegistry{}
+
+func (r MachineLearningRegistry) ModelObject() interface{} {
+	return &MachineLearningRegistryModel{}
+}
+
+func (r MachineLearningRegistry) ResourceType() string {
+	return "azurerm_machine_learning_registry"
+}
+
+func (r MachineLearningRegistry) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return registrymanagement.ValidateRegistryID
+}
+
+// possibleValuesForMachineLearningRegistryStorageAccountType returns the types of the Storage Accounts which can be
+// created for a Registry, the API doesn't define these so they're taken from the documentation
+func possibleValuesForMachineLearningRegistryStorageAccountType() []string {
+	return []string{
+		"Standard_LRS",
+		"Standard_GRS",
+		"Standard_RAGRS",
+		"Standard_ZRS",
+		"Standard_GZRS",
+		"Standard_RAGZRS",
+		"Premium_LRS",
+		"Premium_ZRS",
+	}
+}
+
+func (r MachineLearningRegistry) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z0-9][a-zA-Z0-9_-]{2,32}$"),
+				"Machine Learning Registry name must be 3 - 33 characters long, start with a letter or number and contain only letters, numbers, underscores and hyphens.",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityRequiredForceNew(),
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"storage_account_hns_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"storage_account_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "Standard_LRS",
+			ValidateFunc: validation.StringInSlice(possibleValuesForMachineLearningRegistryStorageAccountType(), false),
+		},
+
+		"replication_region": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"location": commonschema.LocationWithoutForceNew(),
+
+					"storage_account_hns_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"storage_account_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "Standard_LRS",
+						ValidateFunc: validation.StringInSlice(possibleValuesForMachineLearningRegistryStorageAccountType(), false),
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MachineLearningRegistry) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"discovery_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"managed_resource_group_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"mlflow_registry_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MachineLearningRegistry) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Registries
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model MachineLearningRegistryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := registrymanagement.NewRegistryID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.RegistriesGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := registrymanagement.RegistryTrackedResource{
+				Identity: expandedIdentity,
+				Location: location.Normalize(model.Location),
+				Properties: registrymanagement.Registry{
+					PublicNetworkAccess: pointer.To(expandMachineLearningRegistryPublicNetworkAccess(model.PublicNetworkAccessEnabled)),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			regions, err := expandMachineLearningRegistryRegions(model, nil)
+			if err != nil {
+				return err
+			}
+			payload.Properties.RegionDetails = pointer.To(regions)
+
+			if err := client.RegistriesCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningRegistry) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Registries
+
+			id, err := registrymanagement.ParseRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config MachineLearningRegistryModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.RegistriesGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := existing.Model
+
+			if metadata.ResourceData.HasChange("public_network_access_enabled") {
+				payload.Properties.PublicNetworkAccess = pointer.To(expandMachineLearningRegistryPublicNetworkAccess(config.PublicNetworkAccessEnabled))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.Expand(config.Tags)
+			}
+
+			removingRegions := false
+			if metadata.ResourceData.HasChange("replication_region") {
+				existingRegions := pointer.From(payload.Properties.RegionDetails)
+				regions, err := expandMachineLearningRegistryRegions(config, existingRegions)
+				if err != nil {
+					return err
+				}
+				for _, existingRegion := range existingRegions {
+					if !machineLearningRegistryHasRegion(regions, pointer.From(existingRegion.Location)) {
+						removingRegions = true
+					}
+				}
+				payload.Properties.RegionDetails = pointer.To(regions)
+			}
+
+			// regions can only be removed using a separate operation, which otherwise accepts the same payload
+			if removingRegions {
+				if err := client.RegistriesRemoveRegionsThenPoll(ctx, *id, *payload); err != nil {
+					return fmt.Errorf("removing regions from %s: %+v", *id, err)
+				}
+
+				// the remaining changes (e.g. adding a region) are applied below
+				existing, err = client.RegistriesGet(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				if existing.Model == nil {
+					return fmt.Errorf("retrieving %s: `model` was nil", *id)
+				}
+				regions, err := expandMachineLearningRegistryRegions(config, pointer.From(existing.Model.Properties.RegionDetails))
+				if err != nil {
+					return err
+				}
+				payload.Properties.RegionDetails = pointer.To(regions)
+			}
+
+			if err := client.RegistriesCreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MachineLearningRegistry) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Registries
+
+			id, err := registrymanagement.ParseRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.RegistriesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MachineLearningRegistryModel{
+				Name:              id.RegistryName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				flattenedIdentity, err := identity.FlattenLegacySystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = flattenedIdentity
+
+				props := model.Properties
+				state.DiscoveryUrl = pointer.From(props.DiscoveryURL)
+				state.MlFlowRegistryUri = pointer.From(props.MlFlowRegistryUri)
+				state.PublicNetworkAccessEnabled = !strings.EqualFold(pointer.From(props.PublicNetworkAccess), "Disabled")
+
+				if v := props.ManagedResourceGroup; v != nil {
+					state.ManagedResourceGroupId = pointer.From(v.ResourceId)
+				}
+
+				for _, region := range pointer.From(props.RegionDetails) {
+					storageAccountType, hnsEnabled := flattenMachineLearningRegistryRegionStorage(region)
+
+					// the primary region is returned alongside the replication regions
+					if location.Normalize(pointer.From(region.Location)) == state.Location {
+						state.StorageAccountHnsEnabled = hnsEnabled
+						state.StorageAccountType = storageAccountType
+						continue
+					}
+
+					state.ReplicationRegion = append(state.ReplicationRegion, MachineLearningRegistryRegionModel{
+						Location:                 location.Normalize(pointer.From(region.Location)),
+						StorageAccountHnsEnabled: hnsEnabled,
+						StorageAccountType:       storageAccountType,
+					})
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MachineLearningRegistry) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.Registries
+
+			id, err := registrymanagement.ParseRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.RegistriesDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMachineLearningRegistryPublicNetworkAccess(input bool) string {
+	if input {
+		return "Enabled"
+	}
+	return "Disabled"
+}
+
+// expandMachineLearningRegistryRegions returns the primary region of the Registry followed by its replication regions,
+// the details of regions which already exist are retained since they contain the system created resources of the region
+func expandMachineLearningRegistryRegions(input MachineLearningRegistryModel, existing []registrymanagement.RegistryRegionArmDetails) ([]registrymanagement.RegistryRegionArmDetails, error) {
+	regions := []MachineLearningRegistryRegionModel{
+		{
+			Location:                 input.Location,
+			StorageAccountHnsEnabled: input.StorageAccountHnsEnabled,
+			StorageAccountType:       input.StorageAccountType,
+		},
+	}
+	regions = append(regions, input.ReplicationRegion...)
+
+	output := make([]registrymanagement.RegistryRegionArmDetails, 0)
+	for _, region := range regions {
+		found := false
+		for _, v := range existing {
+			if location.Normalize(pointer.From(v.Location)) == location.Normalize(region.Location) {
+				storageAccountType, hnsEnabled := flattenMachineLearningRegistryRegionStorage(v)
+				if !strings.EqualFold(storageAccountType, region.StorageAccountType) || hnsEnabled != region.StorageAccountHnsEnabled {
+					return nil, fmt.Errorf("the storage account of the existing region %q can't be changed, the region must be removed and re-added instead", region.Location)
+				}
+
+				output = append(output, v)
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+
+		output = append(output, registrymanagement.RegistryRegionArmDetails{
+			Location: pointer.To(location.Normalize(region.Location)),
+			AcrDetails: &[]registrymanagement.AcrDetails{
+				{
+					SystemCreatedAcrAccount: &registrymanagement.SystemCreatedAcrAccount{
+						AcrAccountSku: pointer.To("Premium"),
+					},
+				},
+			},
+			StorageAccountDetails: &[]registrymanagement.StorageAccountDetails{
+				{
+					SystemCreatedStorageAccount: &registrymanagement.SystemCreatedStorageAccount{
+						AllowBlobPublicAccess:    pointer.To(false),
+						StorageAccountHnsEnabled: pointer.To(region.StorageAccountHnsEnabled),
+						StorageAccountType:       pointer.To(region.StorageAccountType),
+					},
+				},
+			},
+		})
+	}
+
+	return output, nil
+}
+
+func flattenMachineLearningRegistryRegionStorage(input registrymanagement.RegistryRegionArmDetails) (string, bool) {
+	for _, v := range pointer.From(input.StorageAccountDetails) {
+		if account := v.SystemCreatedStorageAccount; account != nil {
+			return pointer.From(account.StorageAccountType), pointer.From(account.StorageAccountHnsEnabled)
+		}
+	}
+
+	return "", false
+}
+
+func machineLearningRegistryHasRegion(input []registrymanagement.RegistryRegionArmDetails, region string) bool {
+	for _, v := range input {
+		if location.Normalize(pointer.From(v.Location)) == location.Normalize(region) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -53,17 +53,27 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 // DataSources returns the typed DataSources supported by this service
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		MachineLearningEnvironmentVersionDataSource{},
+		MachineLearningModelVersionDataSource{},
+	}
 }
 
 // Resources returns the typed Resources supported by this service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AIFoundry{},
+		AIFoundryCapabilityHost{},
+		AIFoundryConnection{},
 		AIFoundryProject{},
 		MachineLearningDataStoreBlobStorage{},
 		MachineLearningDataStoreDataLakeGen2{},
 		MachineLearningDataStoreFileShare{},
+		MachineLearningEnvironmentVersion{},
+		MachineLearningModelVersion{},
+		MachineLearningOnlineDeployment{},
+		MachineLearningOnlineEndpoint{},
+		MachineLearningRegistry{},
 		WorkspaceNetworkOutboundRuleFqdn{},
 		WorkspaceNetworkOutboundRulePrivateEndpoint{},
 		WorkspaceNetworkOutboundRuleServiceTag{},
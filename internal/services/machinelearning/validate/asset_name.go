@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// AssetName validates the name or version of a Machine Learning asset, such as a Model or an Environment
+func AssetName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9\-_.]{0,254}$`).Match([]byte(v)); !matched {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and 255 characters, start with an alphanumeric character and may only include alphanumeric characters, '-', '_' and '.'", k))
+	}
+	return
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestAssetName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "model",
+			expected: true,
+		},
+		{
+			// numeric version
+			input:    "1",
+			expected: true,
+		},
+		{
+			// dotted version
+			input:    "1.2.3",
+			expected: true,
+		},
+		{
+			// underscores and hyphens
+			input:    "my_model-v2",
+			expected: true,
+		},
+		{
+			// cannot start with a hyphen
+			input:    "-model",
+			expected: false,
+		},
+		{
+			// cannot start with a period
+			input:    ".model",
+			expected: false,
+		},
+		{
+			// cannot contain other special characters
+			input:    "model/v2",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		_, errors := AssetName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	dataplane "github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+var _ pollers.PollerType = &hsmFullBackupPoller{}
+
+func NewHSMFullBackupPoller(client *dataplane.BaseClient, baseUrl string, jobId string) pollers.PollerType {
+	return &hsmFullBackupPoller{
+		client:  client,
+		baseUrl: baseUrl,
+		jobId:   jobId,
+	}
+}
+
+type hsmFullBackupPoller struct {
+	client  *dataplane.BaseClient
+	baseUrl string
+	jobId   string
+}
+
+func (p *hsmFullBackupPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	res, err := p.client.FullBackupStatus(ctx, p.baseUrl, p.jobId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving status of backup %q within %s: %+v", p.jobId, p.baseUrl, err)
+	}
+
+	if res.Status != nil {
+		switch {
+		case strings.EqualFold(*res.Status, "Succeeded"):
+			return &pollers.PollResult{
+				Status:       pollers.PollingStatusSucceeded,
+				PollInterval: 10 * time.Second,
+			}, nil
+
+		case strings.EqualFold(*res.Status, "Failed"):
+			return nil, pollers.PollingFailedError{
+				Message: fmt.Sprintf("backup %q within %s failed: %s", p.jobId, p.baseUrl, operationErrorMessage(res.StatusDetails, res.Error)),
+			}
+		}
+	}
+
+	// Processing
+	return &pollers.PollResult{
+		Status:       pollers.PollingStatusInProgress,
+		PollInterval: 10 * time.Second,
+	}, nil
+}
+
+func operationErrorMessage(statusDetails *string, err *dataplane.Error) string {
+	if err != nil && err.Message != nil {
+		return *err.Message
+	}
+	if statusDetails != nil {
+		return *statusDetails
+	}
+	return "no details were returned"
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	dataplane "github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+var _ pollers.PollerType = &hsmFullRestorePoller{}
+
+func NewHSMFullRestorePoller(client *dataplane.BaseClient, baseUrl string, jobId string) pollers.PollerType {
+	return &hsmFullRestorePoller{
+		client:  client,
+		baseUrl: baseUrl,
+		jobId:   jobId,
+	}
+}
+
+type hsmFullRestorePoller struct {
+	client  *dataplane.BaseClient
+	baseUrl string
+	jobId   string
+}
+
+func (p *hsmFullRestorePoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	res, err := p.client.RestoreStatus(ctx, p.baseUrl, p.jobId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving status of restore %q within %s: %+v", p.jobId, p.baseUrl, err)
+	}
+
+	if res.Status != nil {
+		switch {
+		case strings.EqualFold(*res.Status, "Succeeded"):
+			return &pollers.PollResult{
+				Status:       pollers.PollingStatusSucceeded,
+				PollInterval: 10 * time.Second,
+			}, nil
+
+		case strings.EqualFold(*res.Status, "Failed"):
+			return nil, pollers.PollingFailedError{
+				Message: fmt.Sprintf("restore %q within %s failed: %s", p.jobId, p.baseUrl, operationErrorMessage(res.StatusDetails, res.Error)),
+			}
+		}
+	}
+
+	// Processing
+	return &pollers.PollResult{
+		Status:       pollers.PollingStatusInProgress,
+		PollInterval: 10 * time.Second,
+	}, nil
+}
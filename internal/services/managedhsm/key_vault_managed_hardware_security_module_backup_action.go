@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	kv74 "github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+type KeyVaultManagedHSMBackupAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KeyVaultManagedHSMBackupAction{}
+
+func newKeyVaultManagedHSMBackupAction() action.Action {
+	return &KeyVaultManagedHSMBackupAction{}
+}
+
+type KeyVaultManagedHSMBackupActionModel struct {
+	ManagedHSMId        types.String `tfsdk:"managed_hsm_id"`
+	StorageContainerUrl types.String `tfsdk:"storage_container_url"`
+	SasToken            types.String `tfsdk:"sas_token"`
+	Timeout             types.String `tfsdk:"timeout"`
+}
+
+func (a *KeyVaultManagedHSMBackupAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"managed_hsm_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Managed HSM to back up.",
+				MarkdownDescription: "The ID of the Managed HSM to back up.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: managedhsms.ValidateManagedHSMID,
+					},
+				},
+			},
+
+			"storage_container_url": schema.StringAttribute{
+				Required:            true,
+				Description:         "The URL of the Storage Container in which the backup should be stored.",
+				MarkdownDescription: "The URL of the Storage Container in which the backup should be stored.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsURLWithHTTPS,
+					},
+				},
+			},
+
+			"sas_token": schema.StringAttribute{
+				Required:            true,
+				Description:         "The SAS Token used to access the Storage Container.",
+				MarkdownDescription: "The SAS Token used to access the Storage Container.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (a *KeyVaultManagedHSMBackupAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_managed_hardware_security_module_backup"
+}
+
+func (a *KeyVaultManagedHSMBackupAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.ManagedHSMs
+
+	model := KeyVaultManagedHSMBackupActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := managedhsms.ParseManagedHSMID(model.ManagedHSMId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	baseUri, err := client.BaseUriForManagedHSM(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("determining Data Plane URI for %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("backing up %s", id.ManagedHSMName),
+	})
+
+	future, err := client.DataPlaneKeysClient.FullBackup(ctx, *baseUri, &kv74.SASTokenParameter{
+		StorageResourceURI: pointer.To(model.StorageContainerUrl.ValueString()),
+		Token:              pointer.To(strings.TrimPrefix(model.SasToken.ValueString(), "?")),
+	})
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("backing up %s: %+v", id, err))
+		return
+	}
+
+	operation, err := fullBackupOperationFromFuture(future)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("backing up %s: %+v", id, err))
+		return
+	}
+
+	pollerType := custompollers.NewHSMFullBackupPoller(client.DataPlaneKeysClient, *baseUri, *operation.JobID)
+	poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+	if err := poller.PollUntilDone(ctx); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("waiting for the backup of %s: %+v", id, err))
+		return
+	}
+
+	status, err := client.DataPlaneKeysClient.FullBackupStatus(ctx, *baseUri, *operation.JobID)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("retrieving status of the backup of %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("backup of %s completed, stored in %q", id.ManagedHSMName, pointer.From(status.AzureStorageBlobContainerURI)),
+	})
+}
+
+func (a *KeyVaultManagedHSMBackupAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
+
+// fullBackupOperationFromFuture parses the job of the backup from the initial response, which is needed to poll for its status
+func fullBackupOperationFromFuture(future kv74.FullBackupFuture) (*kv74.FullBackupOperation, error) {
+	resp := future.Response()
+	if resp == nil || resp.Body == nil {
+		return nil, fmt.Errorf("the response was nil")
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %+v", err)
+	}
+
+	var operation kv74.FullBackupOperation
+	if err := json.Unmarshal(body, &operation); err != nil {
+		return nil, fmt.Errorf("unmarshaling response: %+v", err)
+	}
+	if operation.JobID == nil {
+		return nil, fmt.Errorf("`jobId` was nil")
+	}
+
+	return &operation, nil
+}
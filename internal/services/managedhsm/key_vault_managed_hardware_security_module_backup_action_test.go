@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KeyVaultManagedHSMBackupAction struct{}
+
+func testAccKeyVaultManagedHSMBackupAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_backup", "test")
+	a := KeyVaultManagedHSMBackupAction{}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *KeyVaultManagedHSMBackupAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "backups"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+  https_only        = true
+
+  start  = timestamp()
+  expiry = timeadd(timestamp(), "2h")
+
+  permissions {
+    read   = true
+    add    = true
+    create = true
+    write  = true
+    delete = false
+    list   = true
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_key_vault_managed_hardware_security_module_security_domain.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_key_vault_managed_hardware_security_module_backup.test]
+    }
+  }
+}
+
+action "azurerm_key_vault_managed_hardware_security_module_backup" "test" {
+  config {
+    managed_hsm_id        = azurerm_key_vault_managed_hardware_security_module_security_domain.test.managed_hsm_id
+    storage_container_url = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+    sas_token             = data.azurerm_storage_account_blob_container_sas.test.sas
+  }
+}
+`, KeyVaultManagedHSMSecurityDomainResource{}.download(data), data.RandomString)
+}
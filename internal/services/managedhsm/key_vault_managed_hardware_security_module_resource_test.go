@@ -50,6 +50,14 @@ func TestAccKeyVaultManagedHardwareSecurityModule(t *testing.T) {
 			"rotationPolicy":     testAccMHSMKeyRotationPolicy_all,
 			"data_source":        testAccKeyVaultMHSMKeyDataSource_basic,
 		},
+		"securityDomain": {
+			"download": testAccKeyVaultManagedHSMSecurityDomain_download,
+			"restore":  testAccKeyVaultManagedHSMSecurityDomain_restore,
+		},
+		"actions": {
+			"backup":  testAccKeyVaultManagedHSMBackupAction_basic,
+			"restore": testAccKeyVaultManagedHSMRestoreAction_basic,
+		},
 	})
 }
 
@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	kv74 "github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+type KeyVaultManagedHSMRestoreAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KeyVaultManagedHSMRestoreAction{}
+
+func newKeyVaultManagedHSMRestoreAction() action.Action {
+	return &KeyVaultManagedHSMRestoreAction{}
+}
+
+type KeyVaultManagedHSMRestoreActionModel struct {
+	ManagedHSMId        types.String `tfsdk:"managed_hsm_id"`
+	StorageContainerUrl types.String `tfsdk:"storage_container_url"`
+	SasToken            types.String `tfsdk:"sas_token"`
+	FolderName          types.String `tfsdk:"folder_name"`
+	Timeout             types.String `tfsdk:"timeout"`
+}
+
+func (a *KeyVaultManagedHSMRestoreAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"managed_hsm_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Managed HSM to restore the backup into.",
+				MarkdownDescription: "The ID of the Managed HSM to restore the backup into.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: managedhsms.ValidateManagedHSMID,
+					},
+				},
+			},
+
+			"storage_container_url": schema.StringAttribute{
+				Required:            true,
+				Description:         "The URL of the Storage Container in which the backup is stored.",
+				MarkdownDescription: "The URL of the Storage Container in which the backup is stored.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsURLWithHTTPS,
+					},
+				},
+			},
+
+			"sas_token": schema.StringAttribute{
+				Required:            true,
+				Description:         "The SAS Token used to access the Storage Container.",
+				MarkdownDescription: "The SAS Token used to access the Storage Container.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"folder_name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the folder within the Storage Container which contains the backup, for example `mhsm-example-2025101912345678`.",
+				MarkdownDescription: "The name of the folder within the Storage Container which contains the backup, for example `mhsm-example-2025101912345678`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (a *KeyVaultManagedHSMRestoreAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_managed_hardware_security_module_restore"
+}
+
+func (a *KeyVaultManagedHSMRestoreAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.ManagedHSMs
+
+	model := KeyVaultManagedHSMRestoreActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := managedhsms.ParseManagedHSMID(model.ManagedHSMId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	baseUri, err := client.BaseUriForManagedHSM(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("determining Data Plane URI for %s: %+v", id, err))
+		return
+	}
+
+	folderName := model.FolderName.ValueString()
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("restoring backup %q into %s", folderName, id.ManagedHSMName),
+	})
+
+	future, err := client.DataPlaneKeysClient.FullRestoreOperation(ctx, *baseUri, &kv74.RestoreOperationParameters{
+		SasTokenParameters: &kv74.SASTokenParameter{
+			StorageResourceURI: pointer.To(model.StorageContainerUrl.ValueString()),
+			Token:              pointer.To(strings.TrimPrefix(model.SasToken.ValueString(), "?")),
+		},
+		FolderToRestore: pointer.To(folderName),
+	})
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("restoring backup %q into %s: %+v", folderName, id, err))
+		return
+	}
+
+	operation, err := restoreOperationFromFuture(future)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("restoring backup %q into %s: %+v", folderName, id, err))
+		return
+	}
+
+	pollerType := custompollers.NewHSMFullRestorePoller(client.DataPlaneKeysClient, *baseUri, *operation.JobID)
+	poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+	if err := poller.PollUntilDone(ctx); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("waiting for backup %q to be restored into %s: %+v", folderName, id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("restore of backup %q into %s completed", folderName, id.ManagedHSMName),
+	})
+}
+
+func (a *KeyVaultManagedHSMRestoreAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
+
+// restoreOperationFromFuture parses the job of the restore from the initial response, which is needed to poll for its status
+func restoreOperationFromFuture(future kv74.FullRestoreOperationFuture) (*kv74.RestoreOperation, error) {
+	resp := future.Response()
+	if resp == nil || resp.Body == nil {
+		return nil, fmt.Errorf("the response was nil")
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %+v", err)
+	}
+
+	var operation kv74.RestoreOperation
+	if err := json.Unmarshal(body, &operation); err != nil {
+		return nil, fmt.Errorf("unmarshaling response: %+v", err)
+	}
+	if operation.JobID == nil {
+		return nil, fmt.Errorf("`jobId` was nil")
+	}
+
+	return &operation, nil
+}
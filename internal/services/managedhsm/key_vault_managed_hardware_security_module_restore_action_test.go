@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KeyVaultManagedHSMRestoreAction struct{}
+
+func testAccKeyVaultManagedHSMRestoreAction_basic(t *testing.T) {
+	// restoring requires an activated Managed HSM along with a backup of it, which take too long to provision within the test
+	if os.Getenv("ARM_TEST_MANAGED_HSM_ID") == "" || os.Getenv("ARM_TEST_MANAGED_HSM_BACKUP_CONTAINER_URL") == "" || os.Getenv("ARM_TEST_MANAGED_HSM_BACKUP_SAS_TOKEN") == "" || os.Getenv("ARM_TEST_MANAGED_HSM_BACKUP_FOLDER") == "" {
+		t.Skipf("Skipping as ARM_TEST_MANAGED_HSM_ID, ARM_TEST_MANAGED_HSM_BACKUP_CONTAINER_URL, ARM_TEST_MANAGED_HSM_BACKUP_SAS_TOKEN or ARM_TEST_MANAGED_HSM_BACKUP_FOLDER is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_restore", "test")
+	a := KeyVaultManagedHSMRestoreAction{}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *KeyVaultManagedHSMRestoreAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "terraform_data" "trigger" {
+  input = "%[1]s"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_key_vault_managed_hardware_security_module_restore.test]
+    }
+  }
+}
+
+action "azurerm_key_vault_managed_hardware_security_module_restore" "test" {
+  config {
+    managed_hsm_id        = "%[2]s"
+    storage_container_url = "%[3]s"
+    sas_token             = "%[4]s"
+    folder_name           = "%[5]s"
+  }
+}
+`, data.RandomString, os.Getenv("ARM_TEST_MANAGED_HSM_ID"), os.Getenv("ARM_TEST_MANAGED_HSM_BACKUP_CONTAINER_URL"), os.Getenv("ARM_TEST_MANAGED_HSM_BACKUP_SAS_TOKEN"), os.Getenv("ARM_TEST_MANAGED_HSM_BACKUP_FOLDER"))
+}
@@ -0,0 +1,358 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/securitydomain"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	kv74 "github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
+	"golang.org/x/crypto/pkcs12"
+)
+
+type KeyVaultManagedHSMSecurityDomainResource struct{}
+
+var (
+	_ sdk.Resource                  = KeyVaultManagedHSMSecurityDomainResource{}
+	_ sdk.ResourceWithCustomizeDiff = KeyVaultManagedHSMSecurityDomainResource{}
+)
+
+type KeyVaultManagedHSMSecurityDomainResourceModel struct {
+	ManagedHSMID           string   `tfschema:"managed_hsm_id"`
+	KeyVaultCertificateIds []string `tfschema:"key_vault_certificate_ids"`
+	Quorum                 int64    `tfschema:"quorum"`
+	RestoreEncryptedData   string   `tfschema:"restore_encrypted_data"`
+	EncryptedData          string   `tfschema:"encrypted_data"`
+}
+
+func (r KeyVaultManagedHSMSecurityDomainResource) ModelObject() interface{} {
+	return &KeyVaultManagedHSMSecurityDomainResourceModel{}
+}
+
+func (r KeyVaultManagedHSMSecurityDomainResource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_security_domain"
+}
+
+// the Security Domain is a part of the Managed HSM, as such it uses the ID of the Managed HSM
+func (r KeyVaultManagedHSMSecurityDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managedhsms.ValidateManagedHSMID
+}
+
+func (r KeyVaultManagedHSMSecurityDomainResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_hsm_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedhsms.ValidateManagedHSMID,
+		},
+
+		"key_vault_certificate_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 2,
+			MaxItems: 10,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: keyvault.ValidateNestedItemID(keyvault.VersionTypeVersioned, keyvault.NestedItemTypeCertificate),
+			},
+		},
+
+		"quorum": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(2, 10),
+			ExactlyOneOf: []string{"quorum", "restore_encrypted_data"},
+		},
+
+		"restore_encrypted_data": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"quorum", "restore_encrypted_data"},
+		},
+	}
+}
+
+func (r KeyVaultManagedHSMSecurityDomainResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"encrypted_data": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r KeyVaultManagedHSMSecurityDomainResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// restoring only needs the certificates of a quorum, whereas downloading needs at least 3 certificates
+			if !metadata.ResourceDiff.GetRawConfig().AsValueMap()["restore_encrypted_data"].IsNull() {
+				return nil
+			}
+
+			certificateIds := metadata.ResourceDiff.Get("key_vault_certificate_ids").([]interface{})
+			if len(certificateIds) < 3 {
+				return fmt.Errorf("at least 3 `key_vault_certificate_ids` must be specified when downloading the Security Domain")
+			}
+
+			if quorum := metadata.ResourceDiff.Get("quorum").(int); quorum > len(certificateIds) {
+				return fmt.Errorf("`quorum` cannot be greater than the number of `key_vault_certificate_ids`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KeyVaultManagedHSMSecurityDomainResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedHSMs
+			keyVaultClient := metadata.Client.KeyVault.ManagementClient
+
+			var config KeyVaultManagedHSMSecurityDomainResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := managedhsms.ParseManagedHSMID(config.ManagedHSMID)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			resp, err := client.ManagedHsmClient.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.HsmUri == nil {
+				return fmt.Errorf("retrieving %s: `properties.HsmUri` was nil", id)
+			}
+			hsmUri := *resp.Model.Properties.HsmUri
+
+			if isManagedHSMActivated(resp.Model.Properties) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if config.RestoreEncryptedData == "" {
+				certificateIds := make([]interface{}, 0, len(config.KeyVaultCertificateIds))
+				for _, v := range config.KeyVaultCertificateIds {
+					certificateIds = append(certificateIds, v)
+				}
+
+				encData, err := securityDomainDownload(ctx, client.DataPlaneSecurityDomainsClient, *keyVaultClient, hsmUri, certificateIds, int(config.Quorum))
+				if err != nil {
+					return fmt.Errorf("downloading Security Domain for %s: %+v", id, err)
+				}
+
+				metadata.SetID(id)
+				return metadata.ResourceData.Set("encrypted_data", encData)
+			}
+
+			if err := securityDomainUpload(ctx, client.DataPlaneSecurityDomainsClient, *keyVaultClient, hsmUri, config.KeyVaultCertificateIds, config.RestoreEncryptedData); err != nil {
+				return fmt.Errorf("restoring Security Domain for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return metadata.ResourceData.Set("encrypted_data", config.RestoreEncryptedData)
+		},
+	}
+}
+
+func (r KeyVaultManagedHSMSecurityDomainResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ManagedHSMs.ManagedHsmClient
+
+			id, err := managedhsms.ParseManagedHSMID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state KeyVaultManagedHSMSecurityDomainResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model == nil || !isManagedHSMActivated(model.Properties) {
+				log.Printf("[DEBUG] %s has not been activated - removing the Security Domain from state", id)
+				return metadata.MarkAsGone(id)
+			}
+
+			state.ManagedHSMID = id.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KeyVaultManagedHSMSecurityDomainResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := managedhsms.ParseManagedHSMID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the Security Domain cannot be removed from an activated Managed HSM, it's removed along with the Managed HSM
+			log.Printf("[DEBUG] the Security Domain of %s cannot be deleted - removing from state", id)
+
+			return nil
+		},
+	}
+}
+
+func isManagedHSMActivated(props *managedhsms.ManagedHsmProperties) bool {
+	if props == nil || props.SecurityDomainProperties == nil {
+		return false
+	}
+
+	return pointer.From(props.SecurityDomainProperties.ActivationStatus) == managedhsms.ActivationStatusActive
+}
+
+func securityDomainUpload(ctx context.Context, sdClient *kv74.HSMSecurityDomainClient, keyClient kv74.BaseClient, vaultBaseUrl string, certIds []string, encData string) error {
+	privateKeys := make([]*rsa.PrivateKey, 0, len(certIds))
+	for _, certId := range certIds {
+		privateKey, err := securityDomainCertificatePrivateKey(ctx, keyClient, certId)
+		if err != nil {
+			return err
+		}
+		privateKeys = append(privateKeys, privateKey)
+	}
+
+	transferKey, err := sdClient.TransferKeyMethod(ctx, vaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving transfer key for %s: %+v", vaultBaseUrl, err)
+	}
+	if transferKey.TransferKey == nil || transferKey.TransferKey.X5c == nil || len(*transferKey.TransferKey.X5c) == 0 {
+		return fmt.Errorf("retrieving transfer key for %s: `transfer_key.x5c` was nil", vaultBaseUrl)
+	}
+
+	rawCert, err := base64.StdEncoding.DecodeString((*transferKey.TransferKey.X5c)[0])
+	if err != nil {
+		return fmt.Errorf("decoding transfer key certificate: %+v", err)
+	}
+	transferCert, err := x509.ParseCertificate(rawCert)
+	if err != nil {
+		return fmt.Errorf("parsing transfer key certificate: %+v", err)
+	}
+
+	blob, err := securitydomain.RestoreBlob(encData, privateKeys, transferCert)
+	if err != nil {
+		return err
+	}
+
+	if _, err := sdClient.Upload(ctx, vaultBaseUrl, kv74.SecurityDomainObject{Value: pointer.To(blob)}); err != nil {
+		return fmt.Errorf("uploading for %s: %v", vaultBaseUrl, err)
+	}
+
+	pollerType := custompollers.NewHSMUploadPoller(sdClient, vaultBaseUrl)
+	poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("waiting for security domain to upload: %+v", err)
+	}
+
+	return nil
+}
+
+// securityDomainCertificatePrivateKey retrieves the private key of a Key Vault Certificate, which requires the key to be exportable
+func securityDomainCertificatePrivateKey(ctx context.Context, keyClient kv74.BaseClient, certId string) (*rsa.PrivateKey, error) {
+	id, err := keyvault.ParseNestedItemID(certId, keyvault.VersionTypeVersioned, keyvault.NestedItemTypeCertificate)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", certId, err)
+	}
+
+	secret, err := keyClient.GetSecret(ctx, id.KeyVaultBaseURL, id.Name, id.Version)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving private key of certificate %s: %+v", certId, err)
+	}
+	if secret.Value == nil {
+		return nil, fmt.Errorf("retrieving private key of certificate %s: `value` was nil", certId)
+	}
+
+	var blocks []*pem.Block
+	if pointer.From(secret.ContentType) == "application/x-pkcs12" {
+		raw, err := base64.StdEncoding.DecodeString(*secret.Value)
+		if err != nil {
+			return nil, fmt.Errorf("decoding certificate %s: %+v", certId, err)
+		}
+
+		// note PFX passwords are set to an empty string in Key Vault
+		blocks, err = pkcs12.ToPEM(raw, "")
+		if err != nil {
+			return nil, fmt.Errorf("decoding certificate %s: %+v", certId, err)
+		}
+	} else {
+		rest := []byte(*secret.Value)
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			blocks = append(blocks, block)
+		}
+	}
+
+	for _, block := range blocks {
+		if !strings.Contains(block.Type, "PRIVATE KEY") {
+			continue
+		}
+
+		if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+			return key, nil
+		}
+
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing private key of certificate %s: %+v", certId, err)
+		}
+
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("expected the private key of certificate %s to be an RSA key but got %T", certId, key)
+		}
+		return rsaKey, nil
+	}
+
+	return nil, fmt.Errorf("no private key was found for certificate %s, the key of the certificate must be exportable", certId)
+}
@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package managedhsm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KeyVaultManagedHSMSecurityDomainResource struct{}
+
+func testAccKeyVaultManagedHSMSecurityDomain_download(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_security_domain", "test")
+	r := KeyVaultManagedHSMSecurityDomainResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.download(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("encrypted_data").IsSet(),
+			),
+		},
+		data.ImportStep("key_vault_certificate_ids", "quorum", "encrypted_data"),
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccKeyVaultManagedHSMSecurityDomain_restore(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_security_domain", "restored")
+	r := KeyVaultManagedHSMSecurityDomainResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.restore(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key_vault_certificate_ids", "restore_encrypted_data", "encrypted_data"),
+	})
+}
+
+func (KeyVaultManagedHSMSecurityDomainResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := managedhsms.ParseManagedHSMID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ManagedHSMs.ManagedHsmClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.SecurityDomainProperties != nil {
+		return pointer.To(pointer.From(model.Properties.SecurityDomainProperties.ActivationStatus) == managedhsms.ActivationStatusActive), nil
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r KeyVaultManagedHSMSecurityDomainResource) download(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_security_domain" "test" {
+  managed_hsm_id            = azurerm_key_vault_managed_hardware_security_module.test.id
+  key_vault_certificate_ids = [for cert in azurerm_key_vault_certificate.cert : cert.id]
+  quorum                    = 2
+}
+`, r.template(data))
+}
+
+func (r KeyVaultManagedHSMSecurityDomainResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_security_domain" "import" {
+  managed_hsm_id            = azurerm_key_vault_managed_hardware_security_module_security_domain.test.managed_hsm_id
+  key_vault_certificate_ids = azurerm_key_vault_managed_hardware_security_module_security_domain.test.key_vault_certificate_ids
+  quorum                    = azurerm_key_vault_managed_hardware_security_module_security_domain.test.quorum
+}
+`, r.download(data))
+}
+
+func (r KeyVaultManagedHSMSecurityDomainResource) restore(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module" "restored" {
+  name                     = "acctestkvHsmR%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  sku_name                 = "Standard_B1"
+  tenant_id                = data.azurerm_client_config.current.tenant_id
+  admin_object_ids         = [data.azurerm_client_config.current.object_id]
+  purge_protection_enabled = false
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_security_domain" "restored" {
+  managed_hsm_id            = azurerm_key_vault_managed_hardware_security_module.restored.id
+  key_vault_certificate_ids = slice(azurerm_key_vault_managed_hardware_security_module_security_domain.test.key_vault_certificate_ids, 0, 2)
+  restore_encrypted_data    = azurerm_key_vault_managed_hardware_security_module_security_domain.test.encrypted_data
+}
+`, r.download(data), data.RandomString)
+}
+
+func (KeyVaultManagedHSMSecurityDomainResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-KV-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acctest%[3]s"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    secret_permissions = [
+      "Delete",
+      "Get",
+      "Set",
+    ]
+
+    certificate_permissions = [
+      "Create",
+      "Delete",
+      "DeleteIssuers",
+      "Get",
+      "Purge",
+      "Update",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_certificate" "cert" {
+  count        = 3
+  name         = "acchsmcert${count.index}"
+  key_vault_id = azurerm_key_vault.test.id
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+
+    lifetime_action {
+      action {
+        action_type = "AutoRenew"
+      }
+
+      trigger {
+        days_before_expiry = 30
+      }
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+
+    x509_certificate_properties {
+      extended_key_usage = []
+      key_usage = [
+        "cRLSign",
+        "dataEncipherment",
+        "digitalSignature",
+        "keyAgreement",
+        "keyCertSign",
+        "keyEncipherment",
+      ]
+      subject            = "CN=hello-world"
+      validity_in_months = 12
+    }
+  }
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module" "test" {
+  name                     = "acctestkvHsm%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  sku_name                 = "Standard_B1"
+  tenant_id                = data.azurerm_client_config.current.tenant_id
+  admin_object_ids         = [data.azurerm_client_config.current.object_id]
+  purge_protection_enabled = false
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -62,11 +62,15 @@ func (r Registration) Resources() []sdk.Resource {
 		KeyVaultMHSMRoleDefinitionResource{},
 		KeyVaultManagedHSMRoleAssignmentResource{},
 		KeyVaultMHSMKeyRotationPolicyResource{},
+		KeyVaultManagedHSMSecurityDomainResource{},
 	}
 }
 
 func (r Registration) Actions() []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		newKeyVaultManagedHSMBackupAction,
+		newKeyVaultManagedHSMRestoreAction,
+	}
 }
 
 func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package securitydomain
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	algorithmDirect     = "dir"
+	algorithmRSAOAEP256 = "RSA-OAEP-256"
+	encryptionA256CBC   = "A256CBC-HS512"
+
+	// A256CBC-HS512 uses a 64 byte key, the first half is the MAC key and the second half the AES key
+	contentEncryptionKeySize = 64
+)
+
+type jweHeader struct {
+	Algorithm  string `json:"alg"`
+	Encryption string `json:"enc"`
+	KeyId      string `json:"kid,omitempty"`
+}
+
+// compactJWE is a JSON Web Encryption object in the Compact Serialization, see RFC 7516. The Security Domain only uses
+// the `dir` and `RSA-OAEP-256` algorithms with the `A256CBC-HS512` content encryption, matching `jwe.py` in the Azure CLI.
+type compactJWE struct {
+	protected    string
+	header       jweHeader
+	encryptedKey []byte
+	iv           []byte
+	ciphertext   []byte
+	tag          []byte
+}
+
+func parseCompactJWE(input string) (*compactJWE, error) {
+	segments := strings.Split(input, ".")
+	if len(segments) != 5 {
+		return nil, fmt.Errorf("expected 5 segments in the compact JWE but got %d", len(segments))
+	}
+
+	decoded := make([][]byte, 0, 4)
+	for _, segment := range segments[1:] {
+		v, err := base64UrlDecode(segment)
+		if err != nil {
+			return nil, fmt.Errorf("decoding compact JWE: %+v", err)
+		}
+		decoded = append(decoded, v)
+	}
+
+	rawHeader, err := base64UrlDecode(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("decoding JWE header: %+v", err)
+	}
+	var header jweHeader
+	if err := json.Unmarshal(rawHeader, &header); err != nil {
+		return nil, fmt.Errorf("unmarshaling JWE header: %+v", err)
+	}
+	if header.Encryption != encryptionA256CBC {
+		return nil, fmt.Errorf("unsupported JWE content encryption %q", header.Encryption)
+	}
+
+	return &compactJWE{
+		protected:    segments[0],
+		header:       header,
+		encryptedKey: decoded[0],
+		iv:           decoded[1],
+		ciphertext:   decoded[2],
+		tag:          decoded[3],
+	}, nil
+}
+
+func (j compactJWE) String() string {
+	return strings.Join([]string{
+		j.protected,
+		base64UrlEncode(j.encryptedKey),
+		base64UrlEncode(j.iv),
+		base64UrlEncode(j.ciphertext),
+		base64UrlEncode(j.tag),
+	}, ".")
+}
+
+func (j compactJWE) decrypt(cek []byte) ([]byte, error) {
+	return decryptA256CBCHS512(cek, j.iv, j.ciphertext, j.tag, []byte(j.protected))
+}
+
+// encryptWithKey encrypts plaintext directly with the 64 byte key using the `dir` algorithm
+func encryptWithKey(key []byte, keyId string, plaintext []byte) (string, error) {
+	return encrypt(jweHeader{
+		Algorithm:  algorithmDirect,
+		Encryption: encryptionA256CBC,
+		KeyId:      keyId,
+	}, key, nil, plaintext)
+}
+
+func decryptWithKey(input string, key []byte) ([]byte, error) {
+	jwe, err := parseCompactJWE(input)
+	if err != nil {
+		return nil, err
+	}
+	if jwe.header.Algorithm != algorithmDirect {
+		return nil, fmt.Errorf("expected JWE algorithm %q but got %q", algorithmDirect, jwe.header.Algorithm)
+	}
+
+	return jwe.decrypt(key)
+}
+
+// encryptWithPublicKey encrypts plaintext with a random content encryption key, which is wrapped using RSA-OAEP-256
+func encryptWithPublicKey(key *rsa.PublicKey, plaintext []byte) (string, error) {
+	cek := make([]byte, contentEncryptionKeySize)
+	if _, err := rand.Read(cek); err != nil {
+		return "", fmt.Errorf("generating content encryption key: %+v", err)
+	}
+
+	encryptedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, key, cek, nil)
+	if err != nil {
+		return "", fmt.Errorf("wrapping content encryption key: %+v", err)
+	}
+
+	return encrypt(jweHeader{
+		Algorithm:  algorithmRSAOAEP256,
+		Encryption: encryptionA256CBC,
+	}, cek, encryptedKey, plaintext)
+}
+
+func decryptWithPrivateKey(input string, key *rsa.PrivateKey) ([]byte, error) {
+	jwe, err := parseCompactJWE(input)
+	if err != nil {
+		return nil, err
+	}
+	if jwe.header.Algorithm != algorithmRSAOAEP256 {
+		return nil, fmt.Errorf("expected JWE algorithm %q but got %q", algorithmRSAOAEP256, jwe.header.Algorithm)
+	}
+
+	cek, err := rsa.DecryptOAEP(sha256.New(), nil, key, jwe.encryptedKey, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unwrapping content encryption key: %+v", err)
+	}
+
+	return jwe.decrypt(cek)
+}
+
+func encrypt(header jweHeader, cek []byte, encryptedKey []byte, plaintext []byte) (string, error) {
+	rawHeader, err := json.Marshal(header)
+	if err != nil {
+		return "", fmt.Errorf("marshaling JWE header: %+v", err)
+	}
+
+	jwe := compactJWE{
+		protected:    base64UrlEncode(rawHeader),
+		header:       header,
+		encryptedKey: encryptedKey,
+	}
+
+	jwe.iv, jwe.ciphertext, jwe.tag, err = encryptA256CBCHS512(cek, plaintext, []byte(jwe.protected))
+	if err != nil {
+		return "", err
+	}
+
+	return jwe.String(), nil
+}
+
+// encryptA256CBCHS512 implements AES_256_CBC_HMAC_SHA_512 as defined in RFC 7518 section 5.2
+func encryptA256CBCHS512(key, plaintext, aad []byte) (iv, ciphertext, tag []byte, err error) {
+	if len(key) != contentEncryptionKeySize {
+		return nil, nil, nil, fmt.Errorf("expected a %d byte key but got %d bytes", contentEncryptionKeySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key[32:])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	iv = make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, nil, nil, fmt.Errorf("generating initialization vector: %+v", err)
+	}
+
+	padding := aes.BlockSize - len(plaintext)%aes.BlockSize
+	ciphertext = append(bytes.Clone(plaintext), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, ciphertext)
+
+	return iv, ciphertext, authenticationTag(key[:32], aad, iv, ciphertext), nil
+}
+
+func decryptA256CBCHS512(key, iv, ciphertext, tag, aad []byte) ([]byte, error) {
+	if len(key) != contentEncryptionKeySize {
+		return nil, fmt.Errorf("expected a %d byte key but got %d bytes", contentEncryptionKeySize, len(key))
+	}
+
+	if subtle.ConstantTimeCompare(tag, authenticationTag(key[:32], aad, iv, ciphertext)) != 1 {
+		return nil, fmt.Errorf("authentication tag of JWE did not match")
+	}
+
+	if len(iv) != aes.BlockSize || len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("invalid JWE ciphertext")
+	}
+
+	block, err := aes.NewCipher(key[32:])
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ciphertext)
+
+	padding := int(plaintext[len(plaintext)-1])
+	if padding == 0 || padding > aes.BlockSize {
+		return nil, fmt.Errorf("invalid padding in JWE plaintext")
+	}
+
+	return plaintext[:len(plaintext)-padding], nil
+}
+
+func authenticationTag(macKey, aad, iv, ciphertext []byte) []byte {
+	al := make([]byte, 8)
+	binary.BigEndian.PutUint64(al, uint64(len(aad))*8)
+
+	mac := hmac.New(sha512.New, macKey)
+	mac.Write(aad)
+	mac.Write(iv)
+	mac.Write(ciphertext)
+	mac.Write(al)
+
+	return mac.Sum(nil)[:32]
+}
+
+func base64UrlEncode(input []byte) string {
+	return base64.RawURLEncoding.EncodeToString(input)
+}
+
+func base64UrlDecode(input string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(input, "="))
+}
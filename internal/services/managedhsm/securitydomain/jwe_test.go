@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package securitydomain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// TestDecryptA256CBCHS512 uses the test case for AES_256_CBC_HMAC_SHA_512 from RFC 7518 appendix B.3
+func TestDecryptA256CBCHS512(t *testing.T) {
+	key := make([]byte, 64)
+	for i := range key {
+		key[i] = byte(i)
+	}
+
+	plaintext := []byte("A cipher system must not be required to be secret, and it must be able to fall into the hands of the enemy without inconvenience")
+	aad := []byte("The second principle of Auguste Kerckhoffs")
+	iv := decodeHex(t, "1af38c2dc2b96ffdd86694092341bc04")
+	ciphertext := decodeHex(t, strings.Join([]string{
+		"4affaaadb78c31c5da4b1b590d10ffbd3dd8d5d302423526912da037ecbcc7bd",
+		"822c301dd67c373bccb584ad3e9279c2e6d12a1374b77f077553df829410446b",
+		"36ebd97066296ae6427ea75c2e0846a11a09ccf5370dc80bfecbad28c73f09b3",
+		"a3b75e662a2594410ae496b2e2e6609e31e6e02cc837f053d21f37ff4f51950b",
+		"be2638d09dd7a4930930806d0703b1f6",
+	}, ""))
+	tag := decodeHex(t, "4dd3b4c088a7f45c216839645b2012bf2e6269a8c56a816dbc1b267761955bc5")
+
+	actual, err := decryptA256CBCHS512(key, iv, ciphertext, tag, aad)
+	if err != nil {
+		t.Fatalf("decrypting: %+v", err)
+	}
+	if !bytes.Equal(actual, plaintext) {
+		t.Fatalf("expected %q but got %q", plaintext, actual)
+	}
+
+	if !bytes.Equal(authenticationTag(key[:32], aad, iv, ciphertext), tag) {
+		t.Fatalf("expected the authentication tag to be %x", tag)
+	}
+
+	tampered := bytes.Clone(ciphertext)
+	tampered[0] ^= 1
+	if _, err := decryptA256CBCHS512(key, iv, tampered, tag, aad); err == nil {
+		t.Fatalf("expected an error when the ciphertext doesn't match the authentication tag")
+	}
+}
+
+func TestEncryptWithKey(t *testing.T) {
+	key := make([]byte, contentEncryptionKeySize)
+	for i := range key {
+		key[i] = byte(i)
+	}
+
+	for _, plaintext := range [][]byte{{}, []byte("0123456789abcdef"), []byte(`{"some":"security domain data"}`)} {
+		encrypted, err := encryptWithKey(key, "tag1", plaintext)
+		if err != nil {
+			t.Fatalf("encrypting: %+v", err)
+		}
+
+		actual, err := decryptWithKey(encrypted, key)
+		if err != nil {
+			t.Fatalf("decrypting: %+v", err)
+		}
+		if !bytes.Equal(actual, plaintext) {
+			t.Fatalf("expected %q but got %q", plaintext, actual)
+		}
+	}
+}
+
+func decodeHex(t *testing.T, input string) []byte {
+	v, err := hex.DecodeString(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
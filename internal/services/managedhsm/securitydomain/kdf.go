@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package securitydomain
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+)
+
+// deriveKey implements the KDF in Counter Mode from NIST SP 800-108 using HMAC-SHA512 as the PRF:
+//
+//	K(i) := HMAC(key, [i]32 || label || 0x00 || context || [L]32)
+//
+// which matches `sp800_108.py` in the Azure CLI, see https://csrc.nist.gov/pubs/sp/800/108/r1/upd1/final
+func deriveKey(key []byte, label string, context string, bitLength int) []byte {
+	output := make([]byte, 0, bitLength/8)
+
+	for i := uint32(1); len(output) < bitLength/8; i++ {
+		mac := hmac.New(sha512.New, key)
+		_ = binary.Write(mac, binary.BigEndian, i)
+		mac.Write([]byte(label))
+		mac.Write([]byte{0x00})
+		mac.Write([]byte(context))
+		_ = binary.Write(mac, binary.BigEndian, uint32(bitLength))
+		output = mac.Sum(output)
+	}
+
+	return output[:bitLength/8]
+}
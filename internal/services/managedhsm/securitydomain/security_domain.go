@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+// Package securitydomain builds the blob which is uploaded to restore the Security Domain of a Managed HSM.
+//
+// A downloaded Security Domain contains the data of the Managed HSM encrypted with a master key, which in turn
+// is split into shares that are each encrypted with one of the certificates specified when downloading it. To
+// restore it the master key is recovered from a quorum of shares, the data is decrypted and then re-encrypted
+// with a new master key which is wrapped using the transfer key of the Managed HSM it is uploaded into.
+//
+// The format and algorithms match those used by the Azure CLI when running `az keyvault security-domain upload`, see
+// https://github.com/Azure/azure-cli/tree/dev/src/azure-cli/azure/cli/command_modules/keyvault/security_domain
+package securitydomain
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	kdfName         = "sp108_kdf"
+	masterKeyLength = 32
+)
+
+type securityDomain struct {
+	EncData    encData    `json:"EncData"`
+	SharedKeys sharedKeys `json:"SharedKeys"`
+}
+
+type encData struct {
+	Data []datum `json:"data"`
+	Kdf  string  `json:"kdf"`
+}
+
+type datum struct {
+	CompactJWE string `json:"compact_jwe"`
+	Tag        string `json:"tag"`
+}
+
+type sharedKeys struct {
+	EncShares []wrappedKey `json:"enc_shares"`
+	Required  int          `json:"required"`
+}
+
+type wrappedKey struct {
+	EncKey  string `json:"enc_key"`
+	X5tS256 string `json:"x5t_256"`
+}
+
+type restoreBlob struct {
+	EncData    encData    `json:"EncData"`
+	WrappedKey wrappedKey `json:"WrappedKey"`
+}
+
+// RestoreBlob decrypts the downloaded Security Domain using the private keys of (at least a quorum of) the
+// certificates it was downloaded with, and returns the blob to upload into the Managed HSM with the transfer key.
+func RestoreBlob(input string, privateKeys []*rsa.PrivateKey, transferKey *x509.Certificate) (string, error) {
+	var sd securityDomain
+	if err := json.Unmarshal([]byte(input), &sd); err != nil {
+		return "", fmt.Errorf("unmarshaling Security Domain: %+v", err)
+	}
+	if sd.SharedKeys.Required < 1 || len(sd.EncData.Data) == 0 {
+		return "", fmt.Errorf("the Security Domain is missing its shared keys or data")
+	}
+
+	shareArrays, err := decryptShares(sd.SharedKeys, privateKeys)
+	if err != nil {
+		return "", err
+	}
+
+	masterKey, err := combineShares(shareArrays, sd.SharedKeys.Required)
+	if err != nil {
+		return "", fmt.Errorf("recovering Security Domain master key: %+v", err)
+	}
+
+	newMasterKey := make([]byte, masterKeyLength)
+	if _, err := rand.Read(newMasterKey); err != nil {
+		return "", fmt.Errorf("generating master key: %+v", err)
+	}
+
+	output := restoreBlob{
+		EncData: encData{
+			Data: make([]datum, 0, len(sd.EncData.Data)),
+			Kdf:  kdfName,
+		},
+	}
+
+	for _, item := range sd.EncData.Data {
+		plaintext, err := decryptWithKey(item.CompactJWE, deriveKey(masterKey, item.Tag, "", 512))
+		if err != nil {
+			return "", fmt.Errorf("decrypting Security Domain data %q: %+v", item.Tag, err)
+		}
+
+		encrypted, err := encryptWithKey(deriveKey(newMasterKey, item.Tag, "", 512), item.Tag, plaintext)
+		if err != nil {
+			return "", fmt.Errorf("encrypting Security Domain data %q: %+v", item.Tag, err)
+		}
+
+		output.EncData.Data = append(output.EncData.Data, datum{
+			CompactJWE: encrypted,
+			Tag:        item.Tag,
+		})
+	}
+
+	publicKey, ok := transferKey.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("expected the transfer key to be an RSA key but got %T", transferKey.PublicKey)
+	}
+
+	encryptedMasterKey, err := encryptWithPublicKey(publicKey, newMasterKey)
+	if err != nil {
+		return "", fmt.Errorf("wrapping master key: %+v", err)
+	}
+
+	thumbprint := sha256.Sum256(transferKey.Raw)
+	output.WrappedKey = wrappedKey{
+		EncKey:  encryptedMasterKey,
+		X5tS256: base64UrlEncode(thumbprint[:]),
+	}
+
+	result, err := json.Marshal(output)
+	if err != nil {
+		return "", fmt.Errorf("marshaling restore blob: %+v", err)
+	}
+
+	return string(result), nil
+}
+
+// decryptShares decrypts a quorum of the shares, each private key is tried against the shares since the
+// thumbprints recorded in the Security Domain depend on the tool which was used to download it
+func decryptShares(input sharedKeys, privateKeys []*rsa.PrivateKey) ([][]uint16, error) {
+	shareArrays := make([][]uint16, 0, input.Required)
+	used := make(map[int]bool)
+
+	for _, privateKey := range privateKeys {
+		for i, encShare := range input.EncShares {
+			if used[i] {
+				continue
+			}
+
+			plaintext, err := decryptWithPrivateKey(encShare.EncKey, privateKey)
+			if err != nil {
+				continue
+			}
+
+			shares, err := sharesFromBytes(plaintext)
+			if err != nil {
+				return nil, fmt.Errorf("parsing Security Domain share: %+v", err)
+			}
+
+			used[i] = true
+			shareArrays = append(shareArrays, shares)
+			break
+		}
+
+		if len(shareArrays) == input.Required {
+			return shareArrays, nil
+		}
+	}
+
+	return nil, fmt.Errorf("the Security Domain requires %d shares but only %d could be decrypted with the private keys of the certificates", input.Required, len(shareArrays))
+}
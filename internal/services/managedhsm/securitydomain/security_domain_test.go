@@ -0,0 +1,323 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package securitydomain
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+)
+
+// the Security Domain in testdata was generated outside of Go following the format of the Azure CLI, using OpenSSL for
+// AES-256-CBC and RSA-OAEP-256 and the Python standard library for HMAC-SHA512, the KDF and the shares. It contains the
+// data below encrypted with testSecurityDomainMasterKey, which is split into 3 shares (2 of which are required) each
+// encrypted with the RSA key in `testdata/share_key_{n}.pem`.
+const testSecurityDomainMasterKey = "c7d963c569693f7337d9314233c5246c52f8a3abe3b8bf90b6422354fe45a590"
+
+var testSecurityDomainData = map[string]string{
+	"tag-1": `{"keys":["first"]}`,
+	"tag-2": `{"keys":["second","third"]}`,
+}
+
+func TestDeriveKey(t *testing.T) {
+	key := make([]byte, 32)
+	for i := range key {
+		key[i] = byte(i)
+	}
+
+	cases := []struct {
+		key      []byte
+		label    string
+		expected string
+	}{
+		{
+			key:      key,
+			label:    "tag",
+			expected: "5e0d166048d31b68d3276f4bb1d753b57bb41a712686269bd426259f31a9951be17d2feff49dbfa962ff20acdc1de01a4acb2ad391cb63c443d0f213a624017b",
+		},
+		{
+			key:      decodeHex(t, testSecurityDomainMasterKey),
+			label:    "tag-1",
+			expected: "3a6592c78a3232613a7a84df033334ff0778c171c00ac9424409a2657e6b72c897aa16042b6b9d22e401e9455d6a419a05c1b614cf53cd4266bcefdd930f2535",
+		},
+		{
+			key:      decodeHex(t, testSecurityDomainMasterKey),
+			label:    "tag-2",
+			expected: "41af02fd33463378e78164e83b48bdea0940758a78bfe67c5ed196e632caebd2e4915294781c90037344240d441f1c46fe850e9979624ab7269b6676e856afae",
+		},
+	}
+
+	for _, v := range cases {
+		if actual := hex.EncodeToString(deriveKey(v.key, v.label, "", 512)); actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestDecryptSecurityDomain(t *testing.T) {
+	sd := loadTestSecurityDomain(t)
+
+	for _, keys := range [][]*rsa.PrivateKey{
+		{loadTestShareKey(t, 1), loadTestShareKey(t, 2)},
+		{loadTestShareKey(t, 3), loadTestShareKey(t, 1)},
+	} {
+		shareArrays, err := decryptShares(sd.SharedKeys, keys)
+		if err != nil {
+			t.Fatalf("decrypting shares: %+v", err)
+		}
+
+		masterKey, err := combineShares(shareArrays, sd.SharedKeys.Required)
+		if err != nil {
+			t.Fatalf("combining shares: %+v", err)
+		}
+		if actual := hex.EncodeToString(masterKey); actual != testSecurityDomainMasterKey {
+			t.Fatalf("expected the master key %q but got %q", testSecurityDomainMasterKey, actual)
+		}
+
+		for _, item := range sd.EncData.Data {
+			actual, err := decryptWithKey(item.CompactJWE, deriveKey(masterKey, item.Tag, "", 512))
+			if err != nil {
+				t.Fatalf("decrypting %q: %+v", item.Tag, err)
+			}
+			if expected := testSecurityDomainData[item.Tag]; string(actual) != expected {
+				t.Fatalf("expected %q but got %q", expected, actual)
+			}
+		}
+	}
+}
+
+func TestCombineShares(t *testing.T) {
+	secret := []byte{0, 1, 127, 128, 255}
+	shareArrays := splitSecret(t, secret, 5, 3)
+
+	cases := [][][]uint16{
+		{shareArrays[0], shareArrays[1], shareArrays[2]},
+		{shareArrays[4], shareArrays[2], shareArrays[0]},
+		{shareArrays[3], shareArrays[1], shareArrays[4]},
+	}
+
+	for _, v := range cases {
+		actual, err := combineShares(v, 3)
+		if err != nil {
+			t.Fatalf("combining shares: %+v", err)
+		}
+		if !bytes.Equal(actual, secret) {
+			t.Fatalf("expected %v but got %v", secret, actual)
+		}
+	}
+
+	if _, err := combineShares(shareArrays[:2], 3); err == nil {
+		t.Fatalf("expected an error when combining fewer shares than required")
+	}
+}
+
+func TestRestoreBlob(t *testing.T) {
+	privateKeys := []*rsa.PrivateKey{generateKey(t), generateKey(t), generateKey(t)}
+	transferKey := generateKey(t)
+	transferCert := generateCertificate(t, transferKey)
+
+	masterKey := make([]byte, masterKeyLength)
+	if _, err := rand.Read(masterKey); err != nil {
+		t.Fatal(err)
+	}
+
+	plaintext := []byte(`{"some":"security domain data"}`)
+	encrypted, err := encryptWithKey(deriveKey(masterKey, "tag1", "", 512), "tag1", plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sd := securityDomain{
+		EncData: encData{
+			Data: []datum{{CompactJWE: encrypted, Tag: "tag1"}},
+			Kdf:  kdfName,
+		},
+		SharedKeys: sharedKeys{
+			Required: 2,
+		},
+	}
+	for i, shares := range splitSecret(t, masterKey, 3, 2) {
+		raw := make([]byte, 0, len(shares)*2)
+		for _, v := range shares {
+			raw = binary.LittleEndian.AppendUint16(raw, v)
+		}
+		encShare, err := encryptWithPublicKey(&privateKeys[i].PublicKey, raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sd.SharedKeys.EncShares = append(sd.SharedKeys.EncShares, wrappedKey{EncKey: encShare})
+	}
+
+	input, err := json.Marshal(sd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := RestoreBlob(string(input), privateKeys[:1], transferCert); err == nil {
+		t.Fatalf("expected an error when fewer private keys than the quorum are specified")
+	}
+
+	output, err := RestoreBlob(string(input), []*rsa.PrivateKey{privateKeys[2], privateKeys[0]}, transferCert)
+	if err != nil {
+		t.Fatalf("building restore blob: %+v", err)
+	}
+
+	var blob restoreBlob
+	if err := json.Unmarshal([]byte(output), &blob); err != nil {
+		t.Fatal(err)
+	}
+
+	newMasterKey, err := decryptWithPrivateKey(blob.WrappedKey.EncKey, transferKey)
+	if err != nil {
+		t.Fatalf("unwrapping master key: %+v", err)
+	}
+	if bytes.Equal(newMasterKey, masterKey) {
+		t.Fatalf("expected the restore blob to use a new master key")
+	}
+
+	if len(blob.EncData.Data) != 1 || blob.EncData.Data[0].Tag != "tag1" {
+		t.Fatalf("expected the restore blob to contain the data with tag %q", "tag1")
+	}
+
+	actual, err := decryptWithKey(blob.EncData.Data[0].CompactJWE, deriveKey(newMasterKey, "tag1", "", 512))
+	if err != nil {
+		t.Fatalf("decrypting restore blob data: %+v", err)
+	}
+	if !bytes.Equal(actual, plaintext) {
+		t.Fatalf("expected %q but got %q", plaintext, actual)
+	}
+}
+
+func TestRestoreBlobFromSecurityDomain(t *testing.T) {
+	input, err := os.ReadFile("testdata/security_domain.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transferKey := generateKey(t)
+	output, err := RestoreBlob(string(input), []*rsa.PrivateKey{loadTestShareKey(t, 2), loadTestShareKey(t, 3)}, generateCertificate(t, transferKey))
+	if err != nil {
+		t.Fatalf("building restore blob: %+v", err)
+	}
+
+	var blob restoreBlob
+	if err := json.Unmarshal([]byte(output), &blob); err != nil {
+		t.Fatal(err)
+	}
+
+	newMasterKey, err := decryptWithPrivateKey(blob.WrappedKey.EncKey, transferKey)
+	if err != nil {
+		t.Fatalf("unwrapping master key: %+v", err)
+	}
+	if hex.EncodeToString(newMasterKey) == testSecurityDomainMasterKey {
+		t.Fatalf("expected the restore blob to use a new master key")
+	}
+
+	if len(blob.EncData.Data) != len(testSecurityDomainData) {
+		t.Fatalf("expected the restore blob to contain %d items but got %d", len(testSecurityDomainData), len(blob.EncData.Data))
+	}
+	for _, item := range blob.EncData.Data {
+		actual, err := decryptWithKey(item.CompactJWE, deriveKey(newMasterKey, item.Tag, "", 512))
+		if err != nil {
+			t.Fatalf("decrypting restore blob data %q: %+v", item.Tag, err)
+		}
+		if expected := testSecurityDomainData[item.Tag]; string(actual) != expected {
+			t.Fatalf("expected %q but got %q", expected, actual)
+		}
+	}
+}
+
+func loadTestSecurityDomain(t *testing.T) securityDomain {
+	input, err := os.ReadFile("testdata/security_domain.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sd securityDomain
+	if err := json.Unmarshal(input, &sd); err != nil {
+		t.Fatal(err)
+	}
+	return sd
+}
+
+func loadTestShareKey(t *testing.T, n int) *rsa.PrivateKey {
+	raw, err := os.ReadFile(fmt.Sprintf("testdata/share_key_%d.pem", n))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		t.Fatalf("decoding share key %d", n)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key.(*rsa.PrivateKey)
+}
+
+func splitSecret(t *testing.T, secret []byte, count, required int) [][]uint16 {
+	shareArrays := make([][]uint16, count)
+	for _, b := range secret {
+		coefficients := []int{int(b)}
+		for i := 1; i < required; i++ {
+			v, err := rand.Int(rand.Reader, big.NewInt(prime))
+			if err != nil {
+				t.Fatal(err)
+			}
+			coefficients = append(coefficients, int(v.Int64()))
+		}
+
+		for x := 1; x <= count; x++ {
+			value := 0
+			for i := len(coefficients) - 1; i >= 0; i-- {
+				value = (value*x + coefficients[i]) % prime
+			}
+			shareArrays[x-1] = append(shareArrays[x-1], share{x: x, v: value}.uint16())
+		}
+	}
+
+	return shareArrays
+}
+
+func generateKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func generateCertificate(t *testing.T, key *rsa.PrivateKey) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "transfer-key"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := x509.ParseCertificate(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
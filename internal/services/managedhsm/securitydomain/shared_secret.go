@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package securitydomain
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// the shares of the Security Domain are Shamir secret shares over the prime field GF(257), each
+// byte of the secret is split into 16 bit values where the upper 7 bits hold the x coordinate and
+// the lower 9 bits hold the value of the share, matching `shared_secret.py` in the Azure CLI
+const prime = 257
+
+type share struct {
+	x int
+	v int
+}
+
+func shareFromUint16(input uint16) share {
+	return share{
+		x: int(input >> 9),
+		v: int(input & 0x1ff),
+	}
+}
+
+func (s share) uint16() uint16 {
+	return uint16(s.x<<9 | s.v)
+}
+
+func sharesFromBytes(input []byte) ([]uint16, error) {
+	if len(input) == 0 || len(input)%2 != 0 {
+		return nil, fmt.Errorf("expected an even number of bytes in the share but got %d", len(input))
+	}
+
+	shares := make([]uint16, 0, len(input)/2)
+	for i := 0; i < len(input); i += 2 {
+		shares = append(shares, binary.LittleEndian.Uint16(input[i:i+2]))
+	}
+
+	return shares, nil
+}
+
+// combineShares recovers the secret from `required` share arrays using Lagrange interpolation
+func combineShares(shareArrays [][]uint16, required int) ([]byte, error) {
+	if len(shareArrays) < required {
+		return nil, fmt.Errorf("expected at least %d shares but got %d", required, len(shareArrays))
+	}
+
+	secretLength := len(shareArrays[0])
+	for _, shares := range shareArrays[:required] {
+		if len(shares) != secretLength {
+			return nil, fmt.Errorf("the shares have different lengths")
+		}
+	}
+
+	secret := make([]byte, 0, secretLength)
+	for i := 0; i < secretLength; i++ {
+		value := 0
+		for j := 0; j < required; j++ {
+			sj := shareFromUint16(shareArrays[j][i])
+
+			numerator, denominator := 1, 1
+			for k := 0; k < required; k++ {
+				if j == k {
+					continue
+				}
+				sk := shareFromUint16(shareArrays[k][i])
+				numerator = numerator * sk.x % prime
+				denominator = denominator * mod(sk.x-sj.x) % prime
+			}
+
+			if denominator == 0 {
+				return nil, fmt.Errorf("the shares contain duplicate x coordinates")
+			}
+
+			value = (value + numerator*invert(denominator)%prime*sj.v) % prime
+		}
+
+		if value > 255 {
+			return nil, fmt.Errorf("the shares did not combine into a valid secret")
+		}
+		secret = append(secret, byte(value))
+	}
+
+	return secret, nil
+}
+
+func mod(input int) int {
+	return ((input % prime) + prime) % prime
+}
+
+// invert returns the multiplicative inverse using Fermat's little theorem, i.e. input^(prime-2)
+func invert(input int) int {
+	result, base := 1, mod(input)
+	for exponent := prime - 2; exponent > 0; exponent >>= 1 {
+		if exponent&1 == 1 {
+			result = result * base % prime
+		}
+		base = base * base % prime
+	}
+	return result
+}
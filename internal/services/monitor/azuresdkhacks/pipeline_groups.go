@@ -0,0 +1,392 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// This `azuresdkhack` only exists because `go-azure-sdk` does not currently include the `pipelineGroups` resource
+// (Azure Monitor Pipeline), which is only available in the Preview API versions of `Microsoft.Monitor`.
+// Once this is available within a Stable API version this can be replaced by the generated SDK.
+
+const pipelineGroupsApiVersion = "2024-10-01-preview"
+
+type PipelineGroupsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewPipelineGroupsClientWithBaseURI(sdkApi environments.Api) (*PipelineGroupsClient, error) {
+	c, err := resourcemanager.NewClient(sdkApi, "pipelinegroups", pipelineGroupsApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating PipelineGroupsClient: %+v", err)
+	}
+
+	return &PipelineGroupsClient{
+		Client: c,
+	}, nil
+}
+
+type PipelineGroup struct {
+	ExtendedLocation *ExtendedLocation        `json:"extendedLocation,omitempty"`
+	Id               *string                  `json:"id,omitempty"`
+	Location         string                   `json:"location"`
+	Name             *string                  `json:"name,omitempty"`
+	Properties       *PipelineGroupProperties `json:"properties,omitempty"`
+	Tags             *map[string]string       `json:"tags,omitempty"`
+	Type             *string                  `json:"type,omitempty"`
+}
+
+type ExtendedLocation struct {
+	Name string               `json:"name"`
+	Type ExtendedLocationType `json:"type"`
+}
+
+type ExtendedLocationType string
+
+const (
+	ExtendedLocationTypeCustomLocation ExtendedLocationType = "CustomLocation"
+)
+
+type PipelineGroupProperties struct {
+	Exporters                []Exporter                 `json:"exporters"`
+	NetworkingConfigurations *[]NetworkingConfiguration `json:"networkingConfigurations,omitempty"`
+	Processors               []Processor                `json:"processors"`
+	ProvisioningState        *string                    `json:"provisioningState,omitempty"`
+	Receivers                []Receiver                 `json:"receivers"`
+	Replicas                 *int64                     `json:"replicas,omitempty"`
+	Service                  Service                    `json:"service"`
+}
+
+type Receiver struct {
+	Name   string          `json:"name"`
+	Otlp   *OtlpReceiver   `json:"otlp,omitempty"`
+	Syslog *SyslogReceiver `json:"syslog,omitempty"`
+	Type   ReceiverType    `json:"type"`
+	Udp    *UdpReceiver    `json:"udp,omitempty"`
+}
+
+type OtlpReceiver struct {
+	Endpoint string `json:"endpoint"`
+}
+
+type SyslogReceiver struct {
+	Endpoint string  `json:"endpoint"`
+	Protocol *string `json:"protocol,omitempty"`
+}
+
+type UdpReceiver struct {
+	Encoding        *string `json:"encoding,omitempty"`
+	Endpoint        string  `json:"endpoint"`
+	ReadQueueLength *int64  `json:"readQueueLength,omitempty"`
+}
+
+type Processor struct {
+	Batch *BatchProcessor `json:"batch,omitempty"`
+	Name  string          `json:"name"`
+	Type  ProcessorType   `json:"type"`
+}
+
+type BatchProcessor struct {
+	BatchSize *int64 `json:"batchSize,omitempty"`
+	Timeout   *int64 `json:"timeout,omitempty"`
+}
+
+type Exporter struct {
+	AzureMonitorWorkspaceLogs *AzureMonitorWorkspaceLogsExporter `json:"azureMonitorWorkspaceLogs,omitempty"`
+	Name                      string                             `json:"name"`
+	Type                      ExporterType                       `json:"type"`
+}
+
+type AzureMonitorWorkspaceLogsExporter struct {
+	Api         AzureMonitorWorkspaceLogsApiConfig `json:"api"`
+	Cache       *CacheConfiguration                `json:"cache,omitempty"`
+	Concurrency *ConcurrencyConfiguration          `json:"concurrency,omitempty"`
+}
+
+type AzureMonitorWorkspaceLogsApiConfig struct {
+	DataCollectionEndpointUrl string    `json:"dataCollectionEndpointUrl"`
+	DataCollectionRule        string    `json:"dataCollectionRule"`
+	Schema                    SchemaMap `json:"schema"`
+	Stream                    string    `json:"stream"`
+}
+
+type SchemaMap struct {
+	RecordMap   []RecordMap    `json:"recordMap"`
+	ResourceMap *[]ResourceMap `json:"resourceMap,omitempty"`
+	ScopeMap    *[]ScopeMap    `json:"scopeMap,omitempty"`
+}
+
+type RecordMap struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type ResourceMap struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type ScopeMap struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type CacheConfiguration struct {
+	MaxStorageUsage *int64 `json:"maxStorageUsage,omitempty"`
+	RetentionPeriod *int64 `json:"retentionPeriod,omitempty"`
+}
+
+type ConcurrencyConfiguration struct {
+	BatchQueueSize *int64 `json:"batchQueueSize,omitempty"`
+	WorkerCount    *int64 `json:"workerCount,omitempty"`
+}
+
+type Service struct {
+	Persistence *PersistenceConfigurations `json:"persistence,omitempty"`
+	Pipelines   []Pipeline                 `json:"pipelines"`
+}
+
+type PersistenceConfigurations struct {
+	PersistentVolumeName string `json:"persistentVolumeName"`
+}
+
+type Pipeline struct {
+	Exporters  []string     `json:"exporters"`
+	Name       string       `json:"name"`
+	Processors *[]string    `json:"processors,omitempty"`
+	Receivers  []string     `json:"receivers"`
+	Type       PipelineType `json:"type"`
+}
+
+type NetworkingConfiguration struct {
+	ExternalNetworkingMode ExternalNetworkingMode `json:"externalNetworkingMode"`
+	Host                   *string                `json:"host,omitempty"`
+	Routes                 []NetworkingRoute      `json:"routes"`
+}
+
+type NetworkingRoute struct {
+	Path      *string `json:"path,omitempty"`
+	Port      *int64  `json:"port,omitempty"`
+	Receiver  string  `json:"receiver"`
+	Subdomain *string `json:"subdomain,omitempty"`
+}
+
+type ReceiverType string
+
+const (
+	ReceiverTypeOTLP   ReceiverType = "OTLP"
+	ReceiverTypeSyslog ReceiverType = "Syslog"
+	ReceiverTypeUDP    ReceiverType = "UDP"
+)
+
+func PossibleValuesForReceiverType() []string {
+	return []string{
+		string(ReceiverTypeOTLP),
+		string(ReceiverTypeSyslog),
+		string(ReceiverTypeUDP),
+	}
+}
+
+type ProcessorType string
+
+const (
+	ProcessorTypeBatch ProcessorType = "Batch"
+)
+
+type ExporterType string
+
+const (
+	ExporterTypeAzureMonitorWorkspaceLogs ExporterType = "AzureMonitorWorkspaceLogs"
+)
+
+type PipelineType string
+
+const (
+	PipelineTypeLogs PipelineType = "Logs"
+)
+
+type ExternalNetworkingMode string
+
+const (
+	ExternalNetworkingModeLoadBalancerOnly ExternalNetworkingMode = "LoadBalancerOnly"
+)
+
+func PossibleValuesForSyslogProtocol() []string {
+	return []string{
+		"rfc3164",
+		"rfc5424",
+	}
+}
+
+func PossibleValuesForUdpEncoding() []string {
+	return []string{
+		"nop",
+		"utf-8",
+		"utf-16le",
+		"utf-16be",
+		"ascii",
+		"big5",
+	}
+}
+
+type PipelineGroupsGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *PipelineGroup
+}
+
+type PipelineGroupsCreateOrUpdateOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *PipelineGroup
+}
+
+type PipelineGroupsDeleteOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+func (c PipelineGroupsClient) Get(ctx context.Context, id PipelineGroupId) (result PipelineGroupsGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model PipelineGroup
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c PipelineGroupsClient) CreateOrUpdate(ctx context.Context, id PipelineGroupId, input PipelineGroup) (result PipelineGroupsCreateOrUpdateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (c PipelineGroupsClient) CreateOrUpdateThenPoll(ctx context.Context, id PipelineGroupId, input PipelineGroup) error {
+	result, err := c.CreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+func (c PipelineGroupsClient) Delete(ctx context.Context, id PipelineGroupId) (result PipelineGroupsDeleteOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+func (c PipelineGroupsClient) DeleteThenPoll(ctx context.Context, id PipelineGroupId) error {
+	result, err := c.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
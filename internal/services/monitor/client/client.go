@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-15-preview/scheduledqueryrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/monitor/2023-04-03/azuremonitorworkspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/azuresdkhacks"
 )
 
 type Client struct {
@@ -50,6 +51,7 @@ type Client struct {
 	DiagnosticSettingsClient             *diagnosticSettingClient.DiagnosticSettingsClient
 	DiagnosticSettingsCategoryClient     *diagnosticCategoryClient.DiagnosticSettingsCategoriesClient
 	MetricAlertsClient                   *metricalerts.MetricAlertsClient
+	PipelineGroupsClient                 *azuresdkhacks.PipelineGroupsClient
 	PrivateLinkScopesClient              *privatelinkscopesapis.PrivateLinkScopesAPIsClient
 	PrivateLinkScopedResourcesClient     *privatelinkscopedresources.PrivateLinkScopedResourcesClient
 	ScheduledQueryRulesClient            *scheduledqueryrules2018.ScheduledQueryRulesClient
@@ -166,6 +168,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(ScheduledQueryRulesV2Client.Client, o.Authorizers.ResourceManager)
 
+	PipelineGroupsClient, err := azuresdkhacks.NewPipelineGroupsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Pipeline Groups client: %+v", err)
+	}
+	o.Configure(PipelineGroupsClient.Client, o.Authorizers.ResourceManager)
+
 	WorkspacesClient, err := azuremonitorworkspaces.NewAzureMonitorWorkspacesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Workspaces client: %+v", err)
@@ -191,6 +199,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		PrivateLinkScopedResourcesClient:     PrivateLinkScopedResourcesClient,
 		ScheduledQueryRulesClient:            ScheduledQueryRulesClient,
 		ScheduledQueryRulesV2Client:          ScheduledQueryRulesV2Client,
+		PipelineGroupsClient:                 PipelineGroupsClient,
 		WorkspacesClient:                     WorkspacesClient,
 	}, nil
 }
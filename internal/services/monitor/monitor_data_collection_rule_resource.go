@@ -2189,6 +2189,22 @@ func (r DataCollectionRuleResource) CustomizeDiff() sdk.ResourceFunc {
 					return err
 				}
 			}
+
+			var config DataCollectionRule
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := validateDataCollectionRuleStreams(config); err != nil {
+				return err
+			}
+
+			if metadata.ResourceDiff.HasChanges("data_flow", "destinations", "stream_declaration") {
+				if err := validateDataCollectionRuleTableSchemas(ctx, metadata.Client.LogAnalytics.TablesClient, config); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
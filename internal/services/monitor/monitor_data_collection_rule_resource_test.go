@@ -6,6 +6,7 @@ package monitor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -172,6 +173,51 @@ func TestAccMonitorDataCollectionRule_complete(t *testing.T) {
 	})
 }
 
+func TestAccMonitorDataCollectionRule_customTable(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_data_collection_rule", "test")
+	r := MonitorDataCollectionRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customTableTemplate(data),
+		},
+		{
+			Config: r.customTable(data, "string"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorDataCollectionRule_customTableColumnMismatch(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_data_collection_rule", "test")
+	r := MonitorDataCollectionRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customTableTemplate(data),
+		},
+		{
+			Config:      r.customTable(data, "dynamic"),
+			ExpectError: regexp.MustCompile("is not compatible with type"),
+		},
+	})
+}
+
+func TestAccMonitorDataCollectionRule_customStreamNotDeclared(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_data_collection_rule", "test")
+	r := MonitorDataCollectionRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.customStreamNotDeclared(data),
+			ExpectError: regexp.MustCompile("is not declared in a `stream_declaration` block"),
+		},
+	})
+}
+
 func (r MonitorDataCollectionRuleResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -775,6 +821,103 @@ resource "azurerm_monitor_data_collection_rule" "import" {
 `, r.basic(data))
 }
 
+func (r MonitorDataCollectionRuleResource) customTableTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-law-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_workspace_table_custom_log" "test" {
+  name         = "acctestdcr%[2]d_CL"
+  workspace_id = azurerm_log_analytics_workspace.test.id
+
+  column {
+    name = "TimeGenerated"
+    type = "dateTime"
+  }
+
+  column {
+    name = "Computer"
+    type = "string"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MonitorDataCollectionRuleResource) customTable(data acceptance.TestData, computerType string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_data_collection_rule" "test" {
+  name                = "acctestmdcr-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  destinations {
+    log_analytics {
+      workspace_resource_id = azurerm_log_analytics_workspace.test.id
+      name                  = "test-destination-log"
+    }
+  }
+
+  data_flow {
+    streams       = ["Custom-MyTableRawData"]
+    destinations  = ["test-destination-log"]
+    output_stream = "Custom-${azurerm_log_analytics_workspace_table_custom_log.test.name}"
+  }
+
+  stream_declaration {
+    stream_name = "Custom-MyTableRawData"
+    column {
+      name = "TimeGenerated"
+      type = "datetime"
+    }
+    column {
+      name = "Computer"
+      type = "%[3]s"
+    }
+  }
+}
+`, r.customTableTemplate(data), data.RandomInteger, computerType)
+}
+
+func (r MonitorDataCollectionRuleResource) customStreamNotDeclared(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-law-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_monitor_data_collection_rule" "test" {
+  name                = "acctestmdcr-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  destinations {
+    log_analytics {
+      workspace_resource_id = azurerm_log_analytics_workspace.test.id
+      name                  = "test-destination-log"
+    }
+  }
+
+  data_flow {
+    streams       = ["Custom-MyTableRawData"]
+    destinations  = ["test-destination-log"]
+    output_stream = "Custom-MyTable_CL"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r MonitorDataCollectionRuleResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
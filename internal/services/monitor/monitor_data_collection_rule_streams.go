@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+)
+
+const (
+	dataCollectionRuleCustomStreamPrefix    = "Custom-"
+	dataCollectionRuleMicrosoftStreamPrefix = "Microsoft-"
+)
+
+// validateDataCollectionRuleStreams statically checks that the custom streams used by each `data_flow` are declared
+// in a `stream_declaration` block and that the stream names follow the format required by the API.
+// Values which are not yet known at plan time decode as empty strings and are skipped.
+func validateDataCollectionRuleStreams(config DataCollectionRule) error {
+	declared := make(map[string]struct{})
+	for _, declaration := range config.StreamDeclaration {
+		if declaration.StreamName == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(declaration.StreamName, dataCollectionRuleCustomStreamPrefix) {
+			return fmt.Errorf("the `stream_name` %q of a `stream_declaration` must start with %q", declaration.StreamName, dataCollectionRuleCustomStreamPrefix)
+		}
+
+		columns := make(map[string]struct{})
+		for _, column := range declaration.Column {
+			key := strings.ToLower(column.Name)
+			if _, exists := columns[key]; exists {
+				return fmt.Errorf("the column %q is declared more than once in the `stream_declaration` %q", column.Name, declaration.StreamName)
+			}
+			columns[key] = struct{}{}
+		}
+
+		declared[strings.ToLower(declaration.StreamName)] = struct{}{}
+	}
+
+	for i, flow := range config.DataFlows {
+		for _, stream := range flow.Streams {
+			if !strings.HasPrefix(stream, dataCollectionRuleCustomStreamPrefix) {
+				continue
+			}
+
+			if _, ok := declared[strings.ToLower(stream)]; !ok {
+				return fmt.Errorf("`data_flow.%d` uses the custom stream %q which is not declared in a `stream_declaration` block", i, stream)
+			}
+		}
+
+		if output := flow.OutputStream; output != "" && !strings.HasPrefix(output, dataCollectionRuleCustomStreamPrefix) && !strings.HasPrefix(output, dataCollectionRuleMicrosoftStreamPrefix) {
+			return fmt.Errorf("the `output_stream` %q of `data_flow.%d` must start with either %q or %q", output, i, dataCollectionRuleCustomStreamPrefix, dataCollectionRuleMicrosoftStreamPrefix)
+		}
+	}
+
+	return nil
+}
+
+// validateDataCollectionRuleTableSchemas checks the columns of declared input streams against the schema of the
+// Log Analytics Workspace Table targeted by the `output_stream` of each `data_flow` which does not transform the data.
+// Since the table may be created within the same apply, tables which do not yet exist are skipped.
+func validateDataCollectionRuleTableSchemas(ctx context.Context, client *tables.TablesClient, config DataCollectionRule) error {
+	workspaceIds := make(map[string]string)
+	for _, destination := range config.Destinations {
+		for _, v := range destination.LogAnalytics {
+			if v.Name != "" && v.WorkspaceResourceId != "" {
+				workspaceIds[v.Name] = v.WorkspaceResourceId
+			}
+		}
+	}
+
+	declarations := make(map[string]StreamDeclaration)
+	for _, declaration := range config.StreamDeclaration {
+		declarations[strings.ToLower(declaration.StreamName)] = declaration
+	}
+
+	tableColumns := make(map[string]map[string]tables.ColumnTypeEnum)
+	for i, flow := range config.DataFlows {
+		if flow.TransformKql != "" && !strings.EqualFold(strings.TrimSpace(flow.TransformKql), "source") {
+			continue
+		}
+
+		tableName := strings.TrimPrefix(strings.TrimPrefix(flow.OutputStream, dataCollectionRuleCustomStreamPrefix), dataCollectionRuleMicrosoftStreamPrefix)
+		if tableName == "" || tableName == flow.OutputStream {
+			continue
+		}
+
+		for _, destination := range flow.Destinations {
+			workspaceId, ok := workspaceIds[destination]
+			if !ok {
+				continue
+			}
+
+			workspace, err := workspaces.ParseWorkspaceIDInsensitively(workspaceId)
+			if err != nil {
+				return err
+			}
+
+			tableId := tables.NewTableID(workspace.SubscriptionId, workspace.ResourceGroupName, workspace.WorkspaceName, tableName)
+			columns, ok := tableColumns[tableId.ID()]
+			if !ok {
+				resp, err := client.Get(ctx, tableId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						continue
+					}
+					return fmt.Errorf("retrieving %s to validate `data_flow.%d`: %+v", tableId, i, err)
+				}
+
+				columns = make(map[string]tables.ColumnTypeEnum)
+				if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Schema != nil {
+					schema := model.Properties.Schema
+					for _, column := range append(pointer.From(schema.StandardColumns), pointer.From(schema.Columns)...) {
+						columns[strings.ToLower(pointer.From(column.Name))] = pointer.From(column.Type)
+					}
+				}
+				tableColumns[tableId.ID()] = columns
+			}
+
+			for _, stream := range flow.Streams {
+				declaration, ok := declarations[strings.ToLower(stream)]
+				if !ok {
+					continue
+				}
+
+				for _, column := range declaration.Column {
+					if column.Name == "" {
+						continue
+					}
+
+					tableType, ok := columns[strings.ToLower(column.Name)]
+					if !ok {
+						return fmt.Errorf("the column %q of stream %q does not exist in %s, either add the column to the table or use `transform_kql` to project it in `data_flow.%d`", column.Name, stream, tableId, i)
+					}
+
+					if !dataCollectionRuleColumnTypeIsCompatible(column.Type, tableType) {
+						return fmt.Errorf("the column %q of stream %q has type %q which is not compatible with type %q in %s, use `transform_kql` to convert it in `data_flow.%d`", column.Name, stream, column.Type, string(tableType), tableId, i)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func dataCollectionRuleColumnTypeIsCompatible(streamType string, tableType tables.ColumnTypeEnum) bool {
+	if streamType == "" || tableType == "" || strings.EqualFold(streamType, string(tableType)) {
+		return true
+	}
+
+	switch tableType {
+	case tables.ColumnTypeEnumGuid:
+		return strings.EqualFold(streamType, "string")
+	case tables.ColumnTypeEnumLong:
+		return strings.EqualFold(streamType, "int")
+	case tables.ColumnTypeEnumReal:
+		return strings.EqualFold(streamType, "int") || strings.EqualFold(streamType, "long")
+	}
+
+	return false
+}
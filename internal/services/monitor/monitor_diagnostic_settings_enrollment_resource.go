@@ -0,0 +1,791 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/authorizationrulesnamespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = DiagnosticSettingsEnrollmentResource{}
+	_ sdk.ResourceWithCustomizeDiff = DiagnosticSettingsEnrollmentResource{}
+)
+
+type DiagnosticSettingsEnrollmentResource struct{}
+
+type DiagnosticSettingsEnrollmentResourceModel struct {
+	Name                        string                                    `tfschema:"name"`
+	ScopeId                     string                                    `tfschema:"scope_id"`
+	ResourceTypes               []string                                  `tfschema:"resource_types"`
+	CategoryGroup               string                                    `tfschema:"category_group"`
+	MetricsEnabled              bool                                      `tfschema:"metrics_enabled"`
+	EventHubAuthorizationRuleId string                                    `tfschema:"eventhub_authorization_rule_id"`
+	EventHubName                string                                    `tfschema:"eventhub_name"`
+	LogAnalyticsWorkspaceId     string                                    `tfschema:"log_analytics_workspace_id"`
+	LogAnalyticsDestinationType string                                    `tfschema:"log_analytics_destination_type"`
+	StorageAccountId            string                                    `tfschema:"storage_account_id"`
+	DiscoveredTargets           []DiagnosticSettingsEnrollmentTargetModel `tfschema:"discovered_target"`
+	TargetResourceIds           []string                                  `tfschema:"target_resource_ids"`
+	UnsupportedResourceIds      []string                                  `tfschema:"unsupported_resource_ids"`
+}
+
+type DiagnosticSettingsEnrollmentTargetModel struct {
+	ResourceId     string `tfschema:"resource_id"`
+	LogsEnabled    bool   `tfschema:"logs_enabled"`
+	MetricsEnabled bool   `tfschema:"metrics_enabled"`
+}
+
+// DiagnosticSettingsEnrollmentId is the Terraform ID of an enrollment, which uses the same `{scope}|{name}` format
+// as `azurerm_monitor_diagnostic_setting` since the enrollment is not an Azure resource itself.
+type DiagnosticSettingsEnrollmentId struct {
+	ScopeId string
+	Name    string
+}
+
+func NewDiagnosticSettingsEnrollmentID(scopeId, name string) DiagnosticSettingsEnrollmentId {
+	return DiagnosticSettingsEnrollmentId{
+		ScopeId: scopeId,
+		Name:    name,
+	}
+}
+
+func (id DiagnosticSettingsEnrollmentId) ID() string {
+	return fmt.Sprintf("%s|%s", id.ScopeId, id.Name)
+}
+
+func (id DiagnosticSettingsEnrollmentId) String() string {
+	return fmt.Sprintf("Diagnostic Settings Enrollment (Scope: %q / Name: %q)", id.ScopeId, id.Name)
+}
+
+func ParseDiagnosticSettingsEnrollmentID(input string) (*DiagnosticSettingsEnrollmentId, error) {
+	v := strings.Split(input, "|")
+	if len(v) != 2 || v[0] == "" || v[1] == "" {
+		return nil, fmt.Errorf("expected the Diagnostic Settings Enrollment ID to be in the format `{scopeId}|{name}` but got %q", input)
+	}
+
+	if _, err := commonids.ParseResourceGroupIDInsensitively(v[0]); err != nil {
+		if _, err := commonids.ParseSubscriptionIDInsensitively(v[0]); err != nil {
+			return nil, fmt.Errorf("expected the scope of the Diagnostic Settings Enrollment ID to be a Subscription or Resource Group ID but got %q", v[0])
+		}
+	}
+
+	id := NewDiagnosticSettingsEnrollmentID(v[0], v[1])
+	return &id, nil
+}
+
+func ValidateDiagnosticSettingsEnrollmentID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseDiagnosticSettingsEnrollmentID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+func (r DiagnosticSettingsEnrollmentResource) ResourceType() string {
+	return "azurerm_monitor_diagnostic_settings_enrollment"
+}
+
+func (r DiagnosticSettingsEnrollmentResource) ModelObject() interface{} {
+	return &DiagnosticSettingsEnrollmentResourceModel{}
+}
+
+func (r DiagnosticSettingsEnrollmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return ValidateDiagnosticSettingsEnrollmentID
+}
+
+func (r DiagnosticSettingsEnrollmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"scope_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				commonids.ValidateSubscriptionID,
+				commonids.ValidateResourceGroupID,
+			),
+		},
+
+		"resource_types": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"category_group": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "allLogs",
+			ValidateFunc: validation.StringInSlice([]string{
+				"allLogs",
+				"audit",
+			}, false),
+		},
+
+		"metrics_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"eventhub_authorization_rule_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: authorizationrulesnamespaces.ValidateAuthorizationRuleID,
+			AtLeastOneOf: []string{"eventhub_authorization_rule_id", "log_analytics_workspace_id", "storage_account_id"},
+		},
+
+		"eventhub_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"eventhub_authorization_rule_id"},
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+			AtLeastOneOf: []string{"eventhub_authorization_rule_id", "log_analytics_workspace_id", "storage_account_id"},
+		},
+
+		"log_analytics_destination_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Dedicated",
+				"AzureDiagnostics",
+			}, false),
+			RequiredWith: []string{"log_analytics_workspace_id"},
+		},
+
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+			AtLeastOneOf: []string{"eventhub_authorization_rule_id", "log_analytics_workspace_id", "storage_account_id"},
+		},
+	}
+}
+
+func (r DiagnosticSettingsEnrollmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"discovered_target": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"logs_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"metrics_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"target_resource_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"unsupported_resource_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r DiagnosticSettingsEnrollmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+			var config DiagnosticSettingsEnrollmentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := NewDiagnosticSettingsEnrollmentID(config.ScopeId, config.Name)
+
+			targets, err := discoverDiagnosticSettingsEnrollmentTargets(ctx, metadata, config)
+			if err != nil {
+				return fmt.Errorf("discovering targets for %s: %+v", id, err)
+			}
+
+			for _, target := range targets.supported {
+				settingId := diagnosticsettings.NewScopedDiagnosticSettingID(target.id, config.Name)
+				existing, err := client.Get(ctx, settingId)
+				if err != nil && !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for existing %s: %+v", settingId, err)
+				}
+
+				if !response.WasNotFound(existing.HttpResponse) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			// the discovered targets are persisted before any Diagnostic Setting is created, so that a failure part-way
+			// through leaves a tainted enrollment tracking every target, whose Diagnostic Settings are removed when it's replaced
+			config.DiscoveredTargets = flattenDiagnosticSettingsEnrollmentTargets(targets.supported)
+			config.TargetResourceIds = make([]string, 0)
+			config.UnsupportedResourceIds = targets.unsupported
+			metadata.SetID(id)
+			if err := metadata.Encode(&config); err != nil {
+				return fmt.Errorf("encoding: %+v", err)
+			}
+
+			if err := applyDiagnosticSettingsEnrollment(ctx, client, config, targets.supported); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DiagnosticSettingsEnrollmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+			id, err := ParseDiagnosticSettingsEnrollmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state DiagnosticSettingsEnrollmentResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.Name = id.Name
+			state.ScopeId = id.ScopeId
+			if state.CategoryGroup == "" {
+				state.CategoryGroup = "allLogs"
+			}
+
+			// when importing, the destination isn't known yet - so it's taken from the first enrolled target
+			importing := state.LogAnalyticsWorkspaceId == "" && state.StorageAccountId == "" && state.EventHubAuthorizationRuleId == ""
+
+			// the targets are only discovered when importing, otherwise the targets discovered when the enrollment was
+			// created, or when its filters were last updated, are used
+			if importing {
+				targets, err := discoverDiagnosticSettingsEnrollmentTargets(ctx, metadata, state)
+				if err != nil {
+					return fmt.Errorf("discovering targets for %s: %+v", id, err)
+				}
+
+				state.DiscoveredTargets = flattenDiagnosticSettingsEnrollmentTargets(targets.supported)
+				state.UnsupportedResourceIds = targets.unsupported
+			}
+
+			state.TargetResourceIds = make([]string, 0)
+			for _, target := range expandDiagnosticSettingsEnrollmentTargets(state.DiscoveredTargets) {
+				settingId := diagnosticsettings.NewScopedDiagnosticSettingID(target.id, id.Name)
+				resp, err := client.Get(ctx, settingId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						log.Printf("[DEBUG] %s was not found - the target will be re-enrolled", settingId)
+						continue
+					}
+					return fmt.Errorf("retrieving %s: %+v", settingId, err)
+				}
+
+				if resp.Model == nil || resp.Model.Properties == nil {
+					continue
+				}
+				props := resp.Model.Properties
+
+				if importing {
+					state.EventHubAuthorizationRuleId = pointer.From(props.EventHubAuthorizationRuleId)
+					state.EventHubName = pointer.From(props.EventHubName)
+					state.LogAnalyticsWorkspaceId = pointer.From(props.WorkspaceId)
+					state.LogAnalyticsDestinationType = pointer.From(props.LogAnalyticsDestinationType)
+					state.StorageAccountId = pointer.From(props.StorageAccountId)
+					for _, v := range pointer.From(props.Metrics) {
+						if v.Enabled {
+							state.MetricsEnabled = true
+						}
+					}
+					for _, v := range pointer.From(props.Logs) {
+						if v.Enabled && v.CategoryGroup != nil {
+							state.CategoryGroup = *v.CategoryGroup
+						}
+					}
+					importing = false
+				}
+
+				if diagnosticSettingsEnrollmentSettingMatches(state, target, *props) {
+					state.TargetResourceIds = append(state.TargetResourceIds, target.id)
+				} else {
+					log.Printf("[DEBUG] %s has drifted from the enrollment configuration", settingId)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DiagnosticSettingsEnrollmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+			id, err := ParseDiagnosticSettingsEnrollmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config DiagnosticSettingsEnrollmentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			oldTargets, _ := metadata.ResourceData.GetChange("discovered_target")
+			previous := expandDiagnosticSettingsEnrollmentTargets(expandDiagnosticSettingsEnrollmentTargetModels(oldTargets.([]interface{})))
+
+			// the targets are only rediscovered when the filters change, otherwise the previously discovered targets are used
+			targets := &diagnosticSettingsEnrollmentTargets{
+				supported:   previous,
+				unsupported: config.UnsupportedResourceIds,
+			}
+			if metadata.ResourceData.HasChanges("resource_types", "category_group", "metrics_enabled") {
+				targets, err = discoverDiagnosticSettingsEnrollmentTargets(ctx, metadata, config)
+				if err != nil {
+					return fmt.Errorf("discovering targets for %s: %+v", id, err)
+				}
+			}
+
+			// targets which were previously enrolled but no longer match the filters are removed from the enrollment
+			removed := make([]diagnosticSettingsEnrollmentTarget, 0)
+			for _, target := range previous {
+				if !slices.ContainsFunc(targets.supported, func(t diagnosticSettingsEnrollmentTarget) bool {
+					return strings.EqualFold(t.id, target.id)
+				}) {
+					removed = append(removed, target)
+				}
+			}
+
+			// the removed targets remain tracked until their Diagnostic Setting has been deleted, so that a failure
+			// part-way through doesn't leave any Diagnostic Setting untracked
+			config.DiscoveredTargets = flattenDiagnosticSettingsEnrollmentTargets(append(slices.Clone(targets.supported), removed...))
+			config.UnsupportedResourceIds = targets.unsupported
+			if err := metadata.Encode(&config); err != nil {
+				return fmt.Errorf("encoding: %+v", err)
+			}
+
+			if err := applyDiagnosticSettingsEnrollment(ctx, client, config, targets.supported); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			for _, target := range removed {
+				settingId := diagnosticsettings.NewScopedDiagnosticSettingID(target.id, id.Name)
+				if resp, err := client.Delete(ctx, settingId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", settingId, err)
+				}
+			}
+
+			config.DiscoveredTargets = flattenDiagnosticSettingsEnrollmentTargets(targets.supported)
+			return metadata.Encode(&config)
+		},
+	}
+}
+
+func (r DiagnosticSettingsEnrollmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+			id, err := ParseDiagnosticSettingsEnrollmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state DiagnosticSettingsEnrollmentResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// `target_resource_ids` omits targets whose Diagnostic Setting has drifted, which must still be removed
+			for _, target := range state.DiscoveredTargets {
+				settingId := diagnosticsettings.NewScopedDiagnosticSettingID(target.ResourceId, id.Name)
+				if resp, err := client.Delete(ctx, settingId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", settingId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DiagnosticSettingsEnrollmentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceDiff.Id() == "" {
+				return nil
+			}
+
+			if metadata.ResourceDiff.HasChange("scope_id") {
+				return nil
+			}
+
+			// discovering the targets lists every resource within the scope and the categories of each resource type, so
+			// this is only done when the filters change - the targets are then discovered during the update
+			if metadata.ResourceDiff.HasChanges("resource_types", "category_group", "metrics_enabled") {
+				for _, key := range []string{"discovered_target", "target_resource_ids", "unsupported_resource_ids"} {
+					if err := metadata.ResourceDiff.SetNewComputed(key); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+
+			var config DiagnosticSettingsEnrollmentResourceModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			supported := make([]string, 0)
+			for _, v := range config.DiscoveredTargets {
+				supported = append(supported, v.ResourceId)
+			}
+
+			// targets which are missing a Diagnostic Setting, or whose Diagnostic Setting has drifted, are omitted from
+			// `target_resource_ids` during Read - so any difference here means the enrollment needs to be re-applied
+			if !slices.EqualFunc(config.TargetResourceIds, supported, strings.EqualFold) {
+				if err := metadata.ResourceDiff.SetNew("target_resource_ids", supported); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+type diagnosticSettingsEnrollmentTarget struct {
+	id             string
+	logsSupported  bool
+	metricsEnabled bool
+}
+
+type diagnosticSettingsEnrollmentTargets struct {
+	supported   []diagnosticSettingsEnrollmentTarget
+	unsupported []string
+}
+
+// discoverDiagnosticSettingsEnrollmentTargets lists the resources within the scope matching the `resource_types` filter and
+// determines whether each supports the configured category group (or metrics). Support is looked up once per resource type.
+func discoverDiagnosticSettingsEnrollmentTargets(ctx context.Context, metadata sdk.ResourceMetaData, config DiagnosticSettingsEnrollmentResourceModel) (*diagnosticSettingsEnrollmentTargets, error) {
+	categoriesClient := metadata.Client.Monitor.DiagnosticSettingsCategoryClient
+
+	resourceIds, err := listDiagnosticSettingsEnrollmentResources(ctx, metadata, config.ScopeId, config.ResourceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	type capabilities struct {
+		logs    bool
+		metrics bool
+	}
+	capabilitiesByType := make(map[string]capabilities)
+
+	result := &diagnosticSettingsEnrollmentTargets{
+		supported:   make([]diagnosticSettingsEnrollmentTarget, 0),
+		unsupported: make([]string, 0),
+	}
+	for _, resource := range resourceIds {
+		resourceType := strings.ToLower(resource.resourceType)
+		c, ok := capabilitiesByType[resourceType]
+		if !ok {
+			resp, err := categoriesClient.DiagnosticSettingsCategoryList(ctx, commonids.NewScopeID(resource.id))
+			if err != nil {
+				// resource types which don't support Diagnostic Settings return an error rather than an empty list
+				log.Printf("[DEBUG] listing Diagnostic Settings Categories for %q: %+v", resource.id, err)
+			}
+
+			if resp.Model != nil {
+				for _, category := range pointer.From(resp.Model.Value) {
+					if category.Properties == nil {
+						continue
+					}
+
+					switch pointer.From(category.Properties.CategoryType) {
+					case diagnosticsettingscategories.CategoryTypeLogs:
+						for _, group := range pointer.From(category.Properties.CategoryGroups) {
+							if strings.EqualFold(group, config.CategoryGroup) {
+								c.logs = true
+							}
+						}
+					case diagnosticsettingscategories.CategoryTypeMetrics:
+						c.metrics = true
+					}
+				}
+			}
+
+			capabilitiesByType[resourceType] = c
+		}
+
+		target := diagnosticSettingsEnrollmentTarget{
+			id:             resource.id,
+			logsSupported:  c.logs,
+			metricsEnabled: config.MetricsEnabled && c.metrics,
+		}
+		if !target.logsSupported && !target.metricsEnabled {
+			result.unsupported = append(result.unsupported, resource.id)
+			continue
+		}
+
+		result.supported = append(result.supported, target)
+	}
+
+	return result, nil
+}
+
+func flattenDiagnosticSettingsEnrollmentTargets(input []diagnosticSettingsEnrollmentTarget) []DiagnosticSettingsEnrollmentTargetModel {
+	output := make([]DiagnosticSettingsEnrollmentTargetModel, 0)
+	for _, v := range input {
+		output = append(output, DiagnosticSettingsEnrollmentTargetModel{
+			ResourceId:     v.id,
+			LogsEnabled:    v.logsSupported,
+			MetricsEnabled: v.metricsEnabled,
+		})
+	}
+
+	return output
+}
+
+func expandDiagnosticSettingsEnrollmentTargets(input []DiagnosticSettingsEnrollmentTargetModel) []diagnosticSettingsEnrollmentTarget {
+	output := make([]diagnosticSettingsEnrollmentTarget, 0)
+	for _, v := range input {
+		output = append(output, diagnosticSettingsEnrollmentTarget{
+			id:             v.ResourceId,
+			logsSupported:  v.LogsEnabled,
+			metricsEnabled: v.MetricsEnabled,
+		})
+	}
+
+	return output
+}
+
+// expandDiagnosticSettingsEnrollmentTargetModels converts the raw value of `discovered_target`, which is needed when
+// retrieving the previous value of the block during an update
+func expandDiagnosticSettingsEnrollmentTargetModels(input []interface{}) []DiagnosticSettingsEnrollmentTargetModel {
+	output := make([]DiagnosticSettingsEnrollmentTargetModel, 0)
+	for _, item := range input {
+		v, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		output = append(output, DiagnosticSettingsEnrollmentTargetModel{
+			ResourceId:     v["resource_id"].(string),
+			LogsEnabled:    v["logs_enabled"].(bool),
+			MetricsEnabled: v["metrics_enabled"].(bool),
+		})
+	}
+
+	return output
+}
+
+type diagnosticSettingsEnrollmentResource struct {
+	id           string
+	resourceType string
+}
+
+func listDiagnosticSettingsEnrollmentResources(ctx context.Context, metadata sdk.ResourceMetaData, scopeId string, resourceTypes []string) ([]diagnosticSettingsEnrollmentResource, error) {
+	matchesType := func(resourceType string) bool {
+		if len(resourceTypes) == 0 {
+			return true
+		}
+		return slices.ContainsFunc(resourceTypes, func(v string) bool {
+			return strings.EqualFold(v, resourceType)
+		})
+	}
+
+	result := make([]diagnosticSettingsEnrollmentResource, 0)
+	if resourceGroupId, err := commonids.ParseResourceGroupIDInsensitively(scopeId); err == nil {
+		resp, err := metadata.Client.Resource.ResourceGroupsClient.ResourcesListByResourceGroupComplete(ctx, *resourceGroupId, resourcegroups.DefaultResourcesListByResourceGroupOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing resources within %s: %+v", resourceGroupId, err)
+		}
+
+		for _, v := range resp.Items {
+			if v.Id != nil && matchesType(pointer.From(v.Type)) {
+				result = append(result, diagnosticSettingsEnrollmentResource{
+					id:           *v.Id,
+					resourceType: pointer.From(v.Type),
+				})
+			}
+		}
+	} else {
+		subscriptionId, err := commonids.ParseSubscriptionIDInsensitively(scopeId)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := metadata.Client.Resource.ResourcesClient.ListComplete(ctx, *subscriptionId, resources.DefaultListOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing resources within %s: %+v", subscriptionId, err)
+		}
+
+		for _, v := range resp.Items {
+			if v.Id != nil && matchesType(pointer.From(v.Type)) {
+				result = append(result, diagnosticSettingsEnrollmentResource{
+					id:           *v.Id,
+					resourceType: pointer.From(v.Type),
+				})
+			}
+		}
+	}
+
+	slices.SortFunc(result, func(a, b diagnosticSettingsEnrollmentResource) int {
+		return strings.Compare(strings.ToLower(a.id), strings.ToLower(b.id))
+	})
+
+	return result, nil
+}
+
+func expandDiagnosticSettingsEnrollmentSetting(config DiagnosticSettingsEnrollmentResourceModel, target diagnosticSettingsEnrollmentTarget) diagnosticsettings.DiagnosticSettingsResource {
+	logs := make([]diagnosticsettings.LogSettings, 0)
+	if target.logsSupported {
+		logs = append(logs, diagnosticsettings.LogSettings{
+			CategoryGroup: pointer.To(config.CategoryGroup),
+			Enabled:       true,
+		})
+	}
+
+	metrics := make([]diagnosticsettings.MetricSettings, 0)
+	if target.metricsEnabled {
+		metrics = append(metrics, diagnosticsettings.MetricSettings{
+			Category: pointer.To("AllMetrics"),
+			Enabled:  true,
+		})
+	}
+
+	payload := diagnosticsettings.DiagnosticSettingsResource{
+		Properties: &diagnosticsettings.DiagnosticSettings{
+			Logs:    &logs,
+			Metrics: &metrics,
+		},
+	}
+
+	if config.EventHubAuthorizationRuleId != "" {
+		payload.Properties.EventHubAuthorizationRuleId = pointer.To(config.EventHubAuthorizationRuleId)
+		if config.EventHubName != "" {
+			payload.Properties.EventHubName = pointer.To(config.EventHubName)
+		}
+	}
+
+	if config.LogAnalyticsWorkspaceId != "" {
+		payload.Properties.WorkspaceId = pointer.To(config.LogAnalyticsWorkspaceId)
+		if config.LogAnalyticsDestinationType != "" {
+			payload.Properties.LogAnalyticsDestinationType = pointer.To(config.LogAnalyticsDestinationType)
+		}
+	}
+
+	if config.StorageAccountId != "" {
+		payload.Properties.StorageAccountId = pointer.To(config.StorageAccountId)
+	}
+
+	return payload
+}
+
+func applyDiagnosticSettingsEnrollment(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, config DiagnosticSettingsEnrollmentResourceModel, targets []diagnosticSettingsEnrollmentTarget) error {
+	for _, target := range targets {
+		settingId := diagnosticsettings.NewScopedDiagnosticSettingID(target.id, config.Name)
+		if _, err := client.CreateOrUpdate(ctx, settingId, expandDiagnosticSettingsEnrollmentSetting(config, target)); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", settingId, err)
+		}
+	}
+
+	return nil
+}
+
+// diagnosticSettingsEnrollmentSettingMatches returns whether an existing Diagnostic Setting on a target matches the enrollment configuration.
+func diagnosticSettingsEnrollmentSettingMatches(config DiagnosticSettingsEnrollmentResourceModel, target diagnosticSettingsEnrollmentTarget, props diagnosticsettings.DiagnosticSettings) bool {
+	if !strings.EqualFold(pointer.From(props.WorkspaceId), config.LogAnalyticsWorkspaceId) ||
+		!strings.EqualFold(pointer.From(props.StorageAccountId), config.StorageAccountId) ||
+		!strings.EqualFold(pointer.From(props.EventHubAuthorizationRuleId), config.EventHubAuthorizationRuleId) ||
+		pointer.From(props.EventHubName) != config.EventHubName {
+		return false
+	}
+
+	if config.LogAnalyticsDestinationType != "" && !strings.EqualFold(pointer.From(props.LogAnalyticsDestinationType), config.LogAnalyticsDestinationType) {
+		return false
+	}
+
+	logsEnabled := false
+	for _, v := range pointer.From(props.Logs) {
+		if v.Enabled && strings.EqualFold(pointer.From(v.CategoryGroup), config.CategoryGroup) {
+			logsEnabled = true
+		}
+	}
+	if logsEnabled != target.logsSupported {
+		return false
+	}
+
+	metricsEnabled := false
+	for _, v := range pointer.From(props.Metrics) {
+		if v.Enabled {
+			metricsEnabled = true
+		}
+	}
+
+	return metricsEnabled == target.metricsEnabled
+}
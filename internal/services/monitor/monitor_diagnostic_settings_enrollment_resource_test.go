@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MonitorDiagnosticSettingsEnrollmentResource struct{}
+
+func (r MonitorDiagnosticSettingsEnrollmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := monitor.ParseDiagnosticSettingsEnrollmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	target := state.Attributes["target_resource_ids.0"]
+	if target == "" {
+		return pointer.To(false), nil
+	}
+
+	settingId := diagnosticsettings.NewScopedDiagnosticSettingID(target, id.Name)
+	resp, err := client.Monitor.DiagnosticSettingsClient.Get(ctx, settingId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", settingId, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func TestAccMonitorDiagnosticSettingsEnrollment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_settings_enrollment", "test")
+	r := MonitorDiagnosticSettingsEnrollmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("discovered_target.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorDiagnosticSettingsEnrollment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_settings_enrollment", "test")
+	r := MonitorDiagnosticSettingsEnrollmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorDiagnosticSettingsEnrollment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_settings_enrollment", "test")
+	r := MonitorDiagnosticSettingsEnrollmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MonitorDiagnosticSettingsEnrollmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_diagnostic_settings_enrollment" "test" {
+  name                       = "acctest-dse-%d"
+  scope_id                   = azurerm_resource_group.test.id
+  resource_types             = ["Microsoft.KeyVault/vaults", "Microsoft.Logic/workflows"]
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+
+  depends_on = [azurerm_key_vault.test, azurerm_logic_app_workflow.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MonitorDiagnosticSettingsEnrollmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_diagnostic_settings_enrollment" "import" {
+  name                       = azurerm_monitor_diagnostic_settings_enrollment.test.name
+  scope_id                   = azurerm_monitor_diagnostic_settings_enrollment.test.scope_id
+  resource_types             = azurerm_monitor_diagnostic_settings_enrollment.test.resource_types
+  log_analytics_workspace_id = azurerm_monitor_diagnostic_settings_enrollment.test.log_analytics_workspace_id
+}
+`, r.basic(data))
+}
+
+func (r MonitorDiagnosticSettingsEnrollmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_diagnostic_settings_enrollment" "test" {
+  name                           = "acctest-dse-%d"
+  scope_id                       = azurerm_resource_group.test.id
+  resource_types                 = ["Microsoft.KeyVault/vaults"]
+  category_group                 = "audit"
+  metrics_enabled                = true
+  log_analytics_workspace_id     = azurerm_log_analytics_workspace.test.id
+  log_analytics_destination_type = "Dedicated"
+  storage_account_id             = azurerm_storage_account.test.id
+
+  depends_on = [azurerm_key_vault.test, azurerm_logic_app_workflow.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MonitorDiagnosticSettingsEnrollmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-dse-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsadse%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acctestkv%[3]s"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+}
+
+resource "azurerm_logic_app_workflow" "test" {
+  name                = "acctestlaw-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
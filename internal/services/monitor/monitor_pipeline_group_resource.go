@@ -0,0 +1,1164 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = PipelineGroupResource{}
+	_ sdk.ResourceWithCustomizeDiff = PipelineGroupResource{}
+)
+
+type PipelineGroupResource struct{}
+
+type PipelineGroupResourceModel struct {
+	Name                 string                    `tfschema:"name"`
+	ResourceGroupName    string                    `tfschema:"resource_group_name"`
+	Location             string                    `tfschema:"location"`
+	CustomLocationId     string                    `tfschema:"custom_location_id"`
+	Exporter             []PipelineGroupExporter   `tfschema:"exporter"`
+	Networking           []PipelineGroupNetworking `tfschema:"networking"`
+	PersistentVolumeName string                    `tfschema:"persistent_volume_name"`
+	Pipeline             []PipelineGroupPipeline   `tfschema:"pipeline"`
+	Processor            []PipelineGroupProcessor  `tfschema:"processor"`
+	Receiver             []PipelineGroupReceiver   `tfschema:"receiver"`
+	Replicas             int64                     `tfschema:"replicas"`
+	Tags                 map[string]string         `tfschema:"tags"`
+}
+
+type PipelineGroupReceiver struct {
+	Name   string                        `tfschema:"name"`
+	Otlp   []PipelineGroupOtlpReceiver   `tfschema:"otlp"`
+	Syslog []PipelineGroupSyslogReceiver `tfschema:"syslog"`
+	Udp    []PipelineGroupUdpReceiver    `tfschema:"udp"`
+}
+
+type PipelineGroupOtlpReceiver struct {
+	Endpoint string `tfschema:"endpoint"`
+}
+
+type PipelineGroupSyslogReceiver struct {
+	Endpoint string `tfschema:"endpoint"`
+	Protocol string `tfschema:"protocol"`
+}
+
+type PipelineGroupUdpReceiver struct {
+	Encoding        string `tfschema:"encoding"`
+	Endpoint        string `tfschema:"endpoint"`
+	ReadQueueLength int64  `tfschema:"read_queue_length"`
+}
+
+type PipelineGroupProcessor struct {
+	Name  string                        `tfschema:"name"`
+	Batch []PipelineGroupBatchProcessor `tfschema:"batch"`
+}
+
+type PipelineGroupBatchProcessor struct {
+	BatchSize             int64 `tfschema:"batch_size"`
+	TimeoutInMilliseconds int64 `tfschema:"timeout_in_milliseconds"`
+}
+
+type PipelineGroupExporter struct {
+	Name                      string                                   `tfschema:"name"`
+	AzureMonitorWorkspaceLogs []PipelineGroupAzureMonitorWorkspaceLogs `tfschema:"azure_monitor_workspace_logs"`
+}
+
+type PipelineGroupAzureMonitorWorkspaceLogs struct {
+	DataCollectionEndpointUrl     string                     `tfschema:"data_collection_endpoint_url"`
+	DataCollectionRuleImmutableId string                     `tfschema:"data_collection_rule_immutable_id"`
+	Stream                        string                     `tfschema:"stream"`
+	RecordMap                     []PipelineGroupFieldMap    `tfschema:"record_map"`
+	ResourceMap                   []PipelineGroupFieldMap    `tfschema:"resource_map"`
+	ScopeMap                      []PipelineGroupFieldMap    `tfschema:"scope_map"`
+	Cache                         []PipelineGroupCache       `tfschema:"cache"`
+	Concurrency                   []PipelineGroupConcurrency `tfschema:"concurrency"`
+}
+
+type PipelineGroupFieldMap struct {
+	From string `tfschema:"from"`
+	To   string `tfschema:"to"`
+}
+
+type PipelineGroupCache struct {
+	MaxStorageUsageInMb      int64 `tfschema:"max_storage_usage_in_mb"`
+	RetentionPeriodInMinutes int64 `tfschema:"retention_period_in_minutes"`
+}
+
+type PipelineGroupConcurrency struct {
+	BatchQueueSize int64 `tfschema:"batch_queue_size"`
+	WorkerCount    int64 `tfschema:"worker_count"`
+}
+
+type PipelineGroupPipeline struct {
+	Name       string   `tfschema:"name"`
+	Type       string   `tfschema:"type"`
+	Receivers  []string `tfschema:"receivers"`
+	Processors []string `tfschema:"processors"`
+	Exporters  []string `tfschema:"exporters"`
+}
+
+type PipelineGroupNetworking struct {
+	ExternalNetworkingMode string                         `tfschema:"external_networking_mode"`
+	Host                   string                         `tfschema:"host"`
+	Route                  []PipelineGroupNetworkingRoute `tfschema:"route"`
+}
+
+type PipelineGroupNetworkingRoute struct {
+	Receiver  string `tfschema:"receiver"`
+	Port      int64  `tfschema:"port"`
+	Path      string `tfschema:"path"`
+	Subdomain string `tfschema:"subdomain"`
+}
+
+func (r PipelineGroupResource) ResourceType() string {
+	return "azurerm_monitor_pipeline_group"
+}
+
+func (r PipelineGroupResource) ModelObject() interface{} {
+	return &PipelineGroupResourceModel{}
+}
+
+func (r PipelineGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidatePipelineGroupID
+}
+
+func (r PipelineGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	fieldMapSchema := func(required bool) *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Required: required,
+			Optional: !required,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"from": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"to": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: customlocations.ValidateCustomLocationID,
+		},
+
+		"receiver": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"otlp": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"endpoint": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"syslog": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"endpoint": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"protocol": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      "rfc3164",
+									ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForSyslogProtocol(), false),
+								},
+							},
+						},
+					},
+
+					"udp": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"endpoint": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"encoding": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      "nop",
+									ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForUdpEncoding(), false),
+								},
+
+								"read_queue_length": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      1000,
+									ValidateFunc: validation.IntBetween(100, 100000),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"processor": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"batch": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"batch_size": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      1000,
+									ValidateFunc: validation.IntBetween(10, 100000),
+								},
+
+								"timeout_in_milliseconds": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      1000,
+									ValidateFunc: validation.IntBetween(10, 3600000),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"exporter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"azure_monitor_workspace_logs": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"data_collection_endpoint_url": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsURLWithHTTPS,
+								},
+
+								"data_collection_rule_immutable_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"stream": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"record_map": fieldMapSchema(true),
+
+								"resource_map": fieldMapSchema(false),
+
+								"scope_map": fieldMapSchema(false),
+
+								"cache": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"max_storage_usage_in_mb": {
+												Type:         pluginsdk.TypeInt,
+												Optional:     true,
+												ValidateFunc: validation.IntBetween(100, 100000),
+											},
+
+											"retention_period_in_minutes": {
+												Type:         pluginsdk.TypeInt,
+												Optional:     true,
+												ValidateFunc: validation.IntBetween(1, 14400),
+											},
+										},
+									},
+								},
+
+								"concurrency": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"batch_queue_size": {
+												Type:         pluginsdk.TypeInt,
+												Optional:     true,
+												ValidateFunc: validation.IntBetween(1, 1000),
+											},
+
+											"worker_count": {
+												Type:         pluginsdk.TypeInt,
+												Optional:     true,
+												ValidateFunc: validation.IntBetween(1, 1000),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"pipeline": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"receivers": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"exporters": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"processors": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(azuresdkhacks.PipelineTypeLogs),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(azuresdkhacks.PipelineTypeLogs),
+						}, false),
+					},
+				},
+			},
+		},
+
+		"networking": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"route": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"receiver": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"path": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"port": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IsPortNumber,
+								},
+
+								"subdomain": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"external_networking_mode": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(azuresdkhacks.ExternalNetworkingModeLoadBalancerOnly),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(azuresdkhacks.ExternalNetworkingModeLoadBalancerOnly),
+						}, false),
+					},
+
+					"host": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"persistent_volume_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"replicas": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r PipelineGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PipelineGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.PipelineGroupsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model PipelineGroupResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := azuresdkhacks.NewPipelineGroupID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandPipelineGroupProperties(model)
+			if err != nil {
+				return err
+			}
+
+			payload := azuresdkhacks.PipelineGroup{
+				ExtendedLocation: &azuresdkhacks.ExtendedLocation{
+					Name: model.CustomLocationId,
+					Type: azuresdkhacks.ExtendedLocationTypeCustomLocation,
+				},
+				Location:   location.Normalize(model.Location),
+				Properties: properties,
+				Tags:       pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PipelineGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.PipelineGroupsClient
+
+			id, err := azuresdkhacks.ParsePipelineGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PipelineGroupResourceModel{
+				Name:              id.PipelineGroupName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if extendedLocation := model.ExtendedLocation; extendedLocation != nil {
+					customLocationId, err := customlocations.ParseCustomLocationIDInsensitively(extendedLocation.Name)
+					if err != nil {
+						return err
+					}
+					state.CustomLocationId = customLocationId.ID()
+				}
+
+				if props := model.Properties; props != nil {
+					state.Replicas = pointer.From(props.Replicas)
+					state.Receiver = flattenPipelineGroupReceivers(props.Receivers)
+					state.Processor = flattenPipelineGroupProcessors(props.Processors)
+					state.Exporter = flattenPipelineGroupExporters(props.Exporters)
+					state.Pipeline = flattenPipelineGroupPipelines(props.Service.Pipelines)
+					state.Networking = flattenPipelineGroupNetworking(props.NetworkingConfigurations)
+
+					if persistence := props.Service.Persistence; persistence != nil {
+						state.PersistentVolumeName = persistence.PersistentVolumeName
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PipelineGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.PipelineGroupsClient
+
+			id, err := azuresdkhacks.ParsePipelineGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PipelineGroupResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			properties, err := expandPipelineGroupProperties(model)
+			if err != nil {
+				return err
+			}
+			payload.Properties = properties
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PipelineGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.PipelineGroupsClient
+
+			id, err := azuresdkhacks.ParsePipelineGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PipelineGroupResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config PipelineGroupResourceModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return validatePipelineGroupReferences(config)
+		},
+	}
+}
+
+// validatePipelineGroupReferences checks that every receiver, processor and exporter referenced by a pipeline or
+// networking route is defined within the Pipeline Group, and that each receiver configures exactly one receiver type.
+// Names which are not yet known at plan time are skipped.
+func validatePipelineGroupReferences(config PipelineGroupResourceModel) error {
+	receivers := make(map[string]struct{})
+	for _, v := range config.Receiver {
+		if v.Name == "" {
+			continue
+		}
+		if _, exists := receivers[v.Name]; exists {
+			return fmt.Errorf("the receiver name %q must be unique within the Pipeline Group", v.Name)
+		}
+		receivers[v.Name] = struct{}{}
+
+		if count := len(v.Otlp) + len(v.Syslog) + len(v.Udp); count != 1 {
+			return fmt.Errorf("receiver %q must specify exactly one of `otlp`, `syslog` or `udp`, got %d", v.Name, count)
+		}
+	}
+
+	processors := make(map[string]struct{})
+	for _, v := range config.Processor {
+		if v.Name == "" {
+			continue
+		}
+		if _, exists := processors[v.Name]; exists {
+			return fmt.Errorf("the processor name %q must be unique within the Pipeline Group", v.Name)
+		}
+		processors[v.Name] = struct{}{}
+	}
+
+	exporters := make(map[string]struct{})
+	for _, v := range config.Exporter {
+		if v.Name == "" {
+			continue
+		}
+		if _, exists := exporters[v.Name]; exists {
+			return fmt.Errorf("the exporter name %q must be unique within the Pipeline Group", v.Name)
+		}
+		exporters[v.Name] = struct{}{}
+	}
+
+	for _, pipeline := range config.Pipeline {
+		for _, name := range pipeline.Receivers {
+			if _, ok := receivers[name]; name != "" && !ok {
+				return fmt.Errorf("pipeline %q references the receiver %q which is not defined in a `receiver` block", pipeline.Name, name)
+			}
+		}
+		for _, name := range pipeline.Processors {
+			if _, ok := processors[name]; name != "" && !ok {
+				return fmt.Errorf("pipeline %q references the processor %q which is not defined in a `processor` block", pipeline.Name, name)
+			}
+		}
+		for _, name := range pipeline.Exporters {
+			if _, ok := exporters[name]; name != "" && !ok {
+				return fmt.Errorf("pipeline %q references the exporter %q which is not defined in an `exporter` block", pipeline.Name, name)
+			}
+		}
+	}
+
+	for _, networking := range config.Networking {
+		for _, route := range networking.Route {
+			if _, ok := receivers[route.Receiver]; route.Receiver != "" && !ok {
+				return fmt.Errorf("a networking route references the receiver %q which is not defined in a `receiver` block", route.Receiver)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandPipelineGroupProperties(input PipelineGroupResourceModel) (*azuresdkhacks.PipelineGroupProperties, error) {
+	receivers, err := expandPipelineGroupReceivers(input.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &azuresdkhacks.PipelineGroupProperties{
+		Exporters:                expandPipelineGroupExporters(input.Exporter),
+		NetworkingConfigurations: expandPipelineGroupNetworking(input.Networking),
+		Processors:               expandPipelineGroupProcessors(input.Processor),
+		Receivers:                receivers,
+		Replicas:                 pointer.To(input.Replicas),
+		Service: azuresdkhacks.Service{
+			Pipelines: expandPipelineGroupPipelines(input.Pipeline),
+		},
+	}
+
+	if input.PersistentVolumeName != "" {
+		result.Service.Persistence = &azuresdkhacks.PersistenceConfigurations{
+			PersistentVolumeName: input.PersistentVolumeName,
+		}
+	}
+
+	return result, nil
+}
+
+func expandPipelineGroupReceivers(input []PipelineGroupReceiver) ([]azuresdkhacks.Receiver, error) {
+	result := make([]azuresdkhacks.Receiver, 0)
+	for _, v := range input {
+		receiver := azuresdkhacks.Receiver{
+			Name: v.Name,
+		}
+
+		switch {
+		case len(v.Otlp) > 0:
+			receiver.Type = azuresdkhacks.ReceiverTypeOTLP
+			receiver.Otlp = &azuresdkhacks.OtlpReceiver{
+				Endpoint: v.Otlp[0].Endpoint,
+			}
+		case len(v.Syslog) > 0:
+			receiver.Type = azuresdkhacks.ReceiverTypeSyslog
+			receiver.Syslog = &azuresdkhacks.SyslogReceiver{
+				Endpoint: v.Syslog[0].Endpoint,
+				Protocol: pointer.To(v.Syslog[0].Protocol),
+			}
+		case len(v.Udp) > 0:
+			receiver.Type = azuresdkhacks.ReceiverTypeUDP
+			receiver.Udp = &azuresdkhacks.UdpReceiver{
+				Encoding:        pointer.To(v.Udp[0].Encoding),
+				Endpoint:        v.Udp[0].Endpoint,
+				ReadQueueLength: pointer.To(v.Udp[0].ReadQueueLength),
+			}
+		default:
+			return nil, fmt.Errorf("receiver %q must specify one of `otlp`, `syslog` or `udp`", v.Name)
+		}
+
+		result = append(result, receiver)
+	}
+
+	return result, nil
+}
+
+func flattenPipelineGroupReceivers(input []azuresdkhacks.Receiver) []PipelineGroupReceiver {
+	result := make([]PipelineGroupReceiver, 0)
+	for _, v := range input {
+		receiver := PipelineGroupReceiver{
+			Name: v.Name,
+		}
+
+		if otlp := v.Otlp; otlp != nil {
+			receiver.Otlp = []PipelineGroupOtlpReceiver{
+				{
+					Endpoint: otlp.Endpoint,
+				},
+			}
+		}
+
+		if syslog := v.Syslog; syslog != nil {
+			receiver.Syslog = []PipelineGroupSyslogReceiver{
+				{
+					Endpoint: syslog.Endpoint,
+					Protocol: pointer.From(syslog.Protocol),
+				},
+			}
+		}
+
+		if udp := v.Udp; udp != nil {
+			receiver.Udp = []PipelineGroupUdpReceiver{
+				{
+					Encoding:        pointer.From(udp.Encoding),
+					Endpoint:        udp.Endpoint,
+					ReadQueueLength: pointer.From(udp.ReadQueueLength),
+				},
+			}
+		}
+
+		result = append(result, receiver)
+	}
+
+	return result
+}
+
+func expandPipelineGroupProcessors(input []PipelineGroupProcessor) []azuresdkhacks.Processor {
+	result := make([]azuresdkhacks.Processor, 0)
+	for _, v := range input {
+		processor := azuresdkhacks.Processor{
+			Name: v.Name,
+			Type: azuresdkhacks.ProcessorTypeBatch,
+		}
+
+		if len(v.Batch) > 0 {
+			processor.Batch = &azuresdkhacks.BatchProcessor{
+				BatchSize: pointer.To(v.Batch[0].BatchSize),
+				Timeout:   pointer.To(v.Batch[0].TimeoutInMilliseconds),
+			}
+		}
+
+		result = append(result, processor)
+	}
+
+	return result
+}
+
+func flattenPipelineGroupProcessors(input []azuresdkhacks.Processor) []PipelineGroupProcessor {
+	result := make([]PipelineGroupProcessor, 0)
+	for _, v := range input {
+		processor := PipelineGroupProcessor{
+			Name: v.Name,
+		}
+
+		if batch := v.Batch; batch != nil {
+			processor.Batch = []PipelineGroupBatchProcessor{
+				{
+					BatchSize:             pointer.From(batch.BatchSize),
+					TimeoutInMilliseconds: pointer.From(batch.Timeout),
+				},
+			}
+		}
+
+		result = append(result, processor)
+	}
+
+	return result
+}
+
+func expandPipelineGroupExporters(input []PipelineGroupExporter) []azuresdkhacks.Exporter {
+	result := make([]azuresdkhacks.Exporter, 0)
+	for _, v := range input {
+		exporter := azuresdkhacks.Exporter{
+			Name: v.Name,
+			Type: azuresdkhacks.ExporterTypeAzureMonitorWorkspaceLogs,
+		}
+
+		if len(v.AzureMonitorWorkspaceLogs) > 0 {
+			logs := v.AzureMonitorWorkspaceLogs[0]
+			config := &azuresdkhacks.AzureMonitorWorkspaceLogsExporter{
+				Api: azuresdkhacks.AzureMonitorWorkspaceLogsApiConfig{
+					DataCollectionEndpointUrl: logs.DataCollectionEndpointUrl,
+					DataCollectionRule:        logs.DataCollectionRuleImmutableId,
+					Stream:                    logs.Stream,
+					Schema: azuresdkhacks.SchemaMap{
+						RecordMap: make([]azuresdkhacks.RecordMap, 0),
+					},
+				},
+			}
+
+			for _, m := range logs.RecordMap {
+				config.Api.Schema.RecordMap = append(config.Api.Schema.RecordMap, azuresdkhacks.RecordMap{
+					From: m.From,
+					To:   m.To,
+				})
+			}
+
+			if len(logs.ResourceMap) > 0 {
+				resourceMap := make([]azuresdkhacks.ResourceMap, 0)
+				for _, m := range logs.ResourceMap {
+					resourceMap = append(resourceMap, azuresdkhacks.ResourceMap{
+						From: m.From,
+						To:   m.To,
+					})
+				}
+				config.Api.Schema.ResourceMap = &resourceMap
+			}
+
+			if len(logs.ScopeMap) > 0 {
+				scopeMap := make([]azuresdkhacks.ScopeMap, 0)
+				for _, m := range logs.ScopeMap {
+					scopeMap = append(scopeMap, azuresdkhacks.ScopeMap{
+						From: m.From,
+						To:   m.To,
+					})
+				}
+				config.Api.Schema.ScopeMap = &scopeMap
+			}
+
+			if len(logs.Cache) > 0 {
+				config.Cache = &azuresdkhacks.CacheConfiguration{}
+				if v := logs.Cache[0].MaxStorageUsageInMb; v != 0 {
+					config.Cache.MaxStorageUsage = pointer.To(v)
+				}
+				if v := logs.Cache[0].RetentionPeriodInMinutes; v != 0 {
+					config.Cache.RetentionPeriod = pointer.To(v)
+				}
+			}
+
+			if len(logs.Concurrency) > 0 {
+				config.Concurrency = &azuresdkhacks.ConcurrencyConfiguration{}
+				if v := logs.Concurrency[0].BatchQueueSize; v != 0 {
+					config.Concurrency.BatchQueueSize = pointer.To(v)
+				}
+				if v := logs.Concurrency[0].WorkerCount; v != 0 {
+					config.Concurrency.WorkerCount = pointer.To(v)
+				}
+			}
+
+			exporter.AzureMonitorWorkspaceLogs = config
+		}
+
+		result = append(result, exporter)
+	}
+
+	return result
+}
+
+func flattenPipelineGroupExporters(input []azuresdkhacks.Exporter) []PipelineGroupExporter {
+	result := make([]PipelineGroupExporter, 0)
+	for _, v := range input {
+		exporter := PipelineGroupExporter{
+			Name: v.Name,
+		}
+
+		if logs := v.AzureMonitorWorkspaceLogs; logs != nil {
+			config := PipelineGroupAzureMonitorWorkspaceLogs{
+				DataCollectionEndpointUrl:     logs.Api.DataCollectionEndpointUrl,
+				DataCollectionRuleImmutableId: logs.Api.DataCollectionRule,
+				Stream:                        logs.Api.Stream,
+				RecordMap:                     make([]PipelineGroupFieldMap, 0),
+				ResourceMap:                   make([]PipelineGroupFieldMap, 0),
+				ScopeMap:                      make([]PipelineGroupFieldMap, 0),
+			}
+
+			for _, m := range logs.Api.Schema.RecordMap {
+				config.RecordMap = append(config.RecordMap, PipelineGroupFieldMap{
+					From: m.From,
+					To:   m.To,
+				})
+			}
+
+			for _, m := range pointer.From(logs.Api.Schema.ResourceMap) {
+				config.ResourceMap = append(config.ResourceMap, PipelineGroupFieldMap{
+					From: m.From,
+					To:   m.To,
+				})
+			}
+
+			for _, m := range pointer.From(logs.Api.Schema.ScopeMap) {
+				config.ScopeMap = append(config.ScopeMap, PipelineGroupFieldMap{
+					From: m.From,
+					To:   m.To,
+				})
+			}
+
+			if cache := logs.Cache; cache != nil {
+				config.Cache = []PipelineGroupCache{
+					{
+						MaxStorageUsageInMb:      pointer.From(cache.MaxStorageUsage),
+						RetentionPeriodInMinutes: pointer.From(cache.RetentionPeriod),
+					},
+				}
+			}
+
+			if concurrency := logs.Concurrency; concurrency != nil {
+				config.Concurrency = []PipelineGroupConcurrency{
+					{
+						BatchQueueSize: pointer.From(concurrency.BatchQueueSize),
+						WorkerCount:    pointer.From(concurrency.WorkerCount),
+					},
+				}
+			}
+
+			exporter.AzureMonitorWorkspaceLogs = []PipelineGroupAzureMonitorWorkspaceLogs{config}
+		}
+
+		result = append(result, exporter)
+	}
+
+	return result
+}
+
+func expandPipelineGroupPipelines(input []PipelineGroupPipeline) []azuresdkhacks.Pipeline {
+	result := make([]azuresdkhacks.Pipeline, 0)
+	for _, v := range input {
+		pipeline := azuresdkhacks.Pipeline{
+			Exporters: v.Exporters,
+			Name:      v.Name,
+			Receivers: v.Receivers,
+			Type:      azuresdkhacks.PipelineType(v.Type),
+		}
+
+		if len(v.Processors) > 0 {
+			pipeline.Processors = pointer.To(v.Processors)
+		}
+
+		result = append(result, pipeline)
+	}
+
+	return result
+}
+
+func flattenPipelineGroupPipelines(input []azuresdkhacks.Pipeline) []PipelineGroupPipeline {
+	result := make([]PipelineGroupPipeline, 0)
+	for _, v := range input {
+		result = append(result, PipelineGroupPipeline{
+			Exporters:  v.Exporters,
+			Name:       v.Name,
+			Processors: pointer.From(v.Processors),
+			Receivers:  v.Receivers,
+			Type:       string(v.Type),
+		})
+	}
+
+	return result
+}
+
+func expandPipelineGroupNetworking(input []PipelineGroupNetworking) *[]azuresdkhacks.NetworkingConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]azuresdkhacks.NetworkingConfiguration, 0)
+	for _, v := range input {
+		config := azuresdkhacks.NetworkingConfiguration{
+			ExternalNetworkingMode: azuresdkhacks.ExternalNetworkingMode(v.ExternalNetworkingMode),
+			Routes:                 make([]azuresdkhacks.NetworkingRoute, 0),
+		}
+
+		if v.Host != "" {
+			config.Host = pointer.To(v.Host)
+		}
+
+		for _, route := range v.Route {
+			r := azuresdkhacks.NetworkingRoute{
+				Receiver: route.Receiver,
+			}
+			if route.Path != "" {
+				r.Path = pointer.To(route.Path)
+			}
+			if route.Port != 0 {
+				r.Port = pointer.To(route.Port)
+			}
+			if route.Subdomain != "" {
+				r.Subdomain = pointer.To(route.Subdomain)
+			}
+			config.Routes = append(config.Routes, r)
+		}
+
+		result = append(result, config)
+	}
+
+	return &result
+}
+
+func flattenPipelineGroupNetworking(input *[]azuresdkhacks.NetworkingConfiguration) []PipelineGroupNetworking {
+	result := make([]PipelineGroupNetworking, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		config := PipelineGroupNetworking{
+			ExternalNetworkingMode: string(v.ExternalNetworkingMode),
+			Host:                   pointer.From(v.Host),
+			Route:                  make([]PipelineGroupNetworkingRoute, 0),
+		}
+
+		for _, route := range v.Routes {
+			config.Route = append(config.Route, PipelineGroupNetworkingRoute{
+				Path:      pointer.From(route.Path),
+				Port:      pointer.From(route.Port),
+				Receiver:  route.Receiver,
+				Subdomain: pointer.From(route.Subdomain),
+			})
+		}
+
+		result = append(result, config)
+	}
+
+	return result
+}
@@ -0,0 +1,408 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MonitorPipelineGroupResource struct{}
+
+func (r MonitorPipelineGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParsePipelineGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Monitor.PipelineGroupsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func TestAccMonitorPipelineGroup_basic(t *testing.T) {
+	r := MonitorPipelineGroupResource{}
+	r.preCheck(t)
+
+	data := acceptance.BuildTestData(t, "azurerm_monitor_pipeline_group", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorPipelineGroup_requiresImport(t *testing.T) {
+	r := MonitorPipelineGroupResource{}
+	r.preCheck(t)
+
+	data := acceptance.BuildTestData(t, "azurerm_monitor_pipeline_group", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorPipelineGroup_update(t *testing.T) {
+	r := MonitorPipelineGroupResource{}
+	r.preCheck(t)
+
+	data := acceptance.BuildTestData(t, "azurerm_monitor_pipeline_group", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorPipelineGroup_undefinedExporter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_pipeline_group", "test")
+	r := MonitorPipelineGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.undefinedExporter(data),
+			ExpectError: regexp.MustCompile("which is not defined in an `exporter` block"),
+		},
+	})
+}
+
+func (r MonitorPipelineGroupResource) preCheck(t *testing.T) {
+	if os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_CUSTOM_LOCATION_ID` was not specified")
+	}
+}
+
+func (r MonitorPipelineGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_pipeline_group" "test" {
+  name                = "acctestmpg-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[3]q
+
+  receiver {
+    name = "syslog-receiver"
+    syslog {
+      endpoint = "0.0.0.0:514"
+    }
+  }
+
+  exporter {
+    name = "workspace-exporter"
+    azure_monitor_workspace_logs {
+      data_collection_endpoint_url      = azurerm_monitor_data_collection_endpoint.test.logs_ingestion_endpoint
+      data_collection_rule_immutable_id = azurerm_monitor_data_collection_rule.test.immutable_id
+      stream                            = "Custom-PipelineData"
+
+      record_map {
+        from = "body"
+        to   = "Body"
+      }
+
+      record_map {
+        from = "TimeGenerated"
+        to   = "TimeGenerated"
+      }
+    }
+  }
+
+  pipeline {
+    name      = "logs-pipeline"
+    receivers = ["syslog-receiver"]
+    exporters = ["workspace-exporter"]
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID"))
+}
+
+func (r MonitorPipelineGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_pipeline_group" "import" {
+  name                = azurerm_monitor_pipeline_group.test.name
+  resource_group_name = azurerm_monitor_pipeline_group.test.resource_group_name
+  location            = azurerm_monitor_pipeline_group.test.location
+  custom_location_id  = azurerm_monitor_pipeline_group.test.custom_location_id
+
+  receiver {
+    name = "syslog-receiver"
+    syslog {
+      endpoint = "0.0.0.0:514"
+    }
+  }
+
+  exporter {
+    name = "workspace-exporter"
+    azure_monitor_workspace_logs {
+      data_collection_endpoint_url      = azurerm_monitor_data_collection_endpoint.test.logs_ingestion_endpoint
+      data_collection_rule_immutable_id = azurerm_monitor_data_collection_rule.test.immutable_id
+      stream                            = "Custom-PipelineData"
+
+      record_map {
+        from = "body"
+        to   = "Body"
+      }
+    }
+  }
+
+  pipeline {
+    name      = "logs-pipeline"
+    receivers = ["syslog-receiver"]
+    exporters = ["workspace-exporter"]
+  }
+}
+`, r.basic(data))
+}
+
+func (r MonitorPipelineGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_pipeline_group" "test" {
+  name                   = "acctestmpg-%[2]d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  custom_location_id     = %[3]q
+  replicas               = 2
+  persistent_volume_name = "acctest-pv"
+
+  receiver {
+    name = "syslog-receiver"
+    syslog {
+      endpoint = "0.0.0.0:514"
+      protocol = "rfc5424"
+    }
+  }
+
+  receiver {
+    name = "otlp-receiver"
+    otlp {
+      endpoint = "0.0.0.0:4317"
+    }
+  }
+
+  processor {
+    name = "batch-processor"
+    batch {
+      batch_size              = 500
+      timeout_in_milliseconds = 2000
+    }
+  }
+
+  exporter {
+    name = "workspace-exporter"
+    azure_monitor_workspace_logs {
+      data_collection_endpoint_url      = azurerm_monitor_data_collection_endpoint.test.logs_ingestion_endpoint
+      data_collection_rule_immutable_id = azurerm_monitor_data_collection_rule.test.immutable_id
+      stream                            = "Custom-PipelineData"
+
+      record_map {
+        from = "body"
+        to   = "Body"
+      }
+
+      record_map {
+        from = "TimeGenerated"
+        to   = "TimeGenerated"
+      }
+
+      cache {
+        max_storage_usage_in_mb     = 1000
+        retention_period_in_minutes = 60
+      }
+
+      concurrency {
+        batch_queue_size = 100
+        worker_count     = 4
+      }
+    }
+  }
+
+  pipeline {
+    name       = "logs-pipeline"
+    receivers  = ["syslog-receiver", "otlp-receiver"]
+    processors = ["batch-processor"]
+    exporters  = ["workspace-exporter"]
+  }
+
+  networking {
+    route {
+      receiver = "syslog-receiver"
+      port     = 514
+    }
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_CUSTOM_LOCATION_ID"))
+}
+
+func (r MonitorPipelineGroupResource) undefinedExporter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mpg-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_monitor_pipeline_group" "test" {
+  name                = "acctestmpg-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = "/subscriptions/%[3]s/resourceGroups/acctestRG-mpg-%[1]d/providers/Microsoft.ExtendedLocation/customLocations/acctest-cl"
+
+  receiver {
+    name = "syslog-receiver"
+    syslog {
+      endpoint = "0.0.0.0:514"
+    }
+  }
+
+  exporter {
+    name = "workspace-exporter"
+    azure_monitor_workspace_logs {
+      data_collection_endpoint_url      = "https://example.westeurope-1.ingest.monitor.azure.com"
+      data_collection_rule_immutable_id = "dcr-00000000000000000000000000000000"
+      stream                            = "Custom-PipelineData"
+
+      record_map {
+        from = "body"
+        to   = "Body"
+      }
+    }
+  }
+
+  pipeline {
+    name      = "logs-pipeline"
+    receivers = ["syslog-receiver"]
+    exporters = ["missing-exporter"]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.Client().SubscriptionID)
+}
+
+func (r MonitorPipelineGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mpg-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-law-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_workspace_table_custom_log" "test" {
+  name         = "acctestmpg%[1]d_CL"
+  workspace_id = azurerm_log_analytics_workspace.test.id
+
+  column {
+    name = "TimeGenerated"
+    type = "dateTime"
+  }
+
+  column {
+    name = "Body"
+    type = "string"
+  }
+}
+
+resource "azurerm_monitor_data_collection_endpoint" "test" {
+  name                = "acctestmdce-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_monitor_data_collection_rule" "test" {
+  name                        = "acctestmdcr-%[1]d"
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = azurerm_resource_group.test.location
+  data_collection_endpoint_id = azurerm_monitor_data_collection_endpoint.test.id
+
+  destinations {
+    log_analytics {
+      workspace_resource_id = azurerm_log_analytics_workspace.test.id
+      name                  = "test-destination-log"
+    }
+  }
+
+  data_flow {
+    streams       = ["Custom-PipelineData"]
+    destinations  = ["test-destination-log"]
+    output_stream = "Custom-${azurerm_log_analytics_workspace_table_custom_log.test.name}"
+  }
+
+  stream_declaration {
+    stream_name = "Custom-PipelineData"
+    column {
+      name = "TimeGenerated"
+      type = "datetime"
+    }
+    column {
+      name = "Body"
+      type = "string"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -39,6 +39,8 @@ func (r Registration) Resources() []sdk.Resource {
 		DataCollectionRuleResource{},
 		ScheduledQueryRulesAlertV2Resource{},
 		AlertPrometheusRuleGroupResource{},
+		PipelineGroupResource{},
+		DiagnosticSettingsEnrollmentResource{},
 		WorkspaceResource{},
 	}
 }
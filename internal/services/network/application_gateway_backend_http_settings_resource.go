@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ResourceWithUpdate = ApplicationGatewayBackendHTTPSettingsResource{}
+
+type ApplicationGatewayBackendHTTPSettingsResource struct{}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) base() applicationGatewayChildResourceBase[applicationgateways.ApplicationGatewayBackendHTTPSettings] {
+	return applicationGatewayChildResourceBase[applicationgateways.ApplicationGatewayBackendHTTPSettings]{
+		resourceType: r.ResourceType(),
+		block:        "backend_http_settings",
+		newId: func(gatewayId applicationgateways.ApplicationGatewayId, name string) resourceids.Id {
+			return parse.NewBackendHttpSettingsCollectionID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, name)
+		},
+		parseId: func(input string) (*applicationgateways.ApplicationGatewayId, string, error) {
+			id, err := parse.BackendHttpSettingsCollectionID(input)
+			if err != nil {
+				return nil, "", err
+			}
+			return pointer.To(applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)), id.BackendHttpSettingsCollectionName, nil
+		},
+		entries: func(props *applicationgateways.ApplicationGatewayPropertiesFormat) **[]applicationgateways.ApplicationGatewayBackendHTTPSettings {
+			return &props.BackendHTTPSettingsCollection
+		},
+		name: func(v applicationgateways.ApplicationGatewayBackendHTTPSettings) *string {
+			return v.Name
+		},
+		expand: func(input []interface{}, gatewayId string) (*[]applicationgateways.ApplicationGatewayBackendHTTPSettings, error) {
+			return expandApplicationGatewayBackendHTTPSettings(input, gatewayId), nil
+		},
+		flatten: func(input *[]applicationgateways.ApplicationGatewayBackendHTTPSettings) ([]interface{}, error) {
+			return flattenApplicationGatewayBackendHTTPSettings(input)
+		},
+	}
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.base().arguments()
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) Attributes() map[string]*pluginsdk.Schema {
+	return r.base().attributes()
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) ModelObject() interface{} {
+	return nil
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) ResourceType() string {
+	return "azurerm_application_gateway_backend_http_settings"
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.BackendHttpSettingsCollectionID
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) Create() sdk.ResourceFunc {
+	return r.base().createFunc()
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) Read() sdk.ResourceFunc {
+	return r.base().readFunc()
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) Update() sdk.ResourceFunc {
+	return r.base().updateFunc()
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) Delete() sdk.ResourceFunc {
+	return r.base().deleteFunc()
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApplicationGatewayBackendHTTPSettingsResource struct{}
+
+func TestAccApplicationGatewayBackendHTTPSettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_updateApplicationGateway(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// updating the Application Gateway must not remove the entry managed by this resource
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackendHttpSettingsCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	resp, err := client.Network.ApplicationGateways.Get(ctx, gatewayId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.BackendHTTPSettingsCollection != nil {
+		for _, v := range *model.Properties.BackendHTTPSettingsCollection {
+			if strings.EqualFold(pointer.From(v.Name), id.BackendHttpSettingsCollectionName) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) basic(data acceptance.TestData, tag string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "test" {
+  application_gateway_id = azurerm_application_gateway.test.id
+  name                   = "acctest-settings"
+  cookie_based_affinity  = "Disabled"
+  port                   = 8080
+  protocol               = "Http"
+}
+`, ApplicationGatewayResource{}.childResourceManagement(data, tag))
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "import" {
+  application_gateway_id = azurerm_application_gateway.test.id
+  name                   = "acctest-settings"
+  cookie_based_affinity  = "Disabled"
+  port                   = 8080
+  protocol               = "Http"
+}
+`, r.basic(data, "first"))
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "test" {
+  application_gateway_id              = azurerm_application_gateway.test.id
+  name                                = "acctest-settings"
+  cookie_based_affinity               = "Enabled"
+  affinity_cookie_name                = "acctestcookie"
+  port                                = 8080
+  protocol                            = "Http"
+  path                                = "/app/"
+  request_timeout                     = 60
+  pick_host_name_from_backend_address = true
+
+  connection_draining {
+    enabled           = true
+    drain_timeout_sec = 60
+  }
+}
+`, ApplicationGatewayResource{}.childResourceManagement(data, "first"))
+}
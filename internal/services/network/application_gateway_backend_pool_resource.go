@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ResourceWithUpdate = ApplicationGatewayBackendPoolResource{}
+
+type ApplicationGatewayBackendPoolResource struct{}
+
+func (r ApplicationGatewayBackendPoolResource) base() applicationGatewayChildResourceBase[applicationgateways.ApplicationGatewayBackendAddressPool] {
+	return applicationGatewayChildResourceBase[applicationgateways.ApplicationGatewayBackendAddressPool]{
+		resourceType: r.ResourceType(),
+		block:        "backend_address_pool",
+		newId: func(gatewayId applicationgateways.ApplicationGatewayId, name string) resourceids.Id {
+			return parse.NewBackendAddressPoolID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, name)
+		},
+		parseId: func(input string) (*applicationgateways.ApplicationGatewayId, string, error) {
+			id, err := parse.BackendAddressPoolID(input)
+			if err != nil {
+				return nil, "", err
+			}
+			return pointer.To(applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)), id.Name, nil
+		},
+		entries: func(props *applicationgateways.ApplicationGatewayPropertiesFormat) **[]applicationgateways.ApplicationGatewayBackendAddressPool {
+			return &props.BackendAddressPools
+		},
+		name: func(v applicationgateways.ApplicationGatewayBackendAddressPool) *string {
+			return v.Name
+		},
+		expand: func(input []interface{}, _ string) (*[]applicationgateways.ApplicationGatewayBackendAddressPool, error) {
+			return expandApplicationGatewayBackendAddressPools(input), nil
+		},
+		flatten: func(input *[]applicationgateways.ApplicationGatewayBackendAddressPool) ([]interface{}, error) {
+			return flattenApplicationGatewayBackendAddressPools(input), nil
+		},
+	}
+}
+
+func (r ApplicationGatewayBackendPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.base().arguments()
+}
+
+func (r ApplicationGatewayBackendPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return r.base().attributes()
+}
+
+func (r ApplicationGatewayBackendPoolResource) ModelObject() interface{} {
+	return nil
+}
+
+func (r ApplicationGatewayBackendPoolResource) ResourceType() string {
+	return "azurerm_application_gateway_backend_pool"
+}
+
+func (r ApplicationGatewayBackendPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.BackendAddressPoolID
+}
+
+func (r ApplicationGatewayBackendPoolResource) Create() sdk.ResourceFunc {
+	return r.base().createFunc()
+}
+
+func (r ApplicationGatewayBackendPoolResource) Read() sdk.ResourceFunc {
+	return r.base().readFunc()
+}
+
+func (r ApplicationGatewayBackendPoolResource) Update() sdk.ResourceFunc {
+	return r.base().updateFunc()
+}
+
+func (r ApplicationGatewayBackendPoolResource) Delete() sdk.ResourceFunc {
+	return r.base().deleteFunc()
+}
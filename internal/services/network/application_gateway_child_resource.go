@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// applicationGatewayChildResourceBase contains the behaviour shared by the resources which each manage a single entry
+// of type T within one of the blocks of `azurerm_application_gateway`. Since the entries are only available as part of
+// the Application Gateway, each operation retrieves the Application Gateway, changes the entry and writes the whole
+// Application Gateway back.
+type applicationGatewayChildResourceBase[T any] struct {
+	// resourceType is the name of the child resource, e.g. `azurerm_application_gateway_probe`
+	resourceType string
+
+	// block is the name of the block within `azurerm_application_gateway` from which the schema is taken
+	block string
+
+	// newId returns the ID of the entry with the specified name
+	newId func(gatewayId applicationgateways.ApplicationGatewayId, name string) resourceids.Id
+
+	// parseId returns the ID of the Application Gateway and the name of the entry from the ID of the child resource
+	parseId func(input string) (*applicationgateways.ApplicationGatewayId, string, error)
+
+	// entries returns a pointer to the field holding the entries within the Application Gateway properties
+	entries func(props *applicationgateways.ApplicationGatewayPropertiesFormat) **[]T
+
+	name    func(T) *string
+	expand  func(input []interface{}, gatewayId string) (*[]T, error)
+	flatten func(input *[]T) ([]interface{}, error)
+}
+
+// schema returns the schema of the child resource, which is taken from `azurerm_application_gateway` so that both
+// resources stay consistent
+func (br applicationGatewayChildResourceBase[T]) schema() map[string]*pluginsdk.Schema {
+	out := map[string]*pluginsdk.Schema{
+		"application_gateway_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: applicationgateways.ValidateApplicationGatewayID,
+		},
+	}
+
+	for k, v := range resourceApplicationGateway().Schema[br.block].Elem.(*pluginsdk.Resource).Schema {
+		// the ID of the entry is exposed as the ID of the resource
+		if k == "id" {
+			continue
+		}
+		out[k] = v
+	}
+
+	out["name"].ForceNew = true
+
+	return out
+}
+
+func (br applicationGatewayChildResourceBase[T]) arguments() map[string]*pluginsdk.Schema {
+	out := make(map[string]*pluginsdk.Schema)
+	for k, v := range br.schema() {
+		if v.Required || v.Optional {
+			out[k] = v
+		}
+	}
+
+	return out
+}
+
+func (br applicationGatewayChildResourceBase[T]) attributes() map[string]*pluginsdk.Schema {
+	out := make(map[string]*pluginsdk.Schema)
+	for k, v := range br.schema() {
+		if !v.Required && !v.Optional {
+			out[k] = v
+		}
+	}
+
+	return out
+}
+
+func (br applicationGatewayChildResourceBase[T]) createFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGateways
+
+			gatewayId, err := applicationgateways.ParseApplicationGatewayID(metadata.ResourceData.Get("application_gateway_id").(string))
+			if err != nil {
+				return err
+			}
+
+			id := br.newId(*gatewayId, metadata.ResourceData.Get("name").(string))
+
+			locks.ByID(gatewayId.ID())
+			defer locks.UnlockByID(gatewayId.ID())
+
+			entry, err := br.expandEntry(metadata.ResourceData, *gatewayId)
+			if err != nil {
+				return fmt.Errorf("expanding %s: %+v", id, err)
+			}
+
+			err = br.updateApplicationGateway(ctx, client, *gatewayId, func(entries []T) ([]T, error) {
+				if helpers.ExistsByName(entries, pointer.From(br.name(*entry)), br.name) {
+					return nil, metadata.ResourceRequiresImport(br.resourceType, id)
+				}
+				return append(entries, *entry), nil
+			})
+			if err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (br applicationGatewayChildResourceBase[T]) readFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGateways
+
+			gatewayId, name, err := br.parseId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := br.newId(*gatewayId, name)
+
+			resp, err := client.Get(ctx, *gatewayId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *gatewayId, err)
+			}
+
+			var flattened []interface{}
+			if model := resp.Model; model != nil && model.Properties != nil {
+				flattened, err = br.flatten(*br.entries(model.Properties))
+				if err != nil {
+					return fmt.Errorf("flattening `%s`: %+v", br.block, err)
+				}
+			}
+
+			entry := helpers.FindFlattenedByName(flattened, name)
+			if entry == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			if err := helpers.SetEntry(metadata.ResourceData, br.schema(), entry, "application_gateway_id"); err != nil {
+				return err
+			}
+
+			metadata.ResourceData.Set("name", name)
+			metadata.ResourceData.Set("application_gateway_id", gatewayId.ID())
+
+			return nil
+		},
+	}
+}
+
+func (br applicationGatewayChildResourceBase[T]) updateFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGateways
+
+			gatewayId, name, err := br.parseId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := br.newId(*gatewayId, name)
+
+			locks.ByID(gatewayId.ID())
+			defer locks.UnlockByID(gatewayId.ID())
+
+			entry, err := br.expandEntry(metadata.ResourceData, *gatewayId)
+			if err != nil {
+				return fmt.Errorf("expanding %s: %+v", id, err)
+			}
+
+			return br.updateApplicationGateway(ctx, client, *gatewayId, func(entries []T) ([]T, error) {
+				return helpers.UpsertByName(entries, *entry, br.name), nil
+			})
+		},
+	}
+}
+
+func (br applicationGatewayChildResourceBase[T]) deleteFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ApplicationGateways
+
+			gatewayId, name, err := br.parseId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(gatewayId.ID())
+			defer locks.UnlockByID(gatewayId.ID())
+
+			return br.updateApplicationGateway(ctx, client, *gatewayId, func(entries []T) ([]T, error) {
+				return helpers.RemoveByName(entries, name, br.name), nil
+			})
+		},
+	}
+}
+
+// expandEntry expands the configuration of the child resource using the expand function of
+// `azurerm_application_gateway`, since the fields of both are the same
+func (br applicationGatewayChildResourceBase[T]) expandEntry(d *pluginsdk.ResourceData, gatewayId applicationgateways.ApplicationGatewayId) (*T, error) {
+	expanded, err := br.expand([]interface{}{helpers.ExpandEntry(d, br.schema(), "application_gateway_id")}, gatewayId.ID())
+	if err != nil {
+		return nil, err
+	}
+	if expanded == nil || len(*expanded) != 1 {
+		return nil, fmt.Errorf("expected a single `%s` to be expanded", br.block)
+	}
+
+	return pointer.To((*expanded)[0]), nil
+}
+
+// updateApplicationGateway retrieves the Application Gateway, applies the specified update to the entries of the block
+// and then writes it back. Callers are expected to hold a lock on the Application Gateway ID.
+func (br applicationGatewayChildResourceBase[T]) updateApplicationGateway(ctx context.Context, client *applicationgateways.ApplicationGatewaysClient, id applicationgateways.ApplicationGatewayId, update func(entries []T) ([]T, error)) error {
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+	if existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	field := br.entries(existing.Model.Properties)
+	entries, err := update(pointer.From(*field))
+	if err != nil {
+		return err
+	}
+	*field = &entries
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, *existing.Model); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ResourceWithUpdate = ApplicationGatewayListenerResource{}
+
+type ApplicationGatewayListenerResource struct{}
+
+func (r ApplicationGatewayListenerResource) base() applicationGatewayChildResourceBase[applicationgateways.ApplicationGatewayHTTPListener] {
+	return applicationGatewayChildResourceBase[applicationgateways.ApplicationGatewayHTTPListener]{
+		resourceType: r.ResourceType(),
+		block:        "http_listener",
+		newId: func(gatewayId applicationgateways.ApplicationGatewayId, name string) resourceids.Id {
+			return parse.NewHttpListenerID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, name)
+		},
+		parseId: func(input string) (*applicationgateways.ApplicationGatewayId, string, error) {
+			id, err := parse.HttpListenerID(input)
+			if err != nil {
+				return nil, "", err
+			}
+			return pointer.To(applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)), id.Name, nil
+		},
+		entries: func(props *applicationgateways.ApplicationGatewayPropertiesFormat) **[]applicationgateways.ApplicationGatewayHTTPListener {
+			return &props.HTTPListeners
+		},
+		name: func(v applicationgateways.ApplicationGatewayHTTPListener) *string {
+			return v.Name
+		},
+		expand: func(input []interface{}, gatewayId string) (*[]applicationgateways.ApplicationGatewayHTTPListener, error) {
+			return expandApplicationGatewayHTTPListeners(input, gatewayId)
+		},
+		flatten: func(input *[]applicationgateways.ApplicationGatewayHTTPListener) ([]interface{}, error) {
+			return flattenApplicationGatewayHTTPListeners(input)
+		},
+	}
+}
+
+func (r ApplicationGatewayListenerResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.base().arguments()
+}
+
+func (r ApplicationGatewayListenerResource) Attributes() map[string]*pluginsdk.Schema {
+	return r.base().attributes()
+}
+
+func (r ApplicationGatewayListenerResource) ModelObject() interface{} {
+	return nil
+}
+
+func (r ApplicationGatewayListenerResource) ResourceType() string {
+	return "azurerm_application_gateway_listener"
+}
+
+func (r ApplicationGatewayListenerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.HttpListenerID
+}
+
+func (r ApplicationGatewayListenerResource) Create() sdk.ResourceFunc {
+	return r.base().createFunc()
+}
+
+func (r ApplicationGatewayListenerResource) Read() sdk.ResourceFunc {
+	return r.base().readFunc()
+}
+
+func (r ApplicationGatewayListenerResource) Update() sdk.ResourceFunc {
+	return r.base().updateFunc()
+}
+
+func (r ApplicationGatewayListenerResource) Delete() sdk.ResourceFunc {
+	return r.base().deleteFunc()
+}
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApplicationGatewayListenerResource struct{}
+
+func TestAccApplicationGatewayListener_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_listener", "test")
+	r := ApplicationGatewayListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayListener_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_listener", "test")
+	r := ApplicationGatewayListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayListener_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_listener", "test")
+	r := ApplicationGatewayListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayListener_updateApplicationGateway(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_listener", "test")
+	r := ApplicationGatewayListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// updating the Application Gateway must not remove the entry managed by this resource
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationGatewayListenerResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.HttpListenerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	resp, err := client.Network.ApplicationGateways.Get(ctx, gatewayId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.HTTPListeners != nil {
+		for _, v := range *model.Properties.HTTPListeners {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r ApplicationGatewayListenerResource) basic(data acceptance.TestData, tag string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_listener" "test" {
+  application_gateway_id         = azurerm_application_gateway.test.id
+  name                           = "acctest-listener"
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = "${local.frontend_port_name}-child"
+  protocol                       = "Http"
+}
+`, ApplicationGatewayResource{}.childResourceManagement(data, tag))
+}
+
+func (r ApplicationGatewayListenerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_listener" "import" {
+  application_gateway_id         = azurerm_application_gateway.test.id
+  name                           = "acctest-listener"
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = "${local.frontend_port_name}-child"
+  protocol                       = "Http"
+}
+`, r.basic(data, "first"))
+}
+
+func (r ApplicationGatewayListenerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_listener" "test" {
+  application_gateway_id         = azurerm_application_gateway.test.id
+  name                           = "acctest-listener"
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = "${local.frontend_port_name}-child"
+  protocol                       = "Http"
+  host_names                     = ["app.example.com", "www.app.example.com"]
+
+  custom_error_configuration {
+    status_code           = "HttpStatus403"
+    custom_error_page_url = "https://example.com/403.html"
+  }
+}
+`, ApplicationGatewayResource{}.childResourceManagement(data, "first"))
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ResourceWithUpdate = ApplicationGatewayProbeResource{}
+
+type ApplicationGatewayProbeResource struct{}
+
+func (r ApplicationGatewayProbeResource) base() applicationGatewayChildResourceBase[applicationgateways.ApplicationGatewayProbe] {
+	return applicationGatewayChildResourceBase[applicationgateways.ApplicationGatewayProbe]{
+		resourceType: r.ResourceType(),
+		block:        "probe",
+		newId: func(gatewayId applicationgateways.ApplicationGatewayId, name string) resourceids.Id {
+			return parse.NewProbeID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, name)
+		},
+		parseId: func(input string) (*applicationgateways.ApplicationGatewayId, string, error) {
+			id, err := parse.ProbeID(input)
+			if err != nil {
+				return nil, "", err
+			}
+			return pointer.To(applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)), id.Name, nil
+		},
+		entries: func(props *applicationgateways.ApplicationGatewayPropertiesFormat) **[]applicationgateways.ApplicationGatewayProbe {
+			return &props.Probes
+		},
+		name: func(v applicationgateways.ApplicationGatewayProbe) *string {
+			return v.Name
+		},
+		expand: func(input []interface{}, _ string) (*[]applicationgateways.ApplicationGatewayProbe, error) {
+			return expandApplicationGatewayProbes(input), nil
+		},
+		flatten: func(input *[]applicationgateways.ApplicationGatewayProbe) ([]interface{}, error) {
+			return flattenApplicationGatewayProbes(input), nil
+		},
+	}
+}
+
+func (r ApplicationGatewayProbeResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.base().arguments()
+}
+
+func (r ApplicationGatewayProbeResource) Attributes() map[string]*pluginsdk.Schema {
+	return r.base().attributes()
+}
+
+func (r ApplicationGatewayProbeResource) ModelObject() interface{} {
+	return nil
+}
+
+func (r ApplicationGatewayProbeResource) ResourceType() string {
+	return "azurerm_application_gateway_probe"
+}
+
+func (r ApplicationGatewayProbeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ProbeID
+}
+
+func (r ApplicationGatewayProbeResource) Create() sdk.ResourceFunc {
+	return r.base().createFunc()
+}
+
+func (r ApplicationGatewayProbeResource) Read() sdk.ResourceFunc {
+	return r.base().readFunc()
+}
+
+func (r ApplicationGatewayProbeResource) Update() sdk.ResourceFunc {
+	return r.base().updateFunc()
+}
+
+func (r ApplicationGatewayProbeResource) Delete() sdk.ResourceFunc {
+	return r.base().deleteFunc()
+}
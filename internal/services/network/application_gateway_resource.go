@@ -29,7 +29,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -112,11 +114,19 @@ func sslProfileSchema(computed bool) *pluginsdk.Schema {
 
 func resourceApplicationGateway() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
-		Create:   resourceApplicationGatewayCreate,
-		Read:     resourceApplicationGatewayRead,
-		Update:   resourceApplicationGatewayUpdate,
-		Delete:   resourceApplicationGatewayDelete,
-		Importer: pluginsdk.ImporterValidatingIdentity(&applicationgateways.ApplicationGatewayId{}),
+		Create: resourceApplicationGatewayCreate,
+		Read:   resourceApplicationGatewayRead,
+		Update: resourceApplicationGatewayUpdate,
+		Delete: resourceApplicationGatewayDelete,
+
+		// `child_resource_management_enabled` is only stored in the state, so it can't be determined when importing - in
+		// which case it's `false` and all entries are tracked, including those managed by the child resources
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&applicationgateways.ApplicationGatewayId{}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			if err := d.Set("child_resource_management_enabled", false); err != nil {
+				return nil, fmt.Errorf("setting `child_resource_management_enabled`: %+v", err)
+			}
+			return []*pluginsdk.ResourceData{d}, nil
+		}),
 
 		Identity: &schema.ResourceIdentity{
 			SchemaFunc: pluginsdk.GenerateIdentitySchema(&applicationgateways.ApplicationGatewayId{}),
@@ -717,6 +727,12 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				},
 			},
 
+			"child_resource_management_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"fips_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -1849,7 +1865,7 @@ func resourceApplicationGatewayCreate(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("expanding `trusted_root_certificate`: %+v", err)
 	}
 
-	requestRoutingRules, err := expandApplicationGatewayRequestRoutingRules(d, id.ID())
+	requestRoutingRules, err := expandApplicationGatewayRequestRoutingRules(d.Get("request_routing_rule").(*pluginsdk.Set).List(), id.ID())
 	if err != nil {
 		return fmt.Errorf("expanding `request_routing_rule`: %+v", err)
 	}
@@ -1880,12 +1896,12 @@ func resourceApplicationGatewayCreate(d *pluginsdk.ResourceData, meta interface{
 
 	globalConfiguration := expandApplicationGatewayGlobalConfiguration(d.Get("global").([]interface{}))
 
-	httpListeners, err := expandApplicationGatewayHTTPListeners(d, id.ID())
+	httpListeners, err := expandApplicationGatewayHTTPListeners(d.Get("http_listener").(*schema.Set).List(), id.ID())
 	if err != nil {
 		return fmt.Errorf("expanding `http_listener`: %+v", err)
 	}
 
-	rewriteRuleSets, err := expandApplicationGatewayRewriteRuleSets(d)
+	rewriteRuleSets, err := expandApplicationGatewayRewriteRuleSets(d.Get("rewrite_rule_set").([]interface{}))
 	if err != nil {
 		return fmt.Errorf("expanding `rewrite_rule_set`: %v", err)
 	}
@@ -1898,7 +1914,7 @@ func resourceApplicationGatewayCreate(d *pluginsdk.ResourceData, meta interface{
 			AuthenticationCertificates:    expandApplicationGatewayAuthenticationCertificates(d.Get("authentication_certificate").([]interface{})),
 			TrustedRootCertificates:       trustedRootCertificates,
 			CustomErrorConfigurations:     expandApplicationGatewayCustomErrorConfigurations(d.Get("custom_error_configuration").([]interface{})),
-			BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d),
+			BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d.Get("backend_address_pool").(*schema.Set).List()),
 			BackendHTTPSettingsCollection: expandApplicationGatewayBackendHTTPSettings(d.Get("backend_http_settings").(*schema.Set).List(), id.ID()),
 			BackendSettingsCollection:     expandApplicationGatewayBackendSettings(d.Get("backend").([]interface{}), id),
 			EnableHTTP2:                   pointer.To(http2Enabled),
@@ -1986,6 +2002,9 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	existing, err := client.Get(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -1997,6 +2016,19 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 
 	payload := existing.Model
 
+	// entries which have never been defined within this resource may be managed by the child resources and are retained,
+	// whereas entries which were previously defined within this resource but have been removed from it are deleted. When
+	// `child_resource_management_enabled` has just been enabled all entries were previously tracked, including those
+	// about to be managed by the child resources, so none are deleted.
+	childResourceManagementEnabled := d.Get("child_resource_management_enabled").(bool)
+	previouslyDefined := func(block string) map[string]struct{} {
+		if oldEnabled, _ := d.GetChange("child_resource_management_enabled"); !oldEnabled.(bool) {
+			return nil
+		}
+		old, _ := d.GetChange(block)
+		return helpers.FlattenedNames(old)
+	}
+
 	if d.HasChange("tags") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
@@ -2027,10 +2059,15 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	if d.HasChange("request_routing_rule") {
-		requestRoutingRules, err := expandApplicationGatewayRequestRoutingRules(d, id.ID())
+		requestRoutingRules, err := expandApplicationGatewayRequestRoutingRules(d.Get("request_routing_rule").(*pluginsdk.Set).List(), id.ID())
 		if err != nil {
 			return fmt.Errorf("expanding `request_routing_rule`: %+v", err)
 		}
+		if childResourceManagementEnabled {
+			requestRoutingRules = pointer.To(helpers.MergeByName(pointer.From(requestRoutingRules), pointer.From(payload.Properties.RequestRoutingRules), previouslyDefined("request_routing_rule"), func(v applicationgateways.ApplicationGatewayRequestRoutingRule) *string {
+				return v.Name
+			}))
+		}
 		payload.Properties.RequestRoutingRules = requestRoutingRules
 	}
 
@@ -2089,10 +2126,15 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	if d.HasChange("http_listener") {
-		httpListeners, err := expandApplicationGatewayHTTPListeners(d, id.ID())
+		httpListeners, err := expandApplicationGatewayHTTPListeners(d.Get("http_listener").(*schema.Set).List(), id.ID())
 		if err != nil {
 			return fmt.Errorf("expanding `http_listener`: %+v", err)
 		}
+		if childResourceManagementEnabled {
+			httpListeners = pointer.To(helpers.MergeByName(pointer.From(httpListeners), pointer.From(payload.Properties.HTTPListeners), previouslyDefined("http_listener"), func(v applicationgateways.ApplicationGatewayHTTPListener) *string {
+				return v.Name
+			}))
+		}
 
 		payload.Properties.HTTPListeners = httpListeners
 	}
@@ -2102,10 +2144,15 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	if d.HasChange("rewrite_rule_set") {
-		rewriteRuleSets, err := expandApplicationGatewayRewriteRuleSets(d)
+		rewriteRuleSets, err := expandApplicationGatewayRewriteRuleSets(d.Get("rewrite_rule_set").([]interface{}))
 		if err != nil {
 			return fmt.Errorf("expanding `rewrite_rule_set`: %v", err)
 		}
+		if childResourceManagementEnabled {
+			rewriteRuleSets = pointer.To(helpers.MergeByName(pointer.From(rewriteRuleSets), pointer.From(payload.Properties.RewriteRuleSets), previouslyDefined("rewrite_rule_set"), func(v applicationgateways.ApplicationGatewayRewriteRuleSet) *string {
+				return v.Name
+			}))
+		}
 
 		payload.Properties.RewriteRuleSets = rewriteRuleSets
 	}
@@ -2123,11 +2170,23 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	if d.HasChange("backend_address_pool") {
-		payload.Properties.BackendAddressPools = expandApplicationGatewayBackendAddressPools(d)
+		backendAddressPools := expandApplicationGatewayBackendAddressPools(d.Get("backend_address_pool").(*schema.Set).List())
+		if childResourceManagementEnabled {
+			backendAddressPools = pointer.To(helpers.MergeByName(pointer.From(backendAddressPools), pointer.From(payload.Properties.BackendAddressPools), previouslyDefined("backend_address_pool"), func(v applicationgateways.ApplicationGatewayBackendAddressPool) *string {
+				return v.Name
+			}))
+		}
+		payload.Properties.BackendAddressPools = backendAddressPools
 	}
 
 	if d.HasChange("backend_http_settings") {
-		payload.Properties.BackendHTTPSettingsCollection = expandApplicationGatewayBackendHTTPSettings(d.Get("backend_http_settings").(*schema.Set).List(), id.ID())
+		backendHTTPSettings := expandApplicationGatewayBackendHTTPSettings(d.Get("backend_http_settings").(*schema.Set).List(), id.ID())
+		if childResourceManagementEnabled {
+			backendHTTPSettings = pointer.To(helpers.MergeByName(pointer.From(backendHTTPSettings), pointer.From(payload.Properties.BackendHTTPSettingsCollection), previouslyDefined("backend_http_settings"), func(v applicationgateways.ApplicationGatewayBackendHTTPSettings) *string {
+				return v.Name
+			}))
+		}
+		payload.Properties.BackendHTTPSettingsCollection = backendHTTPSettings
 	}
 
 	if d.HasChange("backend") {
@@ -2148,6 +2207,11 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 
 	if d.HasChange("probe") {
 		probes := expandApplicationGatewayProbes(d.Get("probe").(*schema.Set).List())
+		if childResourceManagementEnabled {
+			probes = pointer.To(helpers.MergeByName(pointer.From(probes), pointer.From(payload.Properties.Probes), previouslyDefined("probe"), func(v applicationgateways.ApplicationGatewayProbe) *string {
+				return v.Name
+			}))
+		}
 
 		payload.Properties.Probes = probes
 	}
@@ -2252,6 +2316,18 @@ func resourceApplicationGatewaySetFlatten(d *pluginsdk.ResourceData, id *applica
 	d.Set("name", id.ApplicationGatewayName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
+	childResourceManagementEnabled := d.Get("child_resource_management_enabled").(bool)
+	d.Set("child_resource_management_enabled", childResourceManagementEnabled)
+
+	// when child resources are in use only the entries defined within this resource are tracked, since the remaining
+	// entries are managed by the child resources
+	filterChildResourceEntries := func(block string, input []interface{}) []interface{} {
+		if !childResourceManagementEnabled {
+			return input
+		}
+		return helpers.FilterFlattenedByName(input, helpers.FlattenedNames(d.Get(block)))
+	}
+
 	if model != nil {
 		d.Set("location", location.NormalizeNilable(model.Location))
 		d.Set("zones", zones.FlattenUntyped(model.Zones))
@@ -2273,7 +2349,7 @@ func resourceApplicationGatewaySetFlatten(d *pluginsdk.ResourceData, id *applica
 				return fmt.Errorf("setting `trusted_root_certificate`: %+v", err)
 			}
 
-			if setErr := d.Set("backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools)); setErr != nil {
+			if setErr := d.Set("backend_address_pool", filterChildResourceEntries("backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools))); setErr != nil {
 				return fmt.Errorf("setting `backend_address_pool`: %+v", setErr)
 			}
 
@@ -2281,7 +2357,7 @@ func resourceApplicationGatewaySetFlatten(d *pluginsdk.ResourceData, id *applica
 			if err != nil {
 				return fmt.Errorf("flattening `backend_http_settings`: %+v", err)
 			}
-			if setErr := d.Set("backend_http_settings", backendHttpSettings); setErr != nil {
+			if setErr := d.Set("backend_http_settings", filterChildResourceEntries("backend_http_settings", backendHttpSettings)); setErr != nil {
 				return fmt.Errorf("setting `backend_http_settings`: %+v", setErr)
 			}
 
@@ -2308,7 +2384,7 @@ func resourceApplicationGatewaySetFlatten(d *pluginsdk.ResourceData, id *applica
 			if err != nil {
 				return fmt.Errorf("flattening `http_listener`: %+v", err)
 			}
-			if setErr := d.Set("http_listener", httpListeners); setErr != nil {
+			if setErr := d.Set("http_listener", filterChildResourceEntries("http_listener", httpListeners)); setErr != nil {
 				return fmt.Errorf("setting `http_listener`: %+v", setErr)
 			}
 
@@ -2348,7 +2424,7 @@ func resourceApplicationGatewaySetFlatten(d *pluginsdk.ResourceData, id *applica
 				return fmt.Errorf("setting `private_link_configuration`: %+v", setErr)
 			}
 
-			if setErr := d.Set("probe", flattenApplicationGatewayProbes(props.Probes)); setErr != nil {
+			if setErr := d.Set("probe", filterChildResourceEntries("probe", flattenApplicationGatewayProbes(props.Probes))); setErr != nil {
 				return fmt.Errorf("setting `probe`: %+v", setErr)
 			}
 
@@ -2356,7 +2432,7 @@ func resourceApplicationGatewaySetFlatten(d *pluginsdk.ResourceData, id *applica
 			if err != nil {
 				return fmt.Errorf("flattening `request_routing_rule`: %+v", err)
 			}
-			if setErr := d.Set("request_routing_rule", requestRoutingRules); setErr != nil {
+			if setErr := d.Set("request_routing_rule", filterChildResourceEntries("request_routing_rule", requestRoutingRules)); setErr != nil {
 				return fmt.Errorf("setting `request_routing_rule`: %+v", setErr)
 			}
 
@@ -2377,7 +2453,7 @@ func resourceApplicationGatewaySetFlatten(d *pluginsdk.ResourceData, id *applica
 			}
 
 			rewriteRuleSets := flattenApplicationGatewayRewriteRuleSets(props.RewriteRuleSets)
-			if setErr := d.Set("rewrite_rule_set", rewriteRuleSets); setErr != nil {
+			if setErr := d.Set("rewrite_rule_set", filterChildResourceEntries("rewrite_rule_set", rewriteRuleSets)); setErr != nil {
 				return fmt.Errorf("setting `rewrite_rule_set`: %+v", setErr)
 			}
 
@@ -2595,8 +2671,7 @@ func flattenApplicationGatewayTrustedRootCertificates(certs *[]applicationgatewa
 	return results
 }
 
-func expandApplicationGatewayBackendAddressPools(d *pluginsdk.ResourceData) *[]applicationgateways.ApplicationGatewayBackendAddressPool {
-	vs := d.Get("backend_address_pool").(*schema.Set).List()
+func expandApplicationGatewayBackendAddressPools(vs []interface{}) *[]applicationgateways.ApplicationGatewayBackendAddressPool {
 	results := make([]applicationgateways.ApplicationGatewayBackendAddressPool, 0)
 
 	for _, raw := range vs {
@@ -3074,9 +3149,7 @@ func flattenApplicationGatewaySslPolicy(input *applicationgateways.ApplicationGa
 	return results
 }
 
-func expandApplicationGatewayHTTPListeners(d *pluginsdk.ResourceData, gatewayID string) (*[]applicationgateways.ApplicationGatewayHTTPListener, error) {
-	vs := d.Get("http_listener").(*schema.Set).List()
-
+func expandApplicationGatewayHTTPListeners(vs []interface{}, gatewayID string) (*[]applicationgateways.ApplicationGatewayHTTPListener, error) {
 	results := make([]applicationgateways.ApplicationGatewayHTTPListener, 0)
 
 	for _, raw := range vs {
@@ -3861,8 +3934,7 @@ func flattenApplicationGatewayPrivateLinkConfigurations(input *[]applicationgate
 	return plConfigResults
 }
 
-func expandApplicationGatewayRequestRoutingRules(d *pluginsdk.ResourceData, gatewayID string) (*[]applicationgateways.ApplicationGatewayRequestRoutingRule, error) {
-	vs := d.Get("request_routing_rule").(*pluginsdk.Set).List()
+func expandApplicationGatewayRequestRoutingRules(vs []interface{}, gatewayID string) (*[]applicationgateways.ApplicationGatewayRequestRoutingRule, error) {
 	results := make([]applicationgateways.ApplicationGatewayRequestRoutingRule, 0)
 	priorityset := false
 
@@ -4125,8 +4197,7 @@ func flattenApplicationGatewayRoutingRules(input *[]applicationgateways.Applicat
 	return results, nil
 }
 
-func expandApplicationGatewayRewriteRuleSets(d *pluginsdk.ResourceData) (*[]applicationgateways.ApplicationGatewayRewriteRuleSet, error) {
-	vs := d.Get("rewrite_rule_set").([]interface{})
+func expandApplicationGatewayRewriteRuleSets(vs []interface{}) (*[]applicationgateways.ApplicationGatewayRewriteRuleSet, error) {
 	ruleSets := make([]applicationgateways.ApplicationGatewayRewriteRuleSet, 0)
 
 	for _, raw := range vs {
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ResourceWithUpdate = ApplicationGatewayRewriteRuleSetResource{}
+
+type ApplicationGatewayRewriteRuleSetResource struct{}
+
+func (r ApplicationGatewayRewriteRuleSetResource) base() applicationGatewayChildResourceBase[applicationgateways.ApplicationGatewayRewriteRuleSet] {
+	return applicationGatewayChildResourceBase[applicationgateways.ApplicationGatewayRewriteRuleSet]{
+		resourceType: r.ResourceType(),
+		block:        "rewrite_rule_set",
+		newId: func(gatewayId applicationgateways.ApplicationGatewayId, name string) resourceids.Id {
+			return parse.NewRewriteRuleSetID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, name)
+		},
+		parseId: func(input string) (*applicationgateways.ApplicationGatewayId, string, error) {
+			id, err := parse.RewriteRuleSetID(input)
+			if err != nil {
+				return nil, "", err
+			}
+			return pointer.To(applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)), id.Name, nil
+		},
+		entries: func(props *applicationgateways.ApplicationGatewayPropertiesFormat) **[]applicationgateways.ApplicationGatewayRewriteRuleSet {
+			return &props.RewriteRuleSets
+		},
+		name: func(v applicationgateways.ApplicationGatewayRewriteRuleSet) *string {
+			return v.Name
+		},
+		expand: func(input []interface{}, _ string) (*[]applicationgateways.ApplicationGatewayRewriteRuleSet, error) {
+			return expandApplicationGatewayRewriteRuleSets(input)
+		},
+		flatten: func(input *[]applicationgateways.ApplicationGatewayRewriteRuleSet) ([]interface{}, error) {
+			return flattenApplicationGatewayRewriteRuleSets(input), nil
+		},
+	}
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.base().arguments()
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) Attributes() map[string]*pluginsdk.Schema {
+	return r.base().attributes()
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) ModelObject() interface{} {
+	return nil
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) ResourceType() string {
+	return "azurerm_application_gateway_rewrite_rule_set"
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.RewriteRuleSetID
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) Create() sdk.ResourceFunc {
+	return r.base().createFunc()
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) Read() sdk.ResourceFunc {
+	return r.base().readFunc()
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) Update() sdk.ResourceFunc {
+	return r.base().updateFunc()
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) Delete() sdk.ResourceFunc {
+	return r.base().deleteFunc()
+}
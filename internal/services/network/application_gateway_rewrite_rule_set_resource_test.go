@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApplicationGatewayRewriteRuleSetResource struct{}
+
+func TestAccApplicationGatewayRewriteRuleSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_rewrite_rule_set", "test")
+	r := ApplicationGatewayRewriteRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayRewriteRuleSet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_rewrite_rule_set", "test")
+	r := ApplicationGatewayRewriteRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayRewriteRuleSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_rewrite_rule_set", "test")
+	r := ApplicationGatewayRewriteRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayRewriteRuleSet_updateApplicationGateway(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_rewrite_rule_set", "test")
+	r := ApplicationGatewayRewriteRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// updating the Application Gateway must not remove the entry managed by this resource
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RewriteRuleSetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	resp, err := client.Network.ApplicationGateways.Get(ctx, gatewayId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.RewriteRuleSets != nil {
+		for _, v := range *model.Properties.RewriteRuleSets {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) basic(data acceptance.TestData, tag string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_rewrite_rule_set" "test" {
+  application_gateway_id = azurerm_application_gateway.test.id
+  name                   = "acctest-rewrite"
+
+  rewrite_rule {
+    name          = "add-header"
+    rule_sequence = 100
+
+    response_header_configuration {
+      header_name  = "X-Frame-Options"
+      header_value = "DENY"
+    }
+  }
+}
+`, ApplicationGatewayResource{}.childResourceManagement(data, tag))
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_rewrite_rule_set" "import" {
+  application_gateway_id = azurerm_application_gateway.test.id
+  name                   = "acctest-rewrite"
+
+  rewrite_rule {
+    name          = "add-header"
+    rule_sequence = 100
+
+    response_header_configuration {
+      header_name  = "X-Frame-Options"
+      header_value = "DENY"
+    }
+  }
+}
+`, r.basic(data, "first"))
+}
+
+func (r ApplicationGatewayRewriteRuleSetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_rewrite_rule_set" "test" {
+  application_gateway_id = azurerm_application_gateway.test.id
+  name                   = "acctest-rewrite"
+
+  rewrite_rule {
+    name          = "add-header"
+    rule_sequence = 100
+
+    condition {
+      variable    = "var_uri_path"
+      pattern     = ".*article/(.*)/(.*)"
+      ignore_case = true
+    }
+
+    request_header_configuration {
+      header_name  = "X-Forwarded-Proto"
+      header_value = "https"
+    }
+
+    response_header_configuration {
+      header_name  = "X-Frame-Options"
+      header_value = "SAMEORIGIN"
+    }
+  }
+
+  rewrite_rule {
+    name          = "rewrite-url"
+    rule_sequence = 200
+
+    url {
+      path         = "/article.aspx"
+      query_string = "id={var_uri_path_1}"
+    }
+  }
+}
+`, ApplicationGatewayResource{}.childResourceManagement(data, "first"))
+}
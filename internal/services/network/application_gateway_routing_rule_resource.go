@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ResourceWithUpdate = ApplicationGatewayRoutingRuleResource{}
+
+type ApplicationGatewayRoutingRuleResource struct{}
+
+func (r ApplicationGatewayRoutingRuleResource) base() applicationGatewayChildResourceBase[applicationgateways.ApplicationGatewayRequestRoutingRule] {
+	return applicationGatewayChildResourceBase[applicationgateways.ApplicationGatewayRequestRoutingRule]{
+		resourceType: r.ResourceType(),
+		block:        "request_routing_rule",
+		newId: func(gatewayId applicationgateways.ApplicationGatewayId, name string) resourceids.Id {
+			return parse.NewRequestRoutingRuleID(gatewayId.SubscriptionId, gatewayId.ResourceGroupName, gatewayId.ApplicationGatewayName, name)
+		},
+		parseId: func(input string) (*applicationgateways.ApplicationGatewayId, string, error) {
+			id, err := parse.RequestRoutingRuleID(input)
+			if err != nil {
+				return nil, "", err
+			}
+			return pointer.To(applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)), id.Name, nil
+		},
+		entries: func(props *applicationgateways.ApplicationGatewayPropertiesFormat) **[]applicationgateways.ApplicationGatewayRequestRoutingRule {
+			return &props.RequestRoutingRules
+		},
+		name: func(v applicationgateways.ApplicationGatewayRequestRoutingRule) *string {
+			return v.Name
+		},
+		expand: func(input []interface{}, gatewayId string) (*[]applicationgateways.ApplicationGatewayRequestRoutingRule, error) {
+			return expandApplicationGatewayRequestRoutingRules(input, gatewayId)
+		},
+		flatten: func(input *[]applicationgateways.ApplicationGatewayRequestRoutingRule) ([]interface{}, error) {
+			return flattenApplicationGatewayRequestRoutingRules(input)
+		},
+	}
+}
+
+func (r ApplicationGatewayRoutingRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return r.base().arguments()
+}
+
+func (r ApplicationGatewayRoutingRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return r.base().attributes()
+}
+
+func (r ApplicationGatewayRoutingRuleResource) ModelObject() interface{} {
+	return nil
+}
+
+func (r ApplicationGatewayRoutingRuleResource) ResourceType() string {
+	return "azurerm_application_gateway_routing_rule"
+}
+
+func (r ApplicationGatewayRoutingRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.RequestRoutingRuleID
+}
+
+func (r ApplicationGatewayRoutingRuleResource) Create() sdk.ResourceFunc {
+	return r.base().createFunc()
+}
+
+func (r ApplicationGatewayRoutingRuleResource) Read() sdk.ResourceFunc {
+	return r.base().readFunc()
+}
+
+func (r ApplicationGatewayRoutingRuleResource) Update() sdk.ResourceFunc {
+	return r.base().updateFunc()
+}
+
+func (r ApplicationGatewayRoutingRuleResource) Delete() sdk.ResourceFunc {
+	return r.base().deleteFunc()
+}
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApplicationGatewayRoutingRuleResource struct{}
+
+func TestAccApplicationGatewayRoutingRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_routing_rule", "test")
+	r := ApplicationGatewayRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayRoutingRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_routing_rule", "test")
+	r := ApplicationGatewayRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayRoutingRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_routing_rule", "test")
+	r := ApplicationGatewayRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayRoutingRule_updateApplicationGateway(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_routing_rule", "test")
+	r := ApplicationGatewayRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// updating the Application Gateway must not remove the entry managed by this resource
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationGatewayRoutingRuleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RequestRoutingRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	resp, err := client.Network.ApplicationGateways.Get(ctx, gatewayId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.RequestRoutingRules != nil {
+		for _, v := range *model.Properties.RequestRoutingRules {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r ApplicationGatewayRoutingRuleResource) basic(data acceptance.TestData, tag string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_listener" "test" {
+  application_gateway_id         = azurerm_application_gateway.test.id
+  name                           = "acctest-listener"
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = "${local.frontend_port_name}-child"
+  protocol                       = "Http"
+}
+
+resource "azurerm_application_gateway_routing_rule" "test" {
+  application_gateway_id     = azurerm_application_gateway.test.id
+  name                       = "acctest-rule"
+  rule_type                  = "Basic"
+  http_listener_name         = azurerm_application_gateway_listener.test.name
+  backend_address_pool_name  = local.backend_address_pool_name
+  backend_http_settings_name = local.http_setting_name
+  priority                   = 20
+}
+`, ApplicationGatewayResource{}.childResourceManagement(data, tag))
+}
+
+func (r ApplicationGatewayRoutingRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_routing_rule" "import" {
+  application_gateway_id     = azurerm_application_gateway_routing_rule.test.application_gateway_id
+  name                       = azurerm_application_gateway_routing_rule.test.name
+  rule_type                  = azurerm_application_gateway_routing_rule.test.rule_type
+  http_listener_name         = azurerm_application_gateway_routing_rule.test.http_listener_name
+  backend_address_pool_name  = azurerm_application_gateway_routing_rule.test.backend_address_pool_name
+  backend_http_settings_name = azurerm_application_gateway_routing_rule.test.backend_http_settings_name
+  priority                   = azurerm_application_gateway_routing_rule.test.priority
+}
+`, r.basic(data, "first"))
+}
+
+func (r ApplicationGatewayRoutingRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_listener" "test" {
+  application_gateway_id         = azurerm_application_gateway.test.id
+  name                           = "acctest-listener"
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = "${local.frontend_port_name}-child"
+  protocol                       = "Http"
+}
+
+resource "azurerm_application_gateway_backend_pool" "test" {
+  application_gateway_id = azurerm_application_gateway.test.id
+  name                   = "acctest-pool"
+  ip_addresses           = ["10.0.1.4"]
+}
+
+resource "azurerm_application_gateway_routing_rule" "test" {
+  application_gateway_id     = azurerm_application_gateway.test.id
+  name                       = "acctest-rule"
+  rule_type                  = "Basic"
+  http_listener_name         = azurerm_application_gateway_listener.test.name
+  backend_address_pool_name  = azurerm_application_gateway_backend_pool.test.name
+  backend_http_settings_name = local.http_setting_name
+  priority                   = 30
+}
+`, ApplicationGatewayResource{}.childResourceManagement(data, "first"))
+}
@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// The Network API exposes a number of collections which are only available as part of their parent (for example the
+// listeners of an Application Gateway, or the rules of a Firewall Policy Rule Collection Group) and are therefore
+// updated by sending the whole parent. The helpers below support resources managing a single named entry within such a
+// collection, alongside a parent resource which manages the remaining entries. Names within these collections are
+// compared case-insensitively.
+
+// UpsertByName returns `input` where the entry with the same name as `item` is replaced by `item`, or with `item`
+// appended when no such entry exists.
+func UpsertByName[T any](input []T, item T, name func(T) *string) []T {
+	results := make([]T, 0)
+	found := false
+
+	for _, v := range input {
+		if strings.EqualFold(pointer.From(name(v)), pointer.From(name(item))) {
+			results = append(results, item)
+			found = true
+			continue
+		}
+		results = append(results, v)
+	}
+
+	if !found {
+		results = append(results, item)
+	}
+
+	return results
+}
+
+// RemoveByName returns `input` without the entry named `entryName`.
+func RemoveByName[T any](input []T, entryName string, name func(T) *string) []T {
+	results := make([]T, 0)
+	for _, v := range input {
+		if strings.EqualFold(pointer.From(name(v)), entryName) {
+			continue
+		}
+		results = append(results, v)
+	}
+
+	return results
+}
+
+// ExistsByName returns whether an entry named `entryName` exists within `input`.
+func ExistsByName[T any](input []T, entryName string, name func(T) *string) bool {
+	for _, v := range input {
+		if strings.EqualFold(pointer.From(name(v)), entryName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MergeByName returns `configured` followed by each entry within `existing` whose name isn't used within
+// `configured`. Entries within `existing` which were previously configured on the parent, i.e. whose names are within
+// `previous`, have been removed from its configuration and are dropped. The remaining entries were never configured on
+// the parent and are retained, since they may be managed by a child resource.
+func MergeByName[T any](configured []T, existing []T, previous map[string]struct{}, name func(T) *string) []T {
+	results := make([]T, 0)
+	results = append(results, configured...)
+
+	for _, v := range existing {
+		if ExistsByName(configured, pointer.From(name(v)), name) {
+			continue
+		}
+		if _, ok := previous[strings.ToLower(pointer.From(name(v)))]; ok {
+			continue
+		}
+		results = append(results, v)
+	}
+
+	return results
+}
+
+// FlattenedNames returns the lower-cased names of the entries within a flattened block, which can be either a
+// `*pluginsdk.Set` or a `[]interface{}`.
+func FlattenedNames(input interface{}) map[string]struct{} {
+	names := make(map[string]struct{})
+
+	var entries []interface{}
+	switch v := input.(type) {
+	case *pluginsdk.Set:
+		entries = v.List()
+	case []interface{}:
+		entries = v
+	}
+
+	for _, raw := range entries {
+		if entry, ok := raw.(map[string]interface{}); ok {
+			names[strings.ToLower(fmt.Sprint(entry["name"]))] = struct{}{}
+		}
+	}
+
+	return names
+}
+
+// FilterFlattenedByName returns the entries within the flattened block `input` whose names are contained within
+// `names`.
+func FilterFlattenedByName(input []interface{}, names map[string]struct{}) []interface{} {
+	results := make([]interface{}, 0)
+	for _, raw := range input {
+		entry, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := names[strings.ToLower(fmt.Sprint(entry["name"]))]; ok {
+			results = append(results, raw)
+		}
+	}
+
+	return results
+}
+
+// FindFlattenedByName returns the entry named `entryName` within the flattened block `input`, or nil when it doesn't
+// exist.
+func FindFlattenedByName(input []interface{}, entryName string) map[string]interface{} {
+	for _, raw := range input {
+		if entry, ok := raw.(map[string]interface{}); ok && strings.EqualFold(fmt.Sprint(entry["name"]), entryName) {
+			return entry
+		}
+	}
+
+	return nil
+}
+
+// ExpandEntry returns the values of the fields within `s` from the resource data, in the same structure as a single
+// entry of the flattened block the schema was taken from, so that the expand functions of the parent resource can be
+// reused. The fields named in `exclude` only exist on the child resource and are omitted.
+func ExpandEntry(d *pluginsdk.ResourceData, s map[string]*pluginsdk.Schema, exclude ...string) map[string]interface{} {
+	entry := make(map[string]interface{})
+	for k := range s {
+		if slices.Contains(exclude, k) {
+			continue
+		}
+		entry[k] = d.Get(k)
+	}
+
+	return entry
+}
+
+// SetEntry sets the fields of a single entry of a flattened block into the resource data, skipping the fields which
+// aren't part of `s` together with those named in `exclude`.
+func SetEntry(d *pluginsdk.ResourceData, s map[string]*pluginsdk.Schema, entry map[string]interface{}, exclude ...string) error {
+	for k, v := range entry {
+		if _, ok := s[k]; !ok || slices.Contains(exclude, k) {
+			continue
+		}
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", k, err)
+		}
+	}
+
+	return nil
+}
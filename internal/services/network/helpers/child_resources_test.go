@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+type namedEntry struct {
+	Name  *string
+	Value string
+}
+
+func namedEntryName(v namedEntry) *string {
+	return v.Name
+}
+
+func TestMergeByName(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Configured []namedEntry
+		Existing   []namedEntry
+		Previous   map[string]struct{}
+		Expected   []namedEntry
+	}{
+		{
+			Name:       "nothing exists",
+			Configured: []namedEntry{{Name: pointer.To("a"), Value: "configured"}},
+			Existing:   nil,
+			Expected:   []namedEntry{{Name: pointer.To("a"), Value: "configured"}},
+		},
+		{
+			Name:       "configured entries replace existing entries regardless of case",
+			Configured: []namedEntry{{Name: pointer.To("a"), Value: "configured"}},
+			Existing:   []namedEntry{{Name: pointer.To("A"), Value: "existing"}},
+			Expected:   []namedEntry{{Name: pointer.To("a"), Value: "configured"}},
+		},
+		{
+			Name:       "entries which aren't configured are retained",
+			Configured: []namedEntry{{Name: pointer.To("a"), Value: "configured"}},
+			Existing: []namedEntry{
+				{Name: pointer.To("a"), Value: "existing"},
+				{Name: pointer.To("b"), Value: "existing"},
+			},
+			Expected: []namedEntry{
+				{Name: pointer.To("a"), Value: "configured"},
+				{Name: pointer.To("b"), Value: "existing"},
+			},
+		},
+		{
+			Name:       "entries which were previously configured are removed",
+			Configured: []namedEntry{{Name: pointer.To("a"), Value: "configured"}},
+			Existing: []namedEntry{
+				{Name: pointer.To("a"), Value: "existing"},
+				{Name: pointer.To("B"), Value: "existing"},
+				{Name: pointer.To("c"), Value: "existing"},
+			},
+			Previous: map[string]struct{}{"a": {}, "b": {}},
+			Expected: []namedEntry{
+				{Name: pointer.To("a"), Value: "configured"},
+				{Name: pointer.To("c"), Value: "existing"},
+			},
+		},
+		{
+			Name:       "nothing configured",
+			Configured: nil,
+			Existing:   []namedEntry{{Name: pointer.To("b"), Value: "existing"}},
+			Expected:   []namedEntry{{Name: pointer.To("b"), Value: "existing"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual := MergeByName(tc.Configured, tc.Existing, tc.Previous, namedEntryName)
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestUpsertAndRemoveByName(t *testing.T) {
+	input := []namedEntry{
+		{Name: pointer.To("a"), Value: "1"},
+		{Name: pointer.To("b"), Value: "1"},
+	}
+
+	actual := UpsertByName(input, namedEntry{Name: pointer.To("B"), Value: "2"}, namedEntryName)
+	expected := []namedEntry{
+		{Name: pointer.To("a"), Value: "1"},
+		{Name: pointer.To("B"), Value: "2"},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	actual = UpsertByName(actual, namedEntry{Name: pointer.To("c"), Value: "1"}, namedEntryName)
+	if len(actual) != 3 || !ExistsByName(actual, "C", namedEntryName) {
+		t.Fatalf("expected `c` to be appended but got %+v", actual)
+	}
+
+	actual = RemoveByName(actual, "A", namedEntryName)
+	if len(actual) != 2 || ExistsByName(actual, "a", namedEntryName) {
+		t.Fatalf("expected `a` to be removed but got %+v", actual)
+	}
+}
+
+func TestFilterFlattenedByName(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{"name": "A"},
+		map[string]interface{}{"name": "b"},
+	}
+
+	actual := FilterFlattenedByName(input, FlattenedNames([]interface{}{map[string]interface{}{"name": "a"}}))
+	expected := []interface{}{map[string]interface{}{"name": "A"}}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	if FindFlattenedByName(input, "B") == nil {
+		t.Fatalf("expected `b` to be found")
+	}
+	if FindFlattenedByName(input, "c") != nil {
+		t.Fatalf("expected `c` not to be found")
+	}
+}
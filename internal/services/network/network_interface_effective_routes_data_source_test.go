@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkInterfaceEffectiveRoutesDataSource struct{}
+
+func TestAccNetworkInterfaceEffectiveRoutesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_interface_effective_routes", "test")
+	r := NetworkInterfaceEffectiveRoutesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("route.#").IsNotEmpty(),
+				check.That(data.ResourceName).Key("route.0.source").HasValue("Default"),
+				check.That(data.ResourceName).Key("route.0.state").HasValue("Active"),
+				check.That(data.ResourceName).Key("route.0.next_hop_type").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (NetworkInterfaceEffectiveRoutesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_interface_effective_routes" "test" {
+  network_interface_id = azurerm_network_interface.test.id
+
+  depends_on = [azurerm_virtual_machine.test]
+}
+`, NetworkPacketCaptureResource{}.base(data))
+}
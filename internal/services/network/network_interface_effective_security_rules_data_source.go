@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networkinterfaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = NetworkInterfaceEffectiveSecurityRulesDataSource{}
+
+type NetworkInterfaceEffectiveSecurityRulesDataSource struct{}
+
+type NetworkInterfaceEffectiveSecurityRulesDataSourceModel struct {
+	NetworkInterfaceId    string                                        `tfschema:"network_interface_id"`
+	NetworkSecurityGroups []NetworkInterfaceEffectiveSecurityGroupModel `tfschema:"network_security_group"`
+}
+
+type NetworkInterfaceEffectiveSecurityGroupModel struct {
+	NetworkSecurityGroupId       string                                       `tfschema:"network_security_group_id"`
+	AssociatedNetworkInterfaceId string                                       `tfschema:"associated_network_interface_id"`
+	AssociatedSubnetId           string                                       `tfschema:"associated_subnet_id"`
+	Rules                        []NetworkInterfaceEffectiveSecurityRuleModel `tfschema:"rule"`
+}
+
+type NetworkInterfaceEffectiveSecurityRuleModel struct {
+	Name                               string   `tfschema:"name"`
+	Priority                           int64    `tfschema:"priority"`
+	Direction                          string   `tfschema:"direction"`
+	Access                             string   `tfschema:"access"`
+	Protocol                           string   `tfschema:"protocol"`
+	SourceAddressPrefixes              []string `tfschema:"source_address_prefixes"`
+	SourcePortRanges                   []string `tfschema:"source_port_ranges"`
+	DestinationAddressPrefixes         []string `tfschema:"destination_address_prefixes"`
+	DestinationPortRanges              []string `tfschema:"destination_port_ranges"`
+	ExpandedSourceAddressPrefixes      []string `tfschema:"expanded_source_address_prefixes"`
+	ExpandedDestinationAddressPrefixes []string `tfschema:"expanded_destination_address_prefixes"`
+}
+
+func (NetworkInterfaceEffectiveSecurityRulesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_interface_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceID,
+		},
+	}
+}
+
+func (NetworkInterfaceEffectiveSecurityRulesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	stringList := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"network_security_group": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"network_security_group_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"associated_network_interface_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"associated_subnet_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"rule": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"priority": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"direction": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"access": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"protocol": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"source_address_prefixes": stringList(),
+
+								"source_port_ranges": stringList(),
+
+								"destination_address_prefixes": stringList(),
+
+								"destination_port_ranges": stringList(),
+
+								"expanded_source_address_prefixes": stringList(),
+
+								"expanded_destination_address_prefixes": stringList(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (NetworkInterfaceEffectiveSecurityRulesDataSource) ModelObject() interface{} {
+	return &NetworkInterfaceEffectiveSecurityRulesDataSourceModel{}
+}
+
+func (NetworkInterfaceEffectiveSecurityRulesDataSource) ResourceType() string {
+	return "azurerm_network_interface_effective_security_rules"
+}
+
+func (NetworkInterfaceEffectiveSecurityRulesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkInterfaces
+
+			var state NetworkInterfaceEffectiveSecurityRulesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseNetworkInterfaceID(state.NetworkInterfaceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListEffectiveNetworkSecurityGroups(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving Effective Network Security Groups for %s: %+v", *id, err)
+			}
+			if err := resp.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for Effective Network Security Groups for %s: %+v", *id, err)
+			}
+
+			// the result of this long running operation is only available from the final polling response
+			var result struct {
+				Value *[]networkinterfaces.EffectiveNetworkSecurityGroup `json:"value"`
+			}
+			if err := resp.Poller.FinalResult(&result); err != nil {
+				return fmt.Errorf("retrieving Effective Network Security Groups for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+
+			state.NetworkInterfaceId = id.ID()
+			state.NetworkSecurityGroups = flattenNetworkInterfaceEffectiveSecurityGroups(result.Value)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenNetworkInterfaceEffectiveSecurityGroups(input *[]networkinterfaces.EffectiveNetworkSecurityGroup) []NetworkInterfaceEffectiveSecurityGroupModel {
+	results := make([]NetworkInterfaceEffectiveSecurityGroupModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		group := NetworkInterfaceEffectiveSecurityGroupModel{
+			Rules: flattenNetworkInterfaceEffectiveSecurityRules(v.EffectiveSecurityRules),
+		}
+
+		if v.NetworkSecurityGroup != nil {
+			group.NetworkSecurityGroupId = pointer.From(v.NetworkSecurityGroup.Id)
+		}
+
+		if association := v.Association; association != nil {
+			if association.NetworkInterface != nil {
+				group.AssociatedNetworkInterfaceId = pointer.From(association.NetworkInterface.Id)
+			}
+			if association.Subnet != nil {
+				group.AssociatedSubnetId = pointer.From(association.Subnet.Id)
+			}
+		}
+
+		results = append(results, group)
+	}
+
+	return results
+}
+
+func flattenNetworkInterfaceEffectiveSecurityRules(input *[]networkinterfaces.EffectiveNetworkSecurityRule) []NetworkInterfaceEffectiveSecurityRuleModel {
+	results := make([]NetworkInterfaceEffectiveSecurityRuleModel, 0)
+	if input == nil {
+		return results
+	}
+
+	// the API returns both a single value and a list for prefixes and port ranges, only one of which is populated
+	combine := func(single *string, multiple *[]string) []string {
+		out := make([]string, 0)
+		if single != nil && *single != "" {
+			out = append(out, *single)
+		}
+		return append(out, pointer.From(multiple)...)
+	}
+
+	for _, v := range *input {
+		results = append(results, NetworkInterfaceEffectiveSecurityRuleModel{
+			Name:                               pointer.From(v.Name),
+			Priority:                           pointer.From(v.Priority),
+			Direction:                          string(pointer.From(v.Direction)),
+			Access:                             string(pointer.From(v.Access)),
+			Protocol:                           string(pointer.From(v.Protocol)),
+			SourceAddressPrefixes:              combine(v.SourceAddressPrefix, v.SourceAddressPrefixes),
+			SourcePortRanges:                   combine(v.SourcePortRange, v.SourcePortRanges),
+			DestinationAddressPrefixes:         combine(v.DestinationAddressPrefix, v.DestinationAddressPrefixes),
+			DestinationPortRanges:              combine(v.DestinationPortRange, v.DestinationPortRanges),
+			ExpandedSourceAddressPrefixes:      pointer.From(v.ExpandedSourceAddressPrefix),
+			ExpandedDestinationAddressPrefixes: pointer.From(v.ExpandedDestinationAddressPrefix),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networkwatchers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = NetworkWatcherIPFlowVerifyDataSource{}
+
+type NetworkWatcherIPFlowVerifyDataSource struct{}
+
+type NetworkWatcherIPFlowVerifyDataSourceModel struct {
+	NetworkWatcherId         string `tfschema:"network_watcher_id"`
+	TargetResourceId         string `tfschema:"target_resource_id"`
+	TargetNetworkInterfaceId string `tfschema:"target_network_interface_id"`
+	Direction                string `tfschema:"direction"`
+	Protocol                 string `tfschema:"protocol"`
+	LocalIPAddress           string `tfschema:"local_ip_address"`
+	LocalPort                int64  `tfschema:"local_port"`
+	RemoteIPAddress          string `tfschema:"remote_ip_address"`
+	RemotePort               int64  `tfschema:"remote_port"`
+	Access                   string `tfschema:"access"`
+	RuleName                 string `tfschema:"rule_name"`
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_watcher_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networkwatchers.ValidateNetworkWatcherID,
+		},
+
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateVirtualMachineID,
+		},
+
+		"direction": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(networkwatchers.PossibleValuesForDirection(), false),
+		},
+
+		"protocol": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(networkwatchers.PossibleValuesForIPFlowProtocol(), false),
+		},
+
+		"local_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+
+		"local_port": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+
+		"remote_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+
+		"remote_port": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+
+		"target_network_interface_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceID,
+		},
+	}
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"access": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"rule_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) ModelObject() interface{} {
+	return &NetworkWatcherIPFlowVerifyDataSourceModel{}
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) ResourceType() string {
+	return "azurerm_network_watcher_ip_flow_verify"
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkWatchers
+
+			var state NetworkWatcherIPFlowVerifyDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := networkwatchers.ParseNetworkWatcherID(state.NetworkWatcherId)
+			if err != nil {
+				return err
+			}
+
+			payload := networkwatchers.VerificationIPFlowParameters{
+				TargetResourceId: state.TargetResourceId,
+				Direction:        networkwatchers.Direction(state.Direction),
+				Protocol:         networkwatchers.IPFlowProtocol(state.Protocol),
+				LocalIPAddress:   state.LocalIPAddress,
+				LocalPort:        strconv.FormatInt(state.LocalPort, 10),
+				RemoteIPAddress:  state.RemoteIPAddress,
+				RemotePort:       strconv.FormatInt(state.RemotePort, 10),
+			}
+			if state.TargetNetworkInterfaceId != "" {
+				payload.TargetNicResourceId = pointer.To(state.TargetNetworkInterfaceId)
+			}
+
+			resp, err := client.VerifyIPFlow(ctx, *id, payload)
+			if err != nil {
+				return fmt.Errorf("verifying IP Flow using %s: %+v", *id, err)
+			}
+			if err := resp.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for IP Flow verification using %s: %+v", *id, err)
+			}
+
+			// the result of this long running operation is only available from the final polling response
+			var result networkwatchers.VerificationIPFlowResult
+			if err := resp.Poller.FinalResult(&result); err != nil {
+				return fmt.Errorf("retrieving IP Flow verification result from %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+
+			state.NetworkWatcherId = id.ID()
+			state.Access = string(pointer.From(result.Access))
+			state.RuleName = pointer.From(result.RuleName)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
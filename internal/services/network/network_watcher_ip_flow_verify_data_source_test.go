@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherIPFlowVerifyDataSource struct{}
+
+func TestAccNetworkWatcherIPFlowVerifyDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_ip_flow_verify", "test")
+	r := NetworkWatcherIPFlowVerifyDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").HasValue("Allow"),
+				check.That(data.ResourceName).Key("rule_name").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func TestAccNetworkWatcherIPFlowVerifyDataSource_denied(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_ip_flow_verify", "test")
+	r := NetworkWatcherIPFlowVerifyDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.denied(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").HasValue("Deny"),
+				check.That(data.ResourceName).Key("rule_name").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_ip_flow_verify" "test" {
+  network_watcher_id = azurerm_network_watcher.test.id
+  target_resource_id = azurerm_virtual_machine.test.id
+  direction          = "Outbound"
+  protocol           = "TCP"
+  local_ip_address   = azurerm_network_interface.test.private_ip_address
+  local_port         = 50000
+  remote_ip_address  = "13.107.21.200"
+  remote_port        = 443
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, NetworkPacketCaptureResource{}.base(data))
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) denied(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_ip_flow_verify" "test" {
+  network_watcher_id          = azurerm_network_watcher.test.id
+  target_resource_id          = azurerm_virtual_machine.test.id
+  target_network_interface_id = azurerm_network_interface.test.id
+  direction                   = "Inbound"
+  protocol                    = "TCP"
+  local_ip_address            = azurerm_network_interface.test.private_ip_address
+  local_port                  = 3389
+  remote_ip_address           = "203.0.113.10"
+  remote_port                 = 50000
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, NetworkPacketCaptureResource{}.base(data))
+}
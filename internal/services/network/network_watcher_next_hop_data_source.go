@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networkwatchers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = NetworkWatcherNextHopDataSource{}
+
+type NetworkWatcherNextHopDataSource struct{}
+
+type NetworkWatcherNextHopDataSourceModel struct {
+	NetworkWatcherId         string `tfschema:"network_watcher_id"`
+	TargetResourceId         string `tfschema:"target_resource_id"`
+	TargetNetworkInterfaceId string `tfschema:"target_network_interface_id"`
+	SourceIPAddress          string `tfschema:"source_ip_address"`
+	DestinationIPAddress     string `tfschema:"destination_ip_address"`
+	NextHopType              string `tfschema:"next_hop_type"`
+	NextHopIPAddress         string `tfschema:"next_hop_ip_address"`
+	RouteTableId             string `tfschema:"route_table_id"`
+}
+
+func (NetworkWatcherNextHopDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_watcher_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networkwatchers.ValidateNetworkWatcherID,
+		},
+
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateVirtualMachineID,
+		},
+
+		"source_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+
+		"destination_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+
+		"target_network_interface_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceID,
+		},
+	}
+}
+
+func (NetworkWatcherNextHopDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"next_hop_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"next_hop_ip_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"route_table_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (NetworkWatcherNextHopDataSource) ModelObject() interface{} {
+	return &NetworkWatcherNextHopDataSourceModel{}
+}
+
+func (NetworkWatcherNextHopDataSource) ResourceType() string {
+	return "azurerm_network_watcher_next_hop"
+}
+
+func (NetworkWatcherNextHopDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkWatchers
+
+			var state NetworkWatcherNextHopDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := networkwatchers.ParseNetworkWatcherID(state.NetworkWatcherId)
+			if err != nil {
+				return err
+			}
+
+			payload := networkwatchers.NextHopParameters{
+				TargetResourceId:     state.TargetResourceId,
+				SourceIPAddress:      state.SourceIPAddress,
+				DestinationIPAddress: state.DestinationIPAddress,
+			}
+			if state.TargetNetworkInterfaceId != "" {
+				payload.TargetNicResourceId = pointer.To(state.TargetNetworkInterfaceId)
+			}
+
+			resp, err := client.GetNextHop(ctx, *id, payload)
+			if err != nil {
+				return fmt.Errorf("retrieving Next Hop from %s: %+v", *id, err)
+			}
+			if err := resp.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for Next Hop from %s: %+v", *id, err)
+			}
+
+			// the result of this long running operation is only available from the final polling response
+			var result networkwatchers.NextHopResult
+			if err := resp.Poller.FinalResult(&result); err != nil {
+				return fmt.Errorf("retrieving Next Hop from %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+
+			state.NetworkWatcherId = id.ID()
+			state.NextHopType = string(pointer.From(result.NextHopType))
+			state.NextHopIPAddress = pointer.From(result.NextHopIPAddress)
+			state.RouteTableId = pointer.From(result.RouteTableId)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
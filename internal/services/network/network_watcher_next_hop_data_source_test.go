@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherNextHopDataSource struct{}
+
+func TestAccNetworkWatcherNextHopDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_next_hop", "test")
+	r := NetworkWatcherNextHopDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("next_hop_type").HasValue("Internet"),
+			),
+		},
+	})
+}
+
+func TestAccNetworkWatcherNextHopDataSource_virtualNetwork(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_next_hop", "test")
+	r := NetworkWatcherNextHopDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.virtualNetwork(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("next_hop_type").HasValue("VnetLocal"),
+			),
+		},
+	})
+}
+
+func (NetworkWatcherNextHopDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_next_hop" "test" {
+  network_watcher_id     = azurerm_network_watcher.test.id
+  target_resource_id     = azurerm_virtual_machine.test.id
+  source_ip_address      = azurerm_network_interface.test.private_ip_address
+  destination_ip_address = "13.107.21.200"
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, NetworkPacketCaptureResource{}.base(data))
+}
+
+func (NetworkWatcherNextHopDataSource) virtualNetwork(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_next_hop" "test" {
+  network_watcher_id          = azurerm_network_watcher.test.id
+  target_resource_id          = azurerm_virtual_machine.test.id
+  target_network_interface_id = azurerm_network_interface.test.id
+  source_ip_address           = azurerm_network_interface.test.private_ip_address
+  destination_ip_address      = "10.0.2.100"
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, NetworkPacketCaptureResource{}.base(data))
+}
@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// privateEndpointDnsZone defines the Private DNS Zone required for a given Subresource of a Private Endpoint target,
+// per Cloud Environment. Environments which don't support the Subresource are omitted from `zoneNames`.
+type privateEndpointDnsZone struct {
+	zoneNames map[string]string
+
+	// recordNameSuffix is appended to the name of the target resource to build the recommended record name
+	recordNameSuffix string
+}
+
+func newPrivateEndpointDnsZone(public, china, usGovernment string) privateEndpointDnsZone {
+	zoneNames := make(map[string]string)
+	for env, name := range map[string]string{
+		environments.AzurePublicCloud:       public,
+		environments.AzureChinaCloud:        china,
+		environments.AzureUSGovernmentCloud: usGovernment,
+	} {
+		if name != "" {
+			zoneNames[env] = name
+		}
+	}
+
+	return privateEndpointDnsZone{
+		zoneNames: zoneNames,
+	}
+}
+
+func (z privateEndpointDnsZone) withRecordNameSuffix(suffix string) privateEndpointDnsZone {
+	z.recordNameSuffix = suffix
+	return z
+}
+
+// privateEndpointDnsZones is the catalogue of Private DNS Zones required for each Private Endpoint target, keyed by
+// the lower-cased Resource Type and then the lower-cased Subresource (Group ID) name.
+//
+// The Zone names are taken from https://learn.microsoft.com/azure/private-link/private-endpoint-dns and should be
+// kept in sync with that page as new services gain Private Link support. Zones which include the region or another
+// variable component are not included, since they can't be determined from the Resource ID alone.
+var privateEndpointDnsZones = map[string]map[string]privateEndpointDnsZone{
+	"microsoft.appconfiguration/configurationstores": {
+		"configurationstores": newPrivateEndpointDnsZone("privatelink.azconfig.io", "privatelink.azconfig.azure.cn", "privatelink.azconfig.azure.us"),
+	},
+	"microsoft.automation/automationaccounts": {
+		"webhook":            newPrivateEndpointDnsZone("privatelink.azure-automation.net", "privatelink.azure-automation.cn", "privatelink.azure-automation.us"),
+		"dscandhybridworker": newPrivateEndpointDnsZone("privatelink.azure-automation.net", "privatelink.azure-automation.cn", "privatelink.azure-automation.us"),
+	},
+	"microsoft.cache/redis": {
+		"rediscache": newPrivateEndpointDnsZone("privatelink.redis.cache.windows.net", "privatelink.redis.cache.chinacloudapi.cn", "privatelink.redis.cache.usgovcloudapi.net"),
+	},
+	"microsoft.cognitiveservices/accounts": {
+		"account": newPrivateEndpointDnsZone("privatelink.cognitiveservices.azure.com", "privatelink.cognitiveservices.azure.cn", "privatelink.cognitiveservices.azure.us"),
+	},
+	"microsoft.containerregistry/registries": {
+		"registry": newPrivateEndpointDnsZone("privatelink.azurecr.io", "privatelink.azurecr.cn", "privatelink.azurecr.us"),
+	},
+	"microsoft.datafactory/factories": {
+		"datafactory": newPrivateEndpointDnsZone("privatelink.datafactory.azure.net", "privatelink.datafactory.azure.cn", "privatelink.datafactory.azure.us"),
+		"portal":      newPrivateEndpointDnsZone("privatelink.adf.azure.com", "privatelink.adf.azure.cn", "privatelink.adf.azure.us"),
+	},
+	"microsoft.dbformysql/flexibleservers": {
+		"mysqlserver": newPrivateEndpointDnsZone("privatelink.mysql.database.azure.com", "privatelink.mysql.database.chinacloudapi.cn", "privatelink.mysql.database.usgovcloudapi.net"),
+	},
+	"microsoft.dbforpostgresql/flexibleservers": {
+		"postgresqlserver": newPrivateEndpointDnsZone("privatelink.postgres.database.azure.com", "privatelink.postgres.database.chinacloudapi.cn", "privatelink.postgres.database.usgovcloudapi.net"),
+	},
+	"microsoft.devices/iothubs": {
+		"iothub": newPrivateEndpointDnsZone("privatelink.azure-devices.net", "privatelink.azure-devices.cn", "privatelink.azure-devices.us"),
+	},
+	"microsoft.documentdb/databaseaccounts": {
+		"sql":       newPrivateEndpointDnsZone("privatelink.documents.azure.com", "privatelink.documents.azure.cn", "privatelink.documents.azure.us"),
+		"mongodb":   newPrivateEndpointDnsZone("privatelink.mongo.cosmos.azure.com", "privatelink.mongo.cosmos.azure.cn", "privatelink.mongo.cosmos.azure.us"),
+		"cassandra": newPrivateEndpointDnsZone("privatelink.cassandra.cosmos.azure.com", "privatelink.cassandra.cosmos.azure.cn", "privatelink.cassandra.cosmos.azure.us"),
+		"gremlin":   newPrivateEndpointDnsZone("privatelink.gremlin.cosmos.azure.com", "privatelink.gremlin.cosmos.azure.cn", "privatelink.gremlin.cosmos.azure.us"),
+		"table":     newPrivateEndpointDnsZone("privatelink.table.cosmos.azure.com", "privatelink.table.cosmos.azure.cn", "privatelink.table.cosmos.azure.us"),
+	},
+	"microsoft.eventgrid/domains": {
+		"domain": newPrivateEndpointDnsZone("privatelink.eventgrid.azure.net", "privatelink.eventgrid.azure.cn", "privatelink.eventgrid.azure.us"),
+	},
+	"microsoft.eventgrid/topics": {
+		"topic": newPrivateEndpointDnsZone("privatelink.eventgrid.azure.net", "privatelink.eventgrid.azure.cn", "privatelink.eventgrid.azure.us"),
+	},
+	"microsoft.eventhub/namespaces": {
+		"namespace": newPrivateEndpointDnsZone("privatelink.servicebus.windows.net", "privatelink.servicebus.chinacloudapi.cn", "privatelink.servicebus.usgovcloudapi.net"),
+	},
+	"microsoft.keyvault/managedhsms": {
+		"managedhsm": newPrivateEndpointDnsZone("privatelink.managedhsm.azure.net", "privatelink.managedhsm.azure.cn", "privatelink.managedhsm.usgovcloudapi.net"),
+	},
+	"microsoft.keyvault/vaults": {
+		"vault": newPrivateEndpointDnsZone("privatelink.vaultcore.azure.net", "privatelink.vaultcore.azure.cn", "privatelink.vaultcore.usgovcloudapi.net"),
+	},
+	"microsoft.search/searchservices": {
+		"searchservice": newPrivateEndpointDnsZone("privatelink.search.windows.net", "privatelink.search.azure.cn", "privatelink.search.windows.us"),
+	},
+	"microsoft.servicebus/namespaces": {
+		"namespace": newPrivateEndpointDnsZone("privatelink.servicebus.windows.net", "privatelink.servicebus.chinacloudapi.cn", "privatelink.servicebus.usgovcloudapi.net"),
+	},
+	"microsoft.signalrservice/signalr": {
+		"signalr": newPrivateEndpointDnsZone("privatelink.service.signalr.net", "privatelink.signalr.azure.cn", "privatelink.signalr.azure.us"),
+	},
+	"microsoft.signalrservice/webpubsub": {
+		"webpubsub": newPrivateEndpointDnsZone("privatelink.webpubsub.azure.com", "privatelink.webpubsub.azure.cn", "privatelink.webpubsub.azure.us"),
+	},
+	"microsoft.sql/servers": {
+		"sqlserver": newPrivateEndpointDnsZone("privatelink.database.windows.net", "privatelink.database.chinacloudapi.cn", "privatelink.database.usgovcloudapi.net"),
+	},
+	"microsoft.storage/storageaccounts": {
+		"blob":           newPrivateEndpointDnsZone("privatelink.blob.core.windows.net", "privatelink.blob.core.chinacloudapi.cn", "privatelink.blob.core.usgovcloudapi.net"),
+		"blob_secondary": newPrivateEndpointDnsZone("privatelink.blob.core.windows.net", "privatelink.blob.core.chinacloudapi.cn", "privatelink.blob.core.usgovcloudapi.net").withRecordNameSuffix("-secondary"),
+		"dfs":            newPrivateEndpointDnsZone("privatelink.dfs.core.windows.net", "privatelink.dfs.core.chinacloudapi.cn", "privatelink.dfs.core.usgovcloudapi.net"),
+		"dfs_secondary":  newPrivateEndpointDnsZone("privatelink.dfs.core.windows.net", "privatelink.dfs.core.chinacloudapi.cn", "privatelink.dfs.core.usgovcloudapi.net").withRecordNameSuffix("-secondary"),
+		"file":           newPrivateEndpointDnsZone("privatelink.file.core.windows.net", "privatelink.file.core.chinacloudapi.cn", "privatelink.file.core.usgovcloudapi.net"),
+		"queue":          newPrivateEndpointDnsZone("privatelink.queue.core.windows.net", "privatelink.queue.core.chinacloudapi.cn", "privatelink.queue.core.usgovcloudapi.net"),
+		"table":          newPrivateEndpointDnsZone("privatelink.table.core.windows.net", "privatelink.table.core.chinacloudapi.cn", "privatelink.table.core.usgovcloudapi.net"),
+		"web":            newPrivateEndpointDnsZone("privatelink.web.core.windows.net", "privatelink.web.core.chinacloudapi.cn", "privatelink.web.core.usgovcloudapi.net"),
+	},
+	"microsoft.synapse/workspaces": {
+		"sql":         newPrivateEndpointDnsZone("privatelink.sql.azuresynapse.net", "privatelink.sql.azuresynapse.azure.cn", "privatelink.sql.azuresynapse.usgovcloudapi.net"),
+		"sqlondemand": newPrivateEndpointDnsZone("privatelink.sql.azuresynapse.net", "privatelink.sql.azuresynapse.azure.cn", "privatelink.sql.azuresynapse.usgovcloudapi.net").withRecordNameSuffix("-ondemand"),
+		"dev":         newPrivateEndpointDnsZone("privatelink.dev.azuresynapse.net", "privatelink.dev.azuresynapse.azure.cn", "privatelink.dev.azuresynapse.usgovcloudapi.net"),
+	},
+	"microsoft.web/sites": {
+		"sites": newPrivateEndpointDnsZone("privatelink.azurewebsites.net", "privatelink.chinacloudsites.cn", "privatelink.azurewebsites.us"),
+	},
+}
+
+// privateEndpointTarget is the Resource Type and Name of a Private Endpoint target
+type privateEndpointTarget struct {
+	resourceType string
+	name         string
+}
+
+// parsePrivateEndpointTarget determines the Resource Type (e.g. `Microsoft.Storage/storageAccounts`) and Name of
+// the specified Resource ID
+func parsePrivateEndpointTarget(input string) (*privateEndpointTarget, error) {
+	idx := strings.LastIndex(strings.ToLower(input), "/providers/")
+	if idx == -1 {
+		return nil, fmt.Errorf("%q is not a Resource ID of a Provider resource", input)
+	}
+
+	segments := strings.Split(strings.Trim(input[idx+len("/providers/"):], "/"), "/")
+	if len(segments) < 3 || len(segments)%2 != 1 {
+		return nil, fmt.Errorf("%q is not a Resource ID of a Provider resource", input)
+	}
+
+	// the Resource Type is the Provider Namespace followed by each of the type segments, e.g.
+	// `Microsoft.Storage/storageAccounts/account1` -> `Microsoft.Storage/storageAccounts`
+	resourceType := []string{segments[0]}
+	for i := 1; i < len(segments); i += 2 {
+		resourceType = append(resourceType, segments[i])
+	}
+
+	return &privateEndpointTarget{
+		resourceType: strings.Join(resourceType, "/"),
+		name:         segments[len(segments)-1],
+	}, nil
+}
+
+// findPrivateEndpointDnsZone returns the name of the Private DNS Zone required for the Subresource of the specified
+// Resource Type within the Cloud Environment, along with the suffix of the recommended record name
+func findPrivateEndpointDnsZone(environment, resourceType, subresourceName string) (string, string, error) {
+	subresources, ok := privateEndpointDnsZones[strings.ToLower(resourceType)]
+	if !ok {
+		return "", "", fmt.Errorf("the Private DNS Zones for Private Endpoints targeting %q are not known", resourceType)
+	}
+
+	zone, ok := subresources[strings.ToLower(subresourceName)]
+	if !ok {
+		supported := make([]string, 0)
+		for k := range subresources {
+			supported = append(supported, k)
+		}
+		sort.Strings(supported)
+
+		return "", "", fmt.Errorf("the Private DNS Zones for the Subresource %q of %q are not known - supported Subresources are %s", subresourceName, resourceType, strings.Join(supported, ", "))
+	}
+
+	zoneName, ok := zone.zoneNames[environment]
+	if !ok {
+		return "", "", fmt.Errorf("the Subresource %q of %q is not available in the %q environment", subresourceName, resourceType, environment)
+	}
+
+	return zoneName, zone.recordNameSuffix, nil
+}
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = PrivateEndpointDnsZonesDataSource{}
+
+type PrivateEndpointDnsZonesDataSource struct{}
+
+type PrivateEndpointDnsZonesDataSourceModel struct {
+	TargetResourceId string                        `tfschema:"target_resource_id"`
+	SubresourceNames []string                      `tfschema:"subresource_names"`
+	DnsZones         []PrivateEndpointDnsZoneModel `tfschema:"dns_zone"`
+	DnsZoneNames     []string                      `tfschema:"dns_zone_names"`
+}
+
+type PrivateEndpointDnsZoneModel struct {
+	SubresourceName string `tfschema:"subresource_name"`
+	Name            string `tfschema:"name"`
+	RecordName      string `tfschema:"record_name"`
+	Fqdn            string `tfschema:"fqdn"`
+}
+
+func (PrivateEndpointDnsZonesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"subresource_names": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (PrivateEndpointDnsZonesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dns_zone": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subresource_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"record_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"fqdn": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"dns_zone_names": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (PrivateEndpointDnsZonesDataSource) ModelObject() interface{} {
+	return &PrivateEndpointDnsZonesDataSourceModel{}
+}
+
+func (PrivateEndpointDnsZonesDataSource) ResourceType() string {
+	return "azurerm_private_endpoint_dns_zones"
+}
+
+func (PrivateEndpointDnsZonesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			environment := metadata.Client.Account.Environment.Name
+
+			var state PrivateEndpointDnsZonesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			target, err := parsePrivateEndpointTarget(state.TargetResourceId)
+			if err != nil {
+				return err
+			}
+
+			state.DnsZones = make([]PrivateEndpointDnsZoneModel, 0)
+			state.DnsZoneNames = make([]string, 0)
+			seen := make(map[string]struct{})
+
+			for _, subresourceName := range state.SubresourceNames {
+				zoneName, recordNameSuffix, err := findPrivateEndpointDnsZone(environment, target.resourceType, subresourceName)
+				if err != nil {
+					return err
+				}
+
+				recordName := strings.ToLower(target.name + recordNameSuffix)
+				state.DnsZones = append(state.DnsZones, PrivateEndpointDnsZoneModel{
+					SubresourceName: subresourceName,
+					Name:            zoneName,
+					RecordName:      recordName,
+					Fqdn:            fmt.Sprintf("%s.%s", recordName, zoneName),
+				})
+
+				if _, ok := seen[zoneName]; !ok {
+					seen[zoneName] = struct{}{}
+					state.DnsZoneNames = append(state.DnsZoneNames, zoneName)
+				}
+			}
+
+			idHash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", environment, state.TargetResourceId, strings.Join(state.SubresourceNames, ","))))
+			metadata.ResourceData.SetId(hex.EncodeToString(idHash[:]))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PrivateEndpointDnsZonesDataSource struct{}
+
+func TestAccPrivateEndpointDnsZonesDataSource_storageAccount(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_dns_zones", "test")
+	r := PrivateEndpointDnsZonesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.storageAccount(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("dns_zone.#").HasValue("3"),
+				check.That(data.ResourceName).Key("dns_zone.0.subresource_name").HasValue("blob"),
+				check.That(data.ResourceName).Key("dns_zone.0.name").HasValue("privatelink.blob.core.windows.net"),
+				check.That(data.ResourceName).Key("dns_zone.0.record_name").HasValue("acctestsa"),
+				check.That(data.ResourceName).Key("dns_zone.0.fqdn").HasValue("acctestsa.privatelink.blob.core.windows.net"),
+				check.That(data.ResourceName).Key("dns_zone.1.record_name").HasValue("acctestsa-secondary"),
+				check.That(data.ResourceName).Key("dns_zone.2.name").HasValue("privatelink.dfs.core.windows.net"),
+				check.That(data.ResourceName).Key("dns_zone_names.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccPrivateEndpointDnsZonesDataSource_keyVault(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_dns_zones", "test")
+	r := PrivateEndpointDnsZonesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.keyVault(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("dns_zone.#").HasValue("1"),
+				check.That(data.ResourceName).Key("dns_zone.0.name").HasValue("privatelink.vaultcore.azure.net"),
+				check.That(data.ResourceName).Key("dns_zone_names.0").HasValue("privatelink.vaultcore.azure.net"),
+			),
+		},
+	})
+}
+
+func TestAccPrivateEndpointDnsZonesDataSource_unsupportedSubresource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_dns_zones", "test")
+	r := PrivateEndpointDnsZonesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      r.unsupportedSubresource(data),
+			ExpectError: regexp.MustCompile("supported Subresources are"),
+		},
+	})
+}
+
+func (PrivateEndpointDnsZonesDataSource) storageAccount(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_endpoint_dns_zones" "test" {
+  target_resource_id = "/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Storage/storageAccounts/acctestsa"
+  subresource_names  = ["blob", "blob_secondary", "dfs"]
+}
+`, data.Subscriptions.Primary, data.RandomInteger)
+}
+
+func (PrivateEndpointDnsZonesDataSource) keyVault(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_endpoint_dns_zones" "test" {
+  target_resource_id = "/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.KeyVault/vaults/acctestkv"
+  subresource_names  = ["vault"]
+}
+`, data.Subscriptions.Primary, data.RandomInteger)
+}
+
+func (PrivateEndpointDnsZonesDataSource) unsupportedSubresource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_endpoint_dns_zones" "test" {
+  target_resource_id = "/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.KeyVault/vaults/acctestkv"
+  subresource_names  = ["blob"]
+}
+`, data.Subscriptions.Primary, data.RandomInteger)
+}
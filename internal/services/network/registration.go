@@ -43,6 +43,11 @@ func (r Registration) DataSources() []sdk.DataSource {
 		ManagerIpamPoolDataSource{},
 		NetworkSecurityPerimeterProfileDataSource{},
 		NetworkSecurityPerimeterDataSource{},
+		NetworkInterfaceEffectiveRoutesDataSource{},
+		NetworkInterfaceEffectiveSecurityRulesDataSource{},
+		NetworkWatcherIPFlowVerifyDataSource{},
+		NetworkWatcherNextHopDataSource{},
+		PrivateEndpointDnsZonesDataSource{},
 		VPNServerConfigurationDataSource{},
 		VirtualNetworkPeeringDataSource{},
 	}
@@ -50,6 +55,12 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ApplicationGatewayBackendHTTPSettingsResource{},
+		ApplicationGatewayBackendPoolResource{},
+		ApplicationGatewayListenerResource{},
+		ApplicationGatewayProbeResource{},
+		ApplicationGatewayRewriteRuleSetResource{},
+		ApplicationGatewayRoutingRuleResource{},
 		CustomIpPrefixResource{},
 		ManagerAdminRuleResource{},
 		ManagerAdminRuleCollectionResource{},
@@ -16,6 +16,7 @@ package network
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Listener -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/listeners/listener1 -rewrite=true
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=BackendSettingsCollection -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/backendSettingsCollection/backendSettings1 -rewrite=true
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=RoutingRule -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/routingRules/routingRule1 -rewrite=true
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=RequestRoutingRule -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/requestRoutingRules/requestRoutingRule1 -rewrite=true
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AuthenticationCertificate -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/authenticationCertificates/authcert1 -rewrite=true
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=RewriteRuleSet -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/rewriteRuleSets/rewriteRuleSet1 -rewrite=true
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Probe -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/applicationGateway1/probes/probe1 -rewrite=true
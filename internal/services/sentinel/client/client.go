@@ -13,21 +13,29 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlists"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/automationrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contentpackages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contentproductpackages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contentproducttemplates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contenttemplates"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	securityinsight "github.com/jackofallops/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
 )
 
 type Client struct {
-	AlertRulesClient         *alertrules.AlertRulesClient
-	AlertRuleTemplatesClient *alertruletemplates.AlertRuleTemplatesClient
-	AutomationRulesClient    *automationrules.AutomationRulesClient
-	DataConnectorsClient     *securityinsight.DataConnectorsClient
-	WatchlistsClient         *watchlists.WatchlistsClient
-	WatchlistItemsClient     *watchlistitems.WatchlistItemsClient
-	OnboardingStatesClient   *sentinelonboardingstates.SentinelOnboardingStatesClient
-	AnalyticsSettingsClient  *securityinsight.SecurityMLAnalyticsSettingsClient
-	ThreatIntelligenceClient *securityinsight.ThreatIntelligenceIndicatorClient
-	MetadataClient           *metadata.MetadataClient
+	AlertRulesClient              *alertrules.AlertRulesClient
+	AlertRuleTemplatesClient      *alertruletemplates.AlertRuleTemplatesClient
+	AutomationRulesClient         *automationrules.AutomationRulesClient
+	ContentPackagesClient         *contentpackages.ContentPackagesClient
+	ContentProductPackagesClient  *contentproductpackages.ContentProductPackagesClient
+	ContentProductTemplatesClient *contentproducttemplates.ContentProductTemplatesClient
+	ContentTemplatesClient        *contenttemplates.ContentTemplatesClient
+	DataConnectorsClient          *securityinsight.DataConnectorsClient
+	WatchlistsClient              *watchlists.WatchlistsClient
+	WatchlistItemsClient          *watchlistitems.WatchlistItemsClient
+	OnboardingStatesClient        *sentinelonboardingstates.SentinelOnboardingStatesClient
+	AnalyticsSettingsClient       *securityinsight.SecurityMLAnalyticsSettingsClient
+	ThreatIntelligenceClient      *securityinsight.ThreatIntelligenceIndicatorClient
+	MetadataClient                *metadata.MetadataClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -46,6 +54,30 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(automationRulesClient.Client, o.Authorizers.ResourceManager)
 
+	contentPackagesClient, err := contentpackages.NewContentPackagesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Content Packages Client: %+v", err)
+	}
+	o.Configure(contentPackagesClient.Client, o.Authorizers.ResourceManager)
+
+	contentProductPackagesClient, err := contentproductpackages.NewContentProductPackagesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Content Product Packages Client: %+v", err)
+	}
+	o.Configure(contentProductPackagesClient.Client, o.Authorizers.ResourceManager)
+
+	contentProductTemplatesClient, err := contentproducttemplates.NewContentProductTemplatesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Content Product Templates Client: %+v", err)
+	}
+	o.Configure(contentProductTemplatesClient.Client, o.Authorizers.ResourceManager)
+
+	contentTemplatesClient, err := contenttemplates.NewContentTemplatesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Content Templates Client: %+v", err)
+	}
+	o.Configure(contentTemplatesClient.Client, o.Authorizers.ResourceManager)
+
 	dataConnectorsClient := securityinsight.NewDataConnectorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataConnectorsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -80,15 +112,19 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(metadataClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
-		AlertRulesClient:         alertRulesClient,
-		AlertRuleTemplatesClient: &alertRuleTemplatesClient,
-		AutomationRulesClient:    automationRulesClient,
-		DataConnectorsClient:     &dataConnectorsClient,
-		WatchlistsClient:         watchListsClient,
-		WatchlistItemsClient:     watchListItemsClient,
-		OnboardingStatesClient:   onboardingStatesClient,
-		AnalyticsSettingsClient:  &analyticsSettingsClient,
-		ThreatIntelligenceClient: &threatIntelligenceClient,
-		MetadataClient:           metadataClient,
+		AlertRulesClient:              alertRulesClient,
+		AlertRuleTemplatesClient:      &alertRuleTemplatesClient,
+		AutomationRulesClient:         automationRulesClient,
+		ContentPackagesClient:         contentPackagesClient,
+		ContentProductPackagesClient:  contentProductPackagesClient,
+		ContentProductTemplatesClient: contentProductTemplatesClient,
+		ContentTemplatesClient:        contentTemplatesClient,
+		DataConnectorsClient:          &dataConnectorsClient,
+		WatchlistsClient:              watchListsClient,
+		WatchlistItemsClient:          watchListItemsClient,
+		OnboardingStatesClient:        onboardingStatesClient,
+		AnalyticsSettingsClient:       &analyticsSettingsClient,
+		ThreatIntelligenceClient:      &threatIntelligenceClient,
+		MetadataClient:                metadataClient,
 	}, nil
 }
@@ -88,6 +88,9 @@ func (r Registration) Resources() []sdk.Resource {
 		MetadataResource{},
 		AlertRuleAnomalyDuplicateResource{},
 		ThreatIntelligenceIndicator{},
+		ContentPackageResource{},
+		ContentTemplateInstanceResource{},
+		HuntingQueryResource{},
 	}
 }
 
@@ -0,0 +1,260 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contentpackages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contentproductpackages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContentPackageModel struct {
+	WorkspaceId      string `tfschema:"workspace_id"`
+	ContentId        string `tfschema:"content_id"`
+	Version          string `tfschema:"version"`
+	ContentKind      string `tfschema:"content_kind"`
+	ContentProductId string `tfschema:"content_product_id"`
+	DisplayName      string `tfschema:"display_name"`
+}
+
+type ContentPackageResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContentPackageResource{}
+
+func (r ContentPackageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"content_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ContentPackageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content_kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"content_product_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContentPackageResource) ModelObject() interface{} {
+	return &ContentPackageModel{}
+}
+
+func (r ContentPackageResource) ResourceType() string {
+	return "azurerm_sentinel_content_package"
+}
+
+func (r ContentPackageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return contentpackages.ValidateContentPackageID
+}
+
+func (r ContentPackageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			var config ContentPackageModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(config.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := contentpackages.NewContentPackageID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, config.ContentId)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := installContentPackage(ctx, metadata, id, config.Version); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContentPackageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContentPackageModel{
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+				ContentId:   id.PackageId,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ContentId = pointer.From(props.ContentId)
+					state.ContentKind = string(pointer.From(props.ContentKind))
+					state.ContentProductId = pointer.From(props.ContentProductId)
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.Version = pointer.From(props.Version)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContentPackageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContentPackageModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("version") {
+				if err := installContentPackage(ctx, metadata, *id, config.Version); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContentPackageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.ContentPackageUninstall(ctx, *id); err != nil {
+				return fmt.Errorf("uninstalling %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// installContentPackage installs the Content Hub package with the Content ID of `id` from the catalog. Content Hub only
+// offers the latest version of each package, so when `version` is specified it must match the version in the catalog.
+func installContentPackage(ctx context.Context, metadata sdk.ResourceMetaData, id contentpackages.ContentPackageId, version string) error {
+	client := metadata.Client.Sentinel.ContentPackagesClient
+	productClient := metadata.Client.Sentinel.ContentProductPackagesClient
+
+	workspaceId := contentproductpackages.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+	options := contentproductpackages.ProductPackagesListOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("properties/contentId eq '%s'", id.PackageId)),
+	}
+	products, err := productClient.ProductPackagesListComplete(ctx, workspaceId, options)
+	if err != nil {
+		return fmt.Errorf("retrieving Content Hub packages for %s: %+v", workspaceId, err)
+	}
+
+	var product *contentproductpackages.ProductPackageProperties
+	for _, item := range products.Items {
+		if item.Properties != nil && pointer.From(item.Properties.ContentId) == id.PackageId {
+			product = item.Properties
+			break
+		}
+	}
+	if product == nil {
+		return fmt.Errorf("no Content Hub package with the Content ID %q was found for %s", id.PackageId, workspaceId)
+	}
+
+	if version != "" && version != pointer.From(product.Version) {
+		return fmt.Errorf("version %q of the Content Hub package %q is not available, the version currently offered is %q", version, id.PackageId, pointer.From(product.Version))
+	}
+
+	// the catalog and installed package models share their JSON representation, but are distinct types within the SDK
+	b, err := json.Marshal(product)
+	if err != nil {
+		return fmt.Errorf("marshalling Content Hub package %q: %+v", id.PackageId, err)
+	}
+	var properties contentpackages.PackageBaseProperties
+	if err := json.Unmarshal(b, &properties); err != nil {
+		return fmt.Errorf("unmarshalling Content Hub package %q: %+v", id.PackageId, err)
+	}
+
+	payload := contentpackages.PackageModel{
+		Properties: &properties,
+	}
+
+	if _, err := client.ContentPackageInstall(ctx, id, payload); err != nil {
+		return fmt.Errorf("installing %s: %+v", id, err)
+	}
+
+	return nil
+}
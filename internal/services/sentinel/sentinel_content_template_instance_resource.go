@@ -0,0 +1,287 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contentproducttemplates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contenttemplates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContentTemplateInstanceModel struct {
+	WorkspaceId    string `tfschema:"workspace_id"`
+	ContentId      string `tfschema:"content_id"`
+	ContentKind    string `tfschema:"content_kind"`
+	Version        string `tfschema:"version"`
+	DisplayName    string `tfschema:"display_name"`
+	PackageId      string `tfschema:"package_id"`
+	PackageVersion string `tfschema:"package_version"`
+}
+
+type ContentTemplateInstanceResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContentTemplateInstanceResource{}
+
+func (r ContentTemplateInstanceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"content_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"content_kind": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(contenttemplates.KindAnalyticsRule),
+				string(contenttemplates.KindAutomationRule),
+				string(contenttemplates.KindHuntingQuery),
+				string(contenttemplates.KindParser),
+				string(contenttemplates.KindPlaybook),
+				string(contenttemplates.KindWatchlist),
+				string(contenttemplates.KindWorkbook),
+			}, false),
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ContentTemplateInstanceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"package_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"package_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContentTemplateInstanceResource) ModelObject() interface{} {
+	return &ContentTemplateInstanceModel{}
+}
+
+func (r ContentTemplateInstanceResource) ResourceType() string {
+	return "azurerm_sentinel_content_template_instance"
+}
+
+func (r ContentTemplateInstanceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return contenttemplates.ValidateContentTemplateID
+}
+
+func (r ContentTemplateInstanceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentTemplatesClient
+
+			var config ContentTemplateInstanceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(config.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := contenttemplates.NewContentTemplateID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, config.ContentId)
+
+			existing, err := client.ContentTemplateGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := installContentTemplate(ctx, metadata, id, config.ContentKind, config.Version); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContentTemplateInstanceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentTemplatesClient
+
+			id, err := contenttemplates.ParseContentTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ContentTemplateGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContentTemplateInstanceModel{
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+				ContentId:   id.TemplateId,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ContentId = pointer.From(props.ContentId)
+					state.ContentKind = string(pointer.From(props.ContentKind))
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.PackageId = pointer.From(props.PackageId)
+					state.PackageVersion = pointer.From(props.PackageVersion)
+					state.Version = pointer.From(props.Version)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContentTemplateInstanceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := contenttemplates.ParseContentTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContentTemplateInstanceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("version") {
+				if err := installContentTemplate(ctx, metadata, *id, config.ContentKind, config.Version); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContentTemplateInstanceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentTemplatesClient
+
+			id, err := contenttemplates.ParseContentTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.ContentTemplateDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// installContentTemplate installs the Content Hub template with the Content ID of `id` from the catalog of templates
+// which belong to the packages installed within the Workspace. As with packages, only the latest version of each
+// template is offered, so when `version` is specified it must match the version in the catalog.
+func installContentTemplate(ctx context.Context, metadata sdk.ResourceMetaData, id contenttemplates.ContentTemplateId, kind string, version string) error {
+	client := metadata.Client.Sentinel.ContentTemplatesClient
+	productClient := metadata.Client.Sentinel.ContentProductTemplatesClient
+
+	filters := []string{
+		fmt.Sprintf("properties/contentId eq '%s'", id.TemplateId),
+	}
+	if kind != "" {
+		filters = append(filters, fmt.Sprintf("properties/contentKind eq '%s'", kind))
+	}
+
+	workspaceId := contentproducttemplates.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+	options := contentproducttemplates.ProductTemplatesListOperationOptions{
+		Filter: pointer.To(strings.Join(filters, " and ")),
+	}
+	products, err := productClient.ProductTemplatesListComplete(ctx, workspaceId, options)
+	if err != nil {
+		return fmt.Errorf("retrieving Content Hub templates for %s: %+v", workspaceId, err)
+	}
+
+	var product *contentproducttemplates.ProductTemplateProperties
+	for _, item := range products.Items {
+		if item.Properties != nil && pointer.From(item.Properties.ContentId) == id.TemplateId {
+			product = item.Properties
+			break
+		}
+	}
+	if product == nil {
+		return fmt.Errorf("no Content Hub template with the Content ID %q was found for %s, the Content Package containing it must be installed first", id.TemplateId, workspaceId)
+	}
+
+	if version != "" && version != pointer.From(product.Version) {
+		return fmt.Errorf("version %q of the Content Hub template %q is not available, the version currently offered is %q", version, id.TemplateId, pointer.From(product.Version))
+	}
+
+	// the catalog and installed template models share their JSON representation, but are distinct types within the SDK
+	b, err := json.Marshal(product)
+	if err != nil {
+		return fmt.Errorf("marshalling Content Hub template %q: %+v", id.TemplateId, err)
+	}
+	var properties contenttemplates.TemplateProperties
+	if err := json.Unmarshal(b, &properties); err != nil {
+		return fmt.Errorf("unmarshalling Content Hub template %q: %+v", id.TemplateId, err)
+	}
+
+	payload := contenttemplates.TemplateModel{
+		Properties: &properties,
+	}
+
+	if _, err := client.ContentTemplateInstall(ctx, id, payload); err != nil {
+		return fmt.Errorf("installing %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2024-09-01/contenttemplates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContentTemplateInstanceResource struct{}
+
+func (r ContentTemplateInstanceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := contenttemplates.ParseContentTemplateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Sentinel.ContentTemplatesClient.ContentTemplateGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func TestAccContentTemplateInstance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_content_template_instance", "test")
+	r := ContentTemplateInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_kind").HasValue("AnalyticsRule"),
+				check.That(data.ResourceName).Key("package_id").HasValue("azuresentinel.azure-sentinel-solution-azureactivity"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContentTemplateInstance_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_content_template_instance", "test")
+	r := ContentTemplateInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r ContentTemplateInstanceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_content_template_instance" "test" {
+  workspace_id = azurerm_sentinel_content_package.test.workspace_id
+  content_id   = "23de46ea-c425-4a77-b456-511ae4855d69"
+  content_kind = "AnalyticsRule"
+}
+`, ContentPackageResource{}.basic(data))
+}
+
+func (r ContentTemplateInstanceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_content_template_instance" "import" {
+  workspace_id = azurerm_sentinel_content_template_instance.test.workspace_id
+  content_id   = azurerm_sentinel_content_template_instance.test.content_id
+  content_kind = azurerm_sentinel_content_template_instance.test.content_kind
+}
+`, r.basic(data))
+}
@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/savedsearches"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// Hunting Queries are stored as Saved Searches within the Log Analytics Workspace, using a well-known category and
+// tags which are understood by the Sentinel portal.
+const (
+	huntingQueryCategory       = "Hunting Queries"
+	huntingQueryTagDescription = "description"
+	huntingQueryTagTactics     = "tactics"
+	huntingQueryTagTechniques  = "techniques"
+)
+
+type HuntingQueryModel struct {
+	Name        string   `tfschema:"name"`
+	WorkspaceId string   `tfschema:"workspace_id"`
+	DisplayName string   `tfschema:"display_name"`
+	Query       string   `tfschema:"query"`
+	Description string   `tfschema:"description"`
+	Tactics     []string `tfschema:"tactics"`
+	Techniques  []string `tfschema:"techniques"`
+}
+
+type HuntingQueryResource struct{}
+
+var _ sdk.ResourceWithUpdate = HuntingQueryResource{}
+
+func (r HuntingQueryResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tactics": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(alertrules.PossibleValuesForAttackTactic(), false),
+			},
+		},
+
+		"techniques": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r HuntingQueryResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r HuntingQueryResource) ModelObject() interface{} {
+	return &HuntingQueryModel{}
+}
+
+func (r HuntingQueryResource) ResourceType() string {
+	return "azurerm_sentinel_hunting_query"
+}
+
+func (r HuntingQueryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return savedsearches.ValidateSavedSearchID
+}
+
+func (r HuntingQueryResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SavedSearchesClient
+
+			var config HuntingQueryModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(config.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := savedsearches.NewSavedSearchID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandHuntingQuery(config)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r HuntingQueryResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SavedSearchesClient
+
+			id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := HuntingQueryModel{
+				Name:        id.SavedSearchId,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				if !strings.EqualFold(props.Category, huntingQueryCategory) {
+					return fmt.Errorf("%s is not a Hunting Query, the category was %q", *id, props.Category)
+				}
+
+				state.DisplayName = props.DisplayName
+				state.Query = props.Query
+
+				for _, tag := range pointer.From(props.Tags) {
+					switch tag.Name {
+					case huntingQueryTagDescription:
+						state.Description = tag.Value
+					case huntingQueryTagTactics:
+						state.Tactics = splitHuntingQueryTagValue(tag.Value)
+					case huntingQueryTagTechniques:
+						state.Techniques = splitHuntingQueryTagValue(tag.Value)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r HuntingQueryResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SavedSearchesClient
+
+			id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config HuntingQueryModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			payload := expandHuntingQuery(config)
+			if existing.Model != nil {
+				// the etag must be supplied to update an existing saved search
+				payload.Etag = existing.Model.Etag
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r HuntingQueryResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.SavedSearchesClient
+
+			id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandHuntingQuery(input HuntingQueryModel) savedsearches.SavedSearch {
+	tags := make([]savedsearches.Tag, 0)
+
+	if input.Description != "" {
+		tags = append(tags, savedsearches.Tag{
+			Name:  huntingQueryTagDescription,
+			Value: input.Description,
+		})
+	}
+
+	if len(input.Tactics) > 0 {
+		tactics := append([]string{}, input.Tactics...)
+		sort.Strings(tactics)
+		tags = append(tags, savedsearches.Tag{
+			Name:  huntingQueryTagTactics,
+			Value: strings.Join(tactics, ","),
+		})
+	}
+
+	if len(input.Techniques) > 0 {
+		techniques := append([]string{}, input.Techniques...)
+		sort.Strings(techniques)
+		tags = append(tags, savedsearches.Tag{
+			Name:  huntingQueryTagTechniques,
+			Value: strings.Join(techniques, ","),
+		})
+	}
+
+	return savedsearches.SavedSearch{
+		Properties: savedsearches.SavedSearchProperties{
+			Category:    huntingQueryCategory,
+			DisplayName: input.DisplayName,
+			Query:       input.Query,
+			Tags:        &tags,
+			Version:     pointer.To(int64(2)),
+		},
+	}
+}
+
+func splitHuntingQueryTagValue(input string) []string {
+	result := make([]string, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/savedsearches"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type HuntingQueryResource struct{}
+
+func (r HuntingQueryResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := savedsearches.ParseSavedSearchID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LogAnalytics.SavedSearchesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func TestAccHuntingQuery_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_hunting_query", "test")
+	r := HuntingQueryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHuntingQuery_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_hunting_query", "test")
+	r := HuntingQueryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccHuntingQuery_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_hunting_query", "test")
+	r := HuntingQueryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r HuntingQueryResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_hunting_query" "test" {
+  name         = "acctest-hunting-query-%d"
+  workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  display_name = "Acceptance Test Hunting Query"
+  query        = "SigninLogs | where ResultType != 0"
+}
+`, ContentPackageResource{}.template(data), data.RandomInteger)
+}
+
+func (r HuntingQueryResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_hunting_query" "import" {
+  name         = azurerm_sentinel_hunting_query.test.name
+  workspace_id = azurerm_sentinel_hunting_query.test.workspace_id
+  display_name = azurerm_sentinel_hunting_query.test.display_name
+  query        = azurerm_sentinel_hunting_query.test.query
+}
+`, r.basic(data))
+}
+
+func (r HuntingQueryResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_hunting_query" "test" {
+  name         = "acctest-hunting-query-%d"
+  workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  display_name = "Acceptance Test Hunting Query Updated"
+  query        = "SigninLogs | where ResultType != 0 | summarize count() by UserPrincipalName"
+  description  = "Failed sign-ins grouped by user"
+  tactics      = ["CredentialAccess", "InitialAccess"]
+  techniques   = ["T1110", "T1078"]
+}
+`, ContentPackageResource{}.template(data), data.RandomInteger)
+}